@@ -0,0 +1,297 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package account provides deployment of accounts for common account
+// implementations, handling each implementation's constructor calldata and
+// signature format.
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+
+	client "github.com/attestantio/go-starknet-client"
+	"github.com/attestantio/go-starknet-client/api"
+	"github.com/attestantio/go-starknet-client/crypto"
+	"github.com/attestantio/go-starknet-client/erc20"
+	"github.com/attestantio/go-starknet-client/spec"
+	"github.com/attestantio/go-starknet-client/types"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// STRKAddress is the address of the STRK token on mainnet and Sepolia.
+var STRKAddress = erc20.MainnetSTRKAddress
+
+// ErrInsufficientFunds is returned when the account does not hold enough
+// of the fee token to pay for its deployment.
+var ErrInsufficientFunds = errors.New("insufficient funds")
+
+// Deployer deploys an account.
+type Deployer struct {
+	log                  zerolog.Logger
+	chainID              types.Data
+	feeTokenService      *erc20.Service
+	estimateFeeProvider  client.EstimateFeeProvider
+	transactionSubmitter client.TransactionSubmitter
+	preset               Preset
+	signer               Signer
+	salt                 types.FieldElement
+	classHash            types.Hash
+	calldata             []types.FieldElement
+	address              types.Address
+	feeMultiplier        float64
+}
+
+// NewDeployer creates a new account deployer.
+func NewDeployer(ctx context.Context, params ...Parameter) (*Deployer, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "account").Str("preset", parameters.preset.String()).Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	publicKey := parameters.signer.PublicKey()
+	salt := publicKey
+	if parameters.salt != nil {
+		salt = *parameters.salt
+	}
+
+	classHash, err := parameters.preset.ClassHash()
+	if err != nil {
+		return nil, err
+	}
+
+	calldata, err := parameters.preset.ConstructorCalldata(publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	address, err := crypto.ContractAddress(types.Address{}, salt, classHash, calldata)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to calculate account address"), err)
+	}
+
+	chainIDResponse, err := parameters.client.(client.ChainIDProvider).ChainID(ctx, &api.ChainIDOpts{})
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to obtain chain ID"), err)
+	}
+
+	feeTokenService, err := erc20.New(ctx,
+		erc20.WithLogLevel(parameters.logLevel),
+		erc20.WithCallProvider(parameters.client.(client.CallProvider)),
+		erc20.WithAddress(parameters.feeToken),
+	)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to create fee token service"), err)
+	}
+
+	log.Trace().Stringer("address", address).Msg("Account address calculated")
+
+	return &Deployer{
+		log:                  log,
+		chainID:              chainIDResponse.Data,
+		feeTokenService:      feeTokenService,
+		estimateFeeProvider:  parameters.client.(client.EstimateFeeProvider),
+		transactionSubmitter: parameters.client.(client.TransactionSubmitter),
+		preset:               parameters.preset,
+		signer:               parameters.signer,
+		salt:                 salt,
+		classHash:            classHash,
+		calldata:             calldata,
+		address:              address,
+		feeMultiplier:        parameters.feeMultiplier,
+	}, nil
+}
+
+// Address returns the counterfactual address of the account, to which funds
+// for the deployment should be sent.
+func (d *Deployer) Address() types.Address {
+	return d.address
+}
+
+// Transaction returns the unsigned deploy account transaction.
+func (d *Deployer) Transaction() *spec.DeployAccountV3Transaction {
+	return &spec.DeployAccountV3Transaction{
+		Type:                spec.TransactionTypeDeployAccount,
+		Version:             spec.TransactionVersion3,
+		Signature:           types.Signature{},
+		ContractAddressSalt: d.salt,
+		ConstructorCalldata: d.calldata,
+		ClassHash:           d.classHash,
+		ResourceBounds: spec.ResourceBounds{
+			L1DataGas: &spec.ResourceBound{},
+		},
+		PaymasterData:             []types.FieldElement{},
+		NonceDataAvailabilityMode: spec.TxDAModeL1,
+		FeeDataAvailabilityMode:   spec.TxDAModeL1,
+	}
+}
+
+// EstimateFee estimates the fee for deploying the account.
+func (d *Deployer) EstimateFee(ctx context.Context) (*api.FeeEstimate, error) {
+	response, err := d.estimateFeeProvider.EstimateFee(ctx, &api.EstimateFeeOpts{
+		Block: "latest",
+		Transaction: &spec.Transaction{
+			DeployAccountV3Transaction: d.Transaction(),
+		},
+	})
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to estimate fee"), err)
+	}
+
+	if len(response.Data) != 1 {
+		return nil, fmt.Errorf("expected 1 fee estimate, received %d", len(response.Data))
+	}
+
+	return &response.Data[0], nil
+}
+
+// Balance returns the balance of the fee token held at the account address.
+func (d *Deployer) Balance(ctx context.Context) (*big.Int, error) {
+	balance, err := d.feeTokenService.BalanceOf(ctx, d.address)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to obtain balance"), err)
+	}
+
+	return balance.BigInt(), nil
+}
+
+// Deploy deploys the account, returning an error wrapping ErrInsufficientFunds
+// if the account address does not yet hold enough to pay the deployment fee.
+func (d *Deployer) Deploy(ctx context.Context) (*api.SubmitTransactionResponse, error) {
+	feeEstimate, err := d.EstimateFee(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := d.Transaction()
+	tx.ResourceBounds, err = d.resourceBounds(feeEstimate)
+	if err != nil {
+		return nil, err
+	}
+
+	maxFee := maxResourceFee(&tx.ResourceBounds.L1Gas)
+	maxFee.Add(maxFee, maxResourceFee(&tx.ResourceBounds.L2Gas))
+	if tx.ResourceBounds.L1DataGas != nil {
+		maxFee.Add(maxFee, maxResourceFee(tx.ResourceBounds.L1DataGas))
+	}
+
+	balance, err := d.Balance(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if balance.Cmp(maxFee) < 0 {
+		return nil, errors.Join(ErrInsufficientFunds, fmt.Errorf("balance %s below maximum fee %s", balance, maxFee))
+	}
+
+	hash, err := TransactionHash(tx, d.chainID)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to calculate transaction hash"), err)
+	}
+
+	tx.Signature, err = d.preset.DeploySignature(ctx, d.signer, hash, d.chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	d.log.Trace().Stringer("hash", hash).Stringer("max_fee", maxFee).Msg("Submitting deploy account transaction")
+
+	response, err := d.transactionSubmitter.SubmitTransaction(ctx, &api.SubmitTransactionOpts{
+		Transaction: &spec.Transaction{
+			DeployAccountV3Transaction: tx,
+		},
+		ChainID: d.chainID,
+	})
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to submit transaction"), err)
+	}
+
+	if response.Data.TransactionHash != hash {
+		return nil, fmt.Errorf("transaction hash %s does not match expected %s", response.Data.TransactionHash, hash)
+	}
+
+	return response.Data, nil
+}
+
+// resourceBounds calculates the resource bounds for the deployment from a fee estimate,
+// with the fee multiplier applied to both the amounts and the prices.
+// Estimates that do not report L2 gas predate per-resource bounds, in which case
+// the full estimated fee is expressed in units of L1 gas.
+func (d *Deployer) resourceBounds(feeEstimate *api.FeeEstimate) (spec.ResourceBounds, error) {
+	if feeEstimate.L1GasPrice == 0 {
+		return spec.ResourceBounds{}, errors.New("fee estimate has no gas price")
+	}
+
+	if feeEstimate.L2GasPrice == 0 {
+		amount := (uint64(feeEstimate.OverallFee) + uint64(feeEstimate.L1GasPrice) - 1) / uint64(feeEstimate.L1GasPrice)
+		l1Gas, err := d.resourceBound(types.Number(amount), feeEstimate.L1GasPrice)
+		if err != nil {
+			return spec.ResourceBounds{}, err
+		}
+
+		return spec.ResourceBounds{
+			L1Gas: l1Gas,
+		}, nil
+	}
+
+	l1Gas, err := d.resourceBound(feeEstimate.L1GasConsumed, feeEstimate.L1GasPrice)
+	if err != nil {
+		return spec.ResourceBounds{}, err
+	}
+	l1DataGas, err := d.resourceBound(feeEstimate.L1DataGasConsumed, feeEstimate.L1DataGasPrice)
+	if err != nil {
+		return spec.ResourceBounds{}, err
+	}
+	l2Gas, err := d.resourceBound(feeEstimate.L2GasConsumed, feeEstimate.L2GasPrice)
+	if err != nil {
+		return spec.ResourceBounds{}, err
+	}
+
+	return spec.ResourceBounds{
+		L1Gas:     l1Gas,
+		L1DataGas: &l1DataGas,
+		L2Gas:     l2Gas,
+	}, nil
+}
+
+// resourceBound applies the fee multiplier to an estimated amount and price.
+func (d *Deployer) resourceBound(amount types.Number, price types.Number) (spec.ResourceBound, error) {
+	maxAmount := math.Ceil(float64(amount) * d.feeMultiplier)
+	maxPrice := math.Ceil(float64(price) * d.feeMultiplier)
+	if maxAmount >= math.MaxUint64 || maxPrice >= math.MaxUint64 {
+		return spec.ResourceBound{}, errors.New("resource bounds too large")
+	}
+
+	return spec.ResourceBound{
+		MaxAmount:       types.Number(maxAmount),
+		MaxPricePerUnit: types.Number(maxPrice),
+	}, nil
+}
+
+// maxResourceFee returns the maximum fee that can be charged for a resource.
+func maxResourceFee(bound *spec.ResourceBound) *big.Int {
+	return new(big.Int).Mul(
+		new(big.Int).SetUint64(uint64(bound.MaxAmount)),
+		new(big.Int).SetUint64(uint64(bound.MaxPricePerUnit)),
+	)
+}
@@ -0,0 +1,330 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package account_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/attestantio/go-starknet-client/account"
+	"github.com/attestantio/go-starknet-client/api"
+	"github.com/attestantio/go-starknet-client/spec"
+	"github.com/attestantio/go-starknet-client/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// mockClient is a client that provides the functions required for deployment.
+type mockClient struct {
+	balance     types.FieldElement
+	feeEstimate api.FeeEstimate
+	submitted   *spec.DeployAccountV3Transaction
+}
+
+func (*mockClient) Name() string {
+	return "mock"
+}
+
+func (*mockClient) Address() string {
+	return "mock"
+}
+
+func (*mockClient) ChainID(_ context.Context, _ *api.ChainIDOpts) (*api.Response[types.Data], error) {
+	return &api.Response[types.Data]{
+		Data: types.Data(types.ChainIDSepolia),
+	}, nil
+}
+
+func (m *mockClient) Call(_ context.Context,
+	_ *api.CallOpts,
+) (
+	*api.Response[[]types.FieldElement],
+	error,
+) {
+	return &api.Response[[]types.FieldElement]{
+		Data: []types.FieldElement{m.balance, {}},
+	}, nil
+}
+
+func (m *mockClient) EstimateFee(_ context.Context,
+	_ *api.EstimateFeeOpts,
+) (
+	*api.Response[[]api.FeeEstimate],
+	error,
+) {
+	return &api.Response[[]api.FeeEstimate]{
+		Data: []api.FeeEstimate{m.feeEstimate},
+	}, nil
+}
+
+func (m *mockClient) SubmitTransaction(_ context.Context,
+	opts *api.SubmitTransactionOpts,
+) (
+	*api.Response[*api.SubmitTransactionResponse],
+	error,
+) {
+	m.submitted = opts.Transaction.DeployAccountV3Transaction
+
+	hash, err := account.TransactionHash(m.submitted, types.Data(types.ChainIDSepolia))
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Response[*api.SubmitTransactionResponse]{
+		Data: &api.SubmitTransactionResponse{
+			TransactionHash: hash,
+		},
+	}, nil
+}
+
+// callOnlyClient is a client that only provides calls.
+type callOnlyClient struct{}
+
+func (*callOnlyClient) Name() string {
+	return "call only"
+}
+
+func (*callOnlyClient) Address() string {
+	return "call only"
+}
+
+func TestNewDeployer(t *testing.T) {
+	ctx := context.Background()
+
+	publicKey := fieldElement(t, "0x2e94ba2293dfa45f86dfcf9952d7a33dc50ce2b00b932999fbe0844772604f3")
+
+	tests := []struct {
+		name     string
+		params   []account.Parameter
+		expected string
+		err      string
+	}{
+		{
+			name: "ClientMissing",
+			params: []account.Parameter{
+				account.WithLogLevel(zerolog.Disabled),
+				account.WithPreset(account.PresetOpenZeppelin),
+				account.WithSigner(&signer{publicKey: publicKey}),
+			},
+			err: "no client specified",
+		},
+		{
+			name: "ClientIncapable",
+			params: []account.Parameter{
+				account.WithLogLevel(zerolog.Disabled),
+				account.WithClient(&callOnlyClient{}),
+				account.WithPreset(account.PresetOpenZeppelin),
+				account.WithSigner(&signer{publicKey: publicKey}),
+			},
+			err: "client does not provide chain ID",
+		},
+		{
+			name: "PresetMissing",
+			params: []account.Parameter{
+				account.WithLogLevel(zerolog.Disabled),
+				account.WithClient(&mockClient{}),
+				account.WithSigner(&signer{publicKey: publicKey}),
+			},
+			err: "no preset specified",
+		},
+		{
+			name: "SignerMissing",
+			params: []account.Parameter{
+				account.WithLogLevel(zerolog.Disabled),
+				account.WithClient(&mockClient{}),
+				account.WithPreset(account.PresetOpenZeppelin),
+			},
+			err: "no signer specified",
+		},
+		{
+			name: "FeeMultiplierLow",
+			params: []account.Parameter{
+				account.WithLogLevel(zerolog.Disabled),
+				account.WithClient(&mockClient{}),
+				account.WithPreset(account.PresetOpenZeppelin),
+				account.WithSigner(&signer{publicKey: publicKey}),
+				account.WithFeeMultiplier(0.5),
+			},
+			err: "fee multiplier must be at least 1",
+		},
+		{
+			name: "OpenZeppelin",
+			params: []account.Parameter{
+				account.WithLogLevel(zerolog.Disabled),
+				account.WithClient(&mockClient{}),
+				account.WithPreset(account.PresetOpenZeppelin),
+				account.WithSigner(&signer{publicKey: publicKey}),
+			},
+			expected: "0x48419d3cc27f158917b45255d5376c06a9524484e19a1102279cbdc715c5522",
+		},
+		{
+			name: "Salt",
+			params: []account.Parameter{
+				account.WithLogLevel(zerolog.Disabled),
+				account.WithClient(&mockClient{}),
+				account.WithPreset(account.PresetOpenZeppelin),
+				account.WithSigner(&signer{publicKey: publicKey}),
+				account.WithSalt(types.FieldElementFromUint64(1)),
+			},
+			expected: "0x5791bffefc158c3571e6a1527e90ec2b4fab04e6332db48bf2fe60d38432553",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			deployer, err := account.NewDeployer(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, deployer.Address().String())
+			}
+		})
+	}
+}
+
+func TestDeploy(t *testing.T) {
+	ctx := context.Background()
+
+	publicKey := fieldElement(t, "0x2e94ba2293dfa45f86dfcf9952d7a33dc50ce2b00b932999fbe0844772604f3")
+	feeEstimate := api.FeeEstimate{
+		L1GasConsumed: 20,
+		L1GasPrice:    1000,
+		OverallFee:    25500,
+		Unit:          spec.FeeUnitFri,
+	}
+	resourceFeeEstimate := api.FeeEstimate{
+		L1GasPrice:        1000,
+		L1DataGasConsumed: 100,
+		L1DataGasPrice:    10,
+		L2GasConsumed:     20000,
+		L2GasPrice:        2,
+		OverallFee:        41000,
+		Unit:              spec.FeeUnitFri,
+	}
+
+	tests := []struct {
+		name      string
+		preset    account.Preset
+		client    *mockClient
+		bounds    spec.ResourceBounds
+		signature int
+		err       string
+	}{
+		{
+			name:   "NoGasPrice",
+			preset: account.PresetOpenZeppelin,
+			client: &mockClient{},
+			err:    "fee estimate has no gas price",
+		},
+		{
+			name:   "InsufficientFunds",
+			preset: account.PresetOpenZeppelin,
+			client: &mockClient{
+				feeEstimate: feeEstimate,
+				balance:     types.FieldElementFromUint64(58499),
+			},
+			err: "insufficient funds\nbalance 58499 below maximum fee 58500",
+		},
+		{
+			name:   "OpenZeppelin",
+			preset: account.PresetOpenZeppelin,
+			client: &mockClient{
+				feeEstimate: feeEstimate,
+				balance:     types.FieldElementFromUint64(58500),
+			},
+			bounds: spec.ResourceBounds{
+				L1Gas: spec.ResourceBound{
+					MaxAmount:       39,
+					MaxPricePerUnit: 1500,
+				},
+			},
+			signature: 2,
+		},
+		{
+			name:   "Braavos",
+			preset: account.PresetBraavos,
+			client: &mockClient{
+				feeEstimate: feeEstimate,
+				balance:     types.FieldElementFromUint64(1000000),
+			},
+			bounds: spec.ResourceBounds{
+				L1Gas: spec.ResourceBound{
+					MaxAmount:       39,
+					MaxPricePerUnit: 1500,
+				},
+			},
+			signature: 15,
+		},
+		{
+			name:   "ResourceInsufficientFunds",
+			preset: account.PresetOpenZeppelin,
+			client: &mockClient{
+				feeEstimate: resourceFeeEstimate,
+				balance:     types.FieldElementFromUint64(92249),
+			},
+			err: "insufficient funds\nbalance 92249 below maximum fee 92250",
+		},
+		{
+			name:   "Resource",
+			preset: account.PresetOpenZeppelin,
+			client: &mockClient{
+				feeEstimate: resourceFeeEstimate,
+				balance:     types.FieldElementFromUint64(92250),
+			},
+			bounds: spec.ResourceBounds{
+				L1Gas: spec.ResourceBound{
+					MaxAmount:       0,
+					MaxPricePerUnit: 1500,
+				},
+				L1DataGas: &spec.ResourceBound{
+					MaxAmount:       150,
+					MaxPricePerUnit: 15,
+				},
+				L2Gas: spec.ResourceBound{
+					MaxAmount:       30000,
+					MaxPricePerUnit: 3,
+				},
+			},
+			signature: 2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			deployer, err := account.NewDeployer(ctx,
+				account.WithLogLevel(zerolog.Disabled),
+				account.WithClient(test.client),
+				account.WithPreset(test.preset),
+				account.WithSigner(&signer{publicKey: publicKey}),
+			)
+			require.NoError(t, err)
+
+			res, err := deployer.Deploy(ctx)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				if test.client.balance != (types.FieldElement{}) {
+					require.True(t, errors.Is(err, account.ErrInsufficientFunds))
+				}
+			} else {
+				require.NoError(t, err)
+				require.False(t, res.TransactionHash.IsZero())
+				require.Equal(t, test.bounds, test.client.submitted.ResourceBounds)
+				require.Len(t, test.client.submitted.Signature, test.signature)
+				require.Equal(t, types.FieldElement(res.TransactionHash), test.client.submitted.Signature[0])
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package account
+
+import (
+	"errors"
+
+	client "github.com/attestantio/go-starknet-client"
+	"github.com/attestantio/go-starknet-client/types"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel      zerolog.Level
+	client        client.Service
+	preset        Preset
+	signer        Signer
+	salt          *types.FieldElement
+	feeToken      types.Address
+	feeMultiplier float64
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(p *parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithClient sets the client used to estimate fees and submit the deployment.
+func WithClient(client client.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.client = client
+	})
+}
+
+// WithPreset sets the account preset to deploy.
+func WithPreset(preset Preset) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.preset = preset
+	})
+}
+
+// WithSigner sets the signer that controls the account.
+func WithSigner(signer Signer) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.signer = signer
+	})
+}
+
+// WithSalt sets the salt used to derive the account address.
+// If not supplied the public key of the signer is used.
+func WithSalt(salt types.FieldElement) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.salt = &salt
+	})
+}
+
+// WithFeeToken sets the token in which the deployment fee is paid.
+func WithFeeToken(feeToken types.Address) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.feeToken = feeToken
+	})
+}
+
+// WithFeeMultiplier sets the multiplier applied to the estimated fee when
+// setting the resource bounds of the deployment.
+func WithFeeMultiplier(feeMultiplier float64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.feeMultiplier = feeMultiplier
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel:      zerolog.GlobalLevel(),
+		feeToken:      STRKAddress,
+		feeMultiplier: 1.5,
+	}
+
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.client == nil {
+		return nil, errors.New("no client specified")
+	}
+
+	if _, isProvider := parameters.client.(client.ChainIDProvider); !isProvider {
+		return nil, errors.New("client does not provide chain ID")
+	}
+
+	if _, isProvider := parameters.client.(client.CallProvider); !isProvider {
+		return nil, errors.New("client does not provide calls")
+	}
+
+	if _, isProvider := parameters.client.(client.EstimateFeeProvider); !isProvider {
+		return nil, errors.New("client does not provide fee estimates")
+	}
+
+	if _, isProvider := parameters.client.(client.TransactionSubmitter); !isProvider {
+		return nil, errors.New("client does not submit transactions")
+	}
+
+	if parameters.preset == PresetUnknown {
+		return nil, errors.New("no preset specified")
+	}
+
+	if parameters.signer == nil {
+		return nil, errors.New("no signer specified")
+	}
+
+	if parameters.feeToken.IsZero() {
+		return nil, errors.New("no fee token specified")
+	}
+
+	if parameters.feeMultiplier < 1 {
+		return nil, errors.New("fee multiplier must be at least 1")
+	}
+
+	return &parameters, nil
+}
@@ -0,0 +1,210 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/attestantio/go-starknet-client/crypto"
+	"github.com/attestantio/go-starknet-client/types"
+)
+
+// Preset is an account implementation whose deployment format is known.
+type Preset uint8
+
+const (
+	// PresetUnknown is an unknown preset.
+	PresetUnknown Preset = iota
+	// PresetOpenZeppelin is the OpenZeppelin account.
+	PresetOpenZeppelin
+	// PresetArgent is the Argent account, v0.4.0.
+	PresetArgent
+	// PresetBraavos is the Braavos account, v1.0.0.
+	PresetBraavos
+)
+
+var presetStrings = [...]string{
+	"unknown",
+	"openzeppelin",
+	"argent",
+	"braavos",
+}
+
+// Class hashes for the presets.
+var (
+	openZeppelinClassHash = *new(types.Hash).MustParse("0x061dac032f228abef9c6626f995015233097ae253a7f72d68552db02f2971b8f")
+	argentClassHash       = *new(types.Hash).MustParse("0x036078334509b514626504edc9fb252328d1a240e4e948bef8d0c08dff45927f")
+	// braavosBaseClassHash is the class deployed for Braavos accounts, which
+	// replaces itself with braavosClassHash as part of the deployment.
+	braavosBaseClassHash = *new(types.Hash).MustParse("0x03d16c7a9a60b0593bd202f660a28c5d76e0403601d9ccc7e4fa253b6a70c201")
+	braavosClassHash     = *new(types.Hash).MustParse("0x03957f9f5a1cbfe918cedc2015c85200ca51a5f7506ecb6de98a5207b759bf8a")
+)
+
+// braavosAuxDataLength is the number of unused auxiliary data elements in a
+// Braavos deployment signature.  As defined by the deployment flow of the
+// Braavos v1.0.0 account (github.com/myBraavos/braavos-account-cairo) the full
+// signature is:
+//
+//	r, s,
+//	implementation class hash,
+//	signer type,
+//	secp256r1 signer x low, x high, y low, y high,
+//	multisig threshold,
+//	withdrawal limit low,
+//	fee rate,
+//	STRK fee rate,
+//	chain ID,
+//	auxiliary data r, auxiliary data s
+//
+// where the auxiliary data runs from the implementation class hash to the chain
+// ID, and is signed with its Poseidon hash.  Accounts without a secp256r1
+// signer, multisig or withdrawal limit leave the nine elements between the class
+// hash and the chain ID as zero.
+const braavosAuxDataLength = 9
+
+// MarshalJSON implements json.Marshaler.
+func (p Preset) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf("%q", p.String())), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (p *Preset) UnmarshalJSON(input []byte) error {
+	var err error
+
+	switch strings.ToLower(string(input)) {
+	case `"openzeppelin"`:
+		*p = PresetOpenZeppelin
+	case `"argent"`:
+		*p = PresetArgent
+	case `"braavos"`:
+		*p = PresetBraavos
+	default:
+		err = fmt.Errorf("unrecognised account preset %s", string(input))
+	}
+
+	return err
+}
+
+// String returns a string representation of the preset.
+func (p Preset) String() string {
+	if int(p) >= len(presetStrings) {
+		return presetStrings[0]
+	}
+
+	return presetStrings[p]
+}
+
+// ClassHash returns the class hash deployed for the preset.
+func (p Preset) ClassHash() (types.Hash, error) {
+	switch p {
+	case PresetOpenZeppelin:
+		return openZeppelinClassHash, nil
+	case PresetArgent:
+		return argentClassHash, nil
+	case PresetBraavos:
+		return braavosBaseClassHash, nil
+	default:
+		return types.Hash{}, fmt.Errorf("unsupported account preset %v", p)
+	}
+}
+
+// ConstructorCalldata returns the constructor calldata for an account
+// controlled by the given public key.
+func (p Preset) ConstructorCalldata(publicKey types.FieldElement) ([]types.FieldElement, error) {
+	switch p {
+	case PresetOpenZeppelin, PresetBraavos:
+		return []types.FieldElement{publicKey}, nil
+	case PresetArgent:
+		// Owner is a Starknet signer (variant 0), guardian is None (variant 1).
+		return []types.FieldElement{
+			types.FieldElementFromUint64(0),
+			publicKey,
+			types.FieldElementFromUint64(1),
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported account preset %v", p)
+	}
+}
+
+// DeploySignature signs the hash of a deploy account transaction in the
+// format expected by the preset.
+func (p Preset) DeploySignature(ctx context.Context,
+	signer Signer,
+	hash types.Hash,
+	chainID types.Data,
+) (
+	types.Signature,
+	error,
+) {
+	r, s, err := signer.Sign(ctx, types.FieldElement(hash))
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to sign transaction"), err)
+	}
+
+	switch p {
+	case PresetOpenZeppelin, PresetArgent:
+		return types.Signature{r, s}, nil
+	case PresetBraavos:
+		return braavosDeploySignature(ctx, signer, r, s, chainID)
+	default:
+		return nil, fmt.Errorf("unsupported account preset %v", p)
+	}
+}
+
+// braavosDeploySignature creates a Braavos deployment signature, which carries
+// the implementation class and auxiliary data along with a signature over them.
+func braavosDeploySignature(ctx context.Context,
+	signer Signer,
+	r types.FieldElement,
+	s types.FieldElement,
+	chainID types.Data,
+) (
+	types.Signature,
+	error,
+) {
+	chainName, err := types.ChainIDName(chainID)
+	if err != nil {
+		return nil, errors.Join(errors.New("invalid chain ID"), err)
+	}
+
+	chainIDElement, err := types.FieldElementFromShortString(chainName)
+	if err != nil {
+		return nil, errors.Join(errors.New("invalid chain ID"), err)
+	}
+
+	auxData := make([]types.FieldElement, 0, braavosAuxDataLength+2)
+	auxData = append(auxData, types.FieldElement(braavosClassHash))
+	auxData = append(auxData, make([]types.FieldElement, braavosAuxDataLength)...)
+	auxData = append(auxData, chainIDElement)
+
+	elements, err := crypto.FeltsFromFieldElements(auxData)
+	if err != nil {
+		return nil, err
+	}
+
+	auxR, auxS, err := signer.Sign(ctx, crypto.PoseidonArray(elements...).FieldElement())
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to sign auxiliary data"), err)
+	}
+
+	res := make(types.Signature, 0, len(auxData)+4)
+	res = append(res, r, s)
+	res = append(res, auxData...)
+	res = append(res, auxR, auxS)
+
+	return res, nil
+}
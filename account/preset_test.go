@@ -0,0 +1,237 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package account_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/attestantio/go-starknet-client/account"
+	"github.com/attestantio/go-starknet-client/crypto"
+	"github.com/attestantio/go-starknet-client/types"
+	"github.com/stretchr/testify/require"
+)
+
+// signer is a signer that returns the hash and its position in the sequence of signatures.
+type signer struct {
+	publicKey types.FieldElement
+	hashes    []types.FieldElement
+	err       error
+}
+
+func (s *signer) PublicKey() types.FieldElement {
+	return s.publicKey
+}
+
+func (s *signer) Sign(_ context.Context, hash types.FieldElement) (types.FieldElement, types.FieldElement, error) {
+	if s.err != nil {
+		return types.FieldElement{}, types.FieldElement{}, s.err
+	}
+	s.hashes = append(s.hashes, hash)
+
+	return hash, types.FieldElementFromUint64(uint64(len(s.hashes))), nil
+}
+
+func TestPresetClassHash(t *testing.T) {
+	tests := []struct {
+		name     string
+		preset   account.Preset
+		expected string
+		err      string
+	}{
+		{
+			name:   "Unknown",
+			preset: account.PresetUnknown,
+			err:    "unsupported account preset unknown",
+		},
+		{
+			name:     "OpenZeppelin",
+			preset:   account.PresetOpenZeppelin,
+			expected: "0x61dac032f228abef9c6626f995015233097ae253a7f72d68552db02f2971b8f",
+		},
+		{
+			name:     "Argent",
+			preset:   account.PresetArgent,
+			expected: "0x36078334509b514626504edc9fb252328d1a240e4e948bef8d0c08dff45927f",
+		},
+		{
+			name:     "Braavos",
+			preset:   account.PresetBraavos,
+			expected: "0x3d16c7a9a60b0593bd202f660a28c5d76e0403601d9ccc7e4fa253b6a70c201",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := test.preset.ClassHash()
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res.String())
+			}
+		})
+	}
+}
+
+func TestPresetConstructorCalldata(t *testing.T) {
+	publicKey := fieldElement(t, "0x2e94ba2293dfa45f86dfcf9952d7a33dc50ce2b00b932999fbe0844772604f3")
+
+	tests := []struct {
+		name     string
+		preset   account.Preset
+		expected []types.FieldElement
+		err      string
+	}{
+		{
+			name:   "Unknown",
+			preset: account.PresetUnknown,
+			err:    "unsupported account preset unknown",
+		},
+		{
+			name:     "OpenZeppelin",
+			preset:   account.PresetOpenZeppelin,
+			expected: []types.FieldElement{publicKey},
+		},
+		{
+			name:   "Argent",
+			preset: account.PresetArgent,
+			expected: []types.FieldElement{
+				types.FieldElementFromUint64(0),
+				publicKey,
+				types.FieldElementFromUint64(1),
+			},
+		},
+		{
+			name:     "Braavos",
+			preset:   account.PresetBraavos,
+			expected: []types.FieldElement{publicKey},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := test.preset.ConstructorCalldata(publicKey)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res)
+			}
+		})
+	}
+}
+
+func TestPresetDeploySignature(t *testing.T) {
+	ctx := context.Background()
+	txHash := hash(t, "0x32413f8cee053089d6d7026a72e4108262ca3cfe868dd9159bc1dd160aec975")
+	chainID := types.Data(types.ChainIDSepolia)
+
+	auxData := []types.FieldElement{
+		fieldElement(t, "0x3957f9f5a1cbfe918cedc2015c85200ca51a5f7506ecb6de98a5207b759bf8a"),
+		// Signer type.
+		{},
+		// Secp256r1 signer.
+		{}, {}, {}, {},
+		// Multisig threshold.
+		{},
+		// Withdrawal limit low, fee rate and STRK fee rate.
+		{}, {}, {},
+		types.MustFieldElementFromShortString(types.ChainIDSepolia),
+	}
+	auxFelts, err := crypto.FeltsFromFieldElements(auxData)
+	require.NoError(t, err)
+	auxHash := crypto.PoseidonArray(auxFelts...).FieldElement()
+
+	tests := []struct {
+		name     string
+		preset   account.Preset
+		signer   *signer
+		chainID  types.Data
+		expected types.Signature
+		err      string
+	}{
+		{
+			name:    "Unknown",
+			preset:  account.PresetUnknown,
+			signer:  &signer{},
+			chainID: chainID,
+			err:     "unsupported account preset unknown",
+		},
+		{
+			name:    "SignerFails",
+			preset:  account.PresetOpenZeppelin,
+			signer:  &signer{err: errors.New("mock")},
+			chainID: chainID,
+			err:     "failed to sign transaction\nmock",
+		},
+		{
+			name:     "OpenZeppelin",
+			preset:   account.PresetOpenZeppelin,
+			signer:   &signer{},
+			chainID:  chainID,
+			expected: types.Signature{types.FieldElement(txHash), types.FieldElementFromUint64(1)},
+		},
+		{
+			name:     "Argent",
+			preset:   account.PresetArgent,
+			signer:   &signer{},
+			chainID:  chainID,
+			expected: types.Signature{types.FieldElement(txHash), types.FieldElementFromUint64(1)},
+		},
+		{
+			name:   "BraavosChainIDMissing",
+			preset: account.PresetBraavos,
+			signer: &signer{},
+			err:    "invalid chain ID\nchain ID missing",
+		},
+		{
+			name:    "Braavos",
+			preset:  account.PresetBraavos,
+			signer:  &signer{},
+			chainID: chainID,
+			expected: append(append(types.Signature{types.FieldElement(txHash), types.FieldElementFromUint64(1)},
+				auxData...),
+				auxHash, types.FieldElementFromUint64(2),
+			),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := test.preset.DeploySignature(ctx, test.signer, txHash, test.chainID)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res)
+			}
+		})
+	}
+}
+
+func TestPresetJSON(t *testing.T) {
+	for _, preset := range []account.Preset{account.PresetOpenZeppelin, account.PresetArgent, account.PresetBraavos} {
+		data, err := preset.MarshalJSON()
+		require.NoError(t, err)
+
+		var res account.Preset
+		require.NoError(t, res.UnmarshalJSON(data))
+		require.Equal(t, preset, res)
+	}
+
+	var res account.Preset
+	require.EqualError(t, res.UnmarshalJSON([]byte(`"unknown"`)), `unrecognised account preset "unknown"`)
+}
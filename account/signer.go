@@ -0,0 +1,29 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package account
+
+import (
+	"context"
+
+	"github.com/attestantio/go-starknet-client/types"
+)
+
+// Signer signs hashes with the Stark key that controls an account.
+type Signer interface {
+	// PublicKey returns the public key of the signer.
+	PublicKey() types.FieldElement
+
+	// Sign signs the hash, returning the r and s values of the signature.
+	Sign(ctx context.Context, hash types.FieldElement) (types.FieldElement, types.FieldElement, error)
+}
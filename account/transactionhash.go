@@ -0,0 +1,159 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package account
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/attestantio/go-starknet-client/crypto"
+	"github.com/attestantio/go-starknet-client/spec"
+	"github.com/attestantio/go-starknet-client/types"
+)
+
+// deployAccountPrefix is the short string "deploy_account", used as a domain separator.
+var deployAccountPrefix = types.MustFieldElementFromShortString("deploy_account")
+
+// Resource names used when hashing resource bounds.
+var (
+	resourceL1Gas     = types.MustFieldElementFromShortString("L1_GAS")
+	resourceL2Gas     = types.MustFieldElementFromShortString("L2_GAS")
+	resourceL1DataGas = types.MustFieldElementFromShortString("L1_DATA")
+)
+
+// TransactionHash calculates the hash of a deploy account transaction, which
+// is the value signed by the account.
+func TransactionHash(tx *spec.DeployAccountV3Transaction, chainID types.Data) (types.Hash, error) {
+	if tx == nil {
+		return types.Hash{}, errors.New("no transaction supplied")
+	}
+
+	chainName, err := types.ChainIDName(chainID)
+	if err != nil {
+		return types.Hash{}, errors.Join(errors.New("invalid chain ID"), err)
+	}
+
+	chainIDElement, err := types.FieldElementFromShortString(chainName)
+	if err != nil {
+		return types.Hash{}, errors.Join(errors.New("invalid chain ID"), err)
+	}
+
+	contractAddress, err := crypto.ContractAddress(types.Address{},
+		tx.ContractAddressSalt,
+		tx.ClassHash,
+		tx.ConstructorCalldata,
+	)
+	if err != nil {
+		return types.Hash{}, errors.Join(errors.New("failed to calculate contract address"), err)
+	}
+
+	daMode, err := dataAvailabilityMode(tx.NonceDataAvailabilityMode, tx.FeeDataAvailabilityMode)
+	if err != nil {
+		return types.Hash{}, err
+	}
+
+	paymasterData, err := crypto.FeltsFromFieldElements(tx.PaymasterData)
+	if err != nil {
+		return types.Hash{}, errors.Join(errors.New("invalid paymaster data"), err)
+	}
+
+	calldata, err := crypto.FeltsFromFieldElements(tx.ConstructorCalldata)
+	if err != nil {
+		return types.Hash{}, errors.Join(errors.New("invalid constructor calldata"), err)
+	}
+
+	elements, err := crypto.FeltsFromFieldElements([]types.FieldElement{
+		deployAccountPrefix,
+		types.FieldElement(contractAddress),
+		chainIDElement,
+		types.FieldElement(tx.ClassHash),
+		tx.ContractAddressSalt,
+	})
+	if err != nil {
+		return types.Hash{}, err
+	}
+
+	hash := crypto.PoseidonArray(
+		elements[0],
+		types.FeltFromUint64(3),
+		elements[1],
+		feesHash(tx.Tip, &tx.ResourceBounds),
+		crypto.PoseidonArray(paymasterData...),
+		elements[2],
+		types.FeltFromUint64(uint64(tx.Nonce)),
+		daMode,
+		crypto.PoseidonArray(calldata...),
+		elements[3],
+		elements[4],
+	)
+
+	return types.Hash(hash.FieldElement()), nil
+}
+
+// feesHash hashes the tip and resource bounds of a transaction.
+// L1 data gas bounds are only included if present, as transactions that
+// predate Starknet 0.13.4 were hashed without them.
+func feesHash(tip types.Number, bounds *spec.ResourceBounds) types.Felt {
+	elements := []types.Felt{
+		types.FeltFromUint64(uint64(tip)),
+		resourceBoundFelt(resourceL1Gas, &bounds.L1Gas),
+		resourceBoundFelt(resourceL2Gas, &bounds.L2Gas),
+	}
+	if bounds.L1DataGas != nil {
+		elements = append(elements, resourceBoundFelt(resourceL1DataGas, bounds.L1DataGas))
+	}
+
+	return crypto.PoseidonArray(elements...)
+}
+
+// resourceBoundFelt packs a resource bound into a single felt, with the
+// resource name in the top bits followed by the maximum amount and price.
+func resourceBoundFelt(name types.FieldElement, bound *spec.ResourceBound) types.Felt {
+	res := new(big.Int).Lsh(name.BigInt(), 192)
+	res.Or(res, new(big.Int).Lsh(new(big.Int).SetUint64(uint64(bound.MaxAmount)), 128))
+	res.Or(res, new(big.Int).SetUint64(uint64(bound.MaxPricePerUnit)))
+
+	// Names are at most 7 characters, so the result is always within the field.
+	felt, _ := types.FeltFromBigInt(res)
+
+	return felt
+}
+
+// dataAvailabilityMode packs the nonce and fee data availability modes into a single felt.
+func dataAvailabilityMode(nonceMode spec.TxDAMode, feeMode spec.TxDAMode) (types.Felt, error) {
+	nonceValue, err := dataAvailabilityModeValue(nonceMode)
+	if err != nil {
+		return types.Felt{}, errors.Join(errors.New("invalid nonce data availability mode"), err)
+	}
+
+	feeValue, err := dataAvailabilityModeValue(feeMode)
+	if err != nil {
+		return types.Felt{}, errors.Join(errors.New("invalid fee data availability mode"), err)
+	}
+
+	return types.FeltFromUint64(nonceValue<<32 + feeValue), nil
+}
+
+// dataAvailabilityModeValue returns the value of a data availability mode as used in hashes.
+func dataAvailabilityModeValue(mode spec.TxDAMode) (uint64, error) {
+	switch mode {
+	case spec.TxDAModeL1:
+		return 0, nil
+	case spec.TxDAModeL2:
+		return 1, nil
+	default:
+		return 0, fmt.Errorf("unsupported data availability mode %v", mode)
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package account_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-starknet-client/account"
+	"github.com/attestantio/go-starknet-client/spec"
+	"github.com/attestantio/go-starknet-client/types"
+	"github.com/stretchr/testify/require"
+)
+
+func fieldElement(t *testing.T, input string) types.FieldElement {
+	t.Helper()
+
+	res, err := new(types.FieldElement).Parse(input)
+	require.NoError(t, err)
+
+	return *res
+}
+
+func hash(t *testing.T, input string) types.Hash {
+	t.Helper()
+
+	res, err := new(types.Hash).Parse(input)
+	require.NoError(t, err)
+
+	return *res
+}
+
+func TestTransactionHash(t *testing.T) {
+	tests := []struct {
+		name     string
+		tx       *spec.DeployAccountV3Transaction
+		chainID  types.Data
+		expected string
+		err      string
+	}{
+		{
+			name:    "Nil",
+			chainID: types.Data(types.ChainIDSepolia),
+			err:     "no transaction supplied",
+		},
+		{
+			name: "ChainIDMissing",
+			tx:   &spec.DeployAccountV3Transaction{},
+			err:  "invalid chain ID\nchain ID missing",
+		},
+		{
+			name: "DAModeInvalid",
+			tx: &spec.DeployAccountV3Transaction{
+				NonceDataAvailabilityMode: spec.TxDAModeL1,
+			},
+			chainID: types.Data(types.ChainIDSepolia),
+			err:     "invalid fee data availability mode\nunsupported data availability mode UNKNOWN",
+		},
+		{
+			// Sepolia deploy account transaction with non-default tip and fee data availability mode.
+			name: "Good",
+			tx: &spec.DeployAccountV3Transaction{
+				Type:                spec.TransactionTypeDeployAccount,
+				Version:             spec.TransactionVersion3,
+				Nonce:               1,
+				ContractAddressSalt: fieldElement(t, "0x520b540d51c06e1539cbc42e93a37cbef534082c75a3991179cfac83da67fdb"),
+				ClassHash:           hash(t, "0x26ec026985a3bf9d0cc1fe17326b245dfdc3ff89b8fde106542a3ea56c5a918"),
+				ConstructorCalldata: []types.FieldElement{
+					fieldElement(t, "0x33444ad846cdd5f23eb73ff09fe6fddd568284a0fb7d1be20ee482f044dabe2"),
+					fieldElement(t, "0x79dc0da7c54b95f10aa182ad0a46400db63156920adb65eca2654c0945a463"),
+					fieldElement(t, "0x2"),
+					fieldElement(t, "0x510b540d51c06e1539cbc42e93a37cbef534082c75a3991179cfac83da67fdb"),
+					fieldElement(t, "0x0"),
+				},
+				ResourceBounds: spec.ResourceBounds{
+					L1Gas: spec.ResourceBound{
+						MaxAmount:       0x6fde2b4eb000,
+						MaxPricePerUnit: 0x6fde2b4eb000,
+					},
+				},
+				Tip:                       1,
+				PaymasterData:             []types.FieldElement{},
+				NonceDataAvailabilityMode: spec.TxDAModeL1,
+				FeeDataAvailabilityMode:   spec.TxDAModeL2,
+			},
+			chainID:  types.Data(types.ChainIDSepolia),
+			expected: "0x7ed2723f72842192aea186a6b6f388fbe7b305e450d3ebd6da6b13ff1b59353",
+		},
+		{
+			// Sepolia deploy account transaction with L1 data gas resource bounds.
+			name: "L1DataGas",
+			tx: &spec.DeployAccountV3Transaction{
+				Type:                spec.TransactionTypeDeployAccount,
+				Version:             spec.TransactionVersion3,
+				Nonce:               0,
+				ContractAddressSalt: fieldElement(t, "0x2e94ba2293dfa45f86dfcf9952d7a33dc50ce2b00b932999fbe0844772604f3"),
+				ClassHash:           hash(t, "0x61dac032f228abef9c6626f995015233097ae253a7f72d68552db02f2971b8f"),
+				ConstructorCalldata: []types.FieldElement{
+					fieldElement(t, "0x2e94ba2293dfa45f86dfcf9952d7a33dc50ce2b00b932999fbe0844772604f3"),
+				},
+				ResourceBounds: spec.ResourceBounds{
+					L1Gas: spec.ResourceBound{
+						MaxAmount:       0x0,
+						MaxPricePerUnit: 0x1597b3274d88,
+					},
+					L1DataGas: &spec.ResourceBound{
+						MaxAmount:       0x210,
+						MaxPricePerUnit: 0x97c,
+					},
+					L2Gas: spec.ResourceBound{
+						MaxAmount:       0xe6fa0,
+						MaxPricePerUnit: 0x1920d1317,
+					},
+				},
+				PaymasterData:             []types.FieldElement{},
+				NonceDataAvailabilityMode: spec.TxDAModeL1,
+				FeeDataAvailabilityMode:   spec.TxDAModeL1,
+			},
+			chainID:  types.Data(types.ChainIDSepolia),
+			expected: "0x32413f8cee053089d6d7026a72e4108262ca3cfe868dd9159bc1dd160aec975",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := account.TransactionHash(test.tx, test.chainID)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res.String())
+			}
+		})
+	}
+}
@@ -20,12 +20,17 @@ type CallOpts struct {
 	Common CommonOpts
 
 	// Block is the block for which the data is obtained.
-	// It can be a block number, block hash, or one of the special values "latest" or "pending".
+	// It can be a block number, block hash, or a block tag such as "latest".
 	Block types.BlockID
 
 	// Contact is the contract for which the data is obtained.
 	Contract types.Address
 
+	// EntryPoint is the name of the entry point for the call.
+	// If supplied it is resolved to its selector before the call is made,
+	// in which case EntryPointSelector must not be supplied.
+	EntryPoint string
+
 	// EntryPointSelector defines the entry point for the call.
 	EntryPointSelector types.FieldElement
 
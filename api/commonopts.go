@@ -20,4 +20,9 @@ type CommonOpts struct {
 	// Timeout is a specific timeout for this call.
 	// If 0 then the default timeout is used.
 	Timeout time.Duration
+
+	// AllowUnsynced allows the call to be made to a node that is not synced.
+	// This is intended for reads of finalised data, which are unaffected by
+	// the node lagging the chain head.
+	AllowUnsynced bool
 }
@@ -23,7 +23,7 @@ type EstimateFeeOpts struct {
 	Common CommonOpts
 
 	// Block is the block at which the fee is estimated.
-	// It can be a block number, block hash, or one of the special values "latest" or "pending".
+	// It can be a block number, block hash, or a block tag such as "latest".
 	Block types.BlockID
 
 	// Transaction is the transaction for which to estimate fees.
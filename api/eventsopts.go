@@ -17,6 +17,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/attestantio/go-starknet-client/crypto"
 	"github.com/attestantio/go-starknet-client/types"
 )
 
@@ -28,7 +29,7 @@ type EventsOpts struct {
 	FromBlock types.BlockID
 
 	// ToBlock is the latest block from which the events are obtained.
-	// It can be a block number, block hash, or one of the special values "latest" or "pending".
+	// It can be a block number, block hash, or a block tag such as "latest".
 	ToBlock types.BlockID
 
 	// Address is the contract address from which the events are obtained.
@@ -42,6 +43,12 @@ type EventsOpts struct {
 	// If empty then there is no key filter on returned events.
 	Keys [][]types.FieldElement
 
+	// KeyNames are event names that are resolved to their selectors.
+	// Each list corresponds to a location in the keys, and its selectors are
+	// added to any values supplied for the same location in Keys.
+	// For example, [["Transfer","Approval"]] will return any Transfer or Approval event.
+	KeyNames [][]string
+
 	// Limit is the maximum number of events to return.
 	// This value must be provided.
 	Limit uint32
@@ -58,9 +65,9 @@ func (o *EventsOpts) MarshalJSON() ([]byte, error) {
 		filter["address"] = o.Address.String()
 	}
 
-	if len(o.Keys) > 0 {
-		keys := make([][]string, 0, len(o.Keys))
-		for _, keySet := range o.Keys {
+	if resolvedKeys := o.resolvedKeys(); len(resolvedKeys) > 0 {
+		keys := make([][]string, 0, len(resolvedKeys))
+		for _, keySet := range resolvedKeys {
 			set := make([]string, 0, len(keySet))
 			for _, key := range keySet {
 				set = append(set, key.String())
@@ -79,6 +86,26 @@ func (o *EventsOpts) MarshalJSON() ([]byte, error) {
 	return json.Marshal(eventsOpts)
 }
 
+// resolvedKeys returns the keys with the selectors for any key names added.
+func (o *EventsOpts) resolvedKeys() [][]types.FieldElement {
+	if len(o.KeyNames) == 0 {
+		return o.Keys
+	}
+
+	keys := make([][]types.FieldElement, max(len(o.Keys), len(o.KeyNames)))
+	for i := range o.Keys {
+		keys[i] = append(keys[i], o.Keys[i]...)
+	}
+
+	for i, names := range o.KeyNames {
+		for _, name := range names {
+			keys[i] = append(keys[i], crypto.SelectorFromName(name))
+		}
+	}
+
+	return keys
+}
+
 // String returns a string version of the structure.
 func (o *EventsOpts) String() string {
 	data, err := o.MarshalJSON()
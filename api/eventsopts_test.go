@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	"github.com/attestantio/go-starknet-client/api"
+	"github.com/attestantio/go-starknet-client/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -70,6 +71,27 @@ func TestEventsOptsMarshalJSON(t *testing.T) {
 			},
 			expected: []byte(`{"filter":{"address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","chunk_size":5,"from_block":{"block_number":1},"to_block":{"block_number":2}}}`),
 		},
+		{
+			name: "KeyNames",
+			input: &api.EventsOpts{
+				FromBlock: "1",
+				ToBlock:   "2",
+				Limit:     5,
+				KeyNames:  [][]string{{"Transfer"}},
+			},
+			expected: []byte(`{"filter":{"chunk_size":5,"from_block":{"block_number":1},"keys":[["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]],"to_block":{"block_number":2}}}`),
+		},
+		{
+			name: "KeysAndKeyNames",
+			input: &api.EventsOpts{
+				FromBlock: "1",
+				ToBlock:   "2",
+				Limit:     5,
+				Keys:      [][]types.FieldElement{{*new(types.FieldElement).MustParse("0x1")}, {*new(types.FieldElement).MustParse("0x2")}},
+				KeyNames:  [][]string{{"Transfer"}},
+			},
+			expected: []byte(`{"filter":{"chunk_size":5,"from_block":{"block_number":1},"keys":[["0x1","0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"],["0x2"]],"to_block":{"block_number":2}}}`),
+		},
 	}
 
 	for _, test := range tests {
@@ -17,17 +17,22 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/attestantio/go-starknet-client/spec"
 	"github.com/attestantio/go-starknet-client/types"
 )
 
 // FeeEstimate contains a fee estimate.
+// L2 gas is reported separately from RPC 0.8; prior to that it is zero and
+// the L1 gas values cover all execution.
 type FeeEstimate struct {
-	GasConsumed     types.Number `json:"gas_consumed"`
-	GasPrice        types.Number `json:"gas_price"`
-	DataGasConsumed types.Number `json:"data_gas_consumed"`
-	DataGasPrice    types.Number `json:"data_gas_price"`
-	OverallFee      types.Number `json:"overall_fee"`
-	Unit            string       `json:"unit"`
+	L1GasConsumed     types.Number `json:"l1_gas_consumed"`
+	L1GasPrice        types.Number `json:"l1_gas_price"`
+	L1DataGasConsumed types.Number `json:"l1_data_gas_consumed"`
+	L1DataGasPrice    types.Number `json:"l1_data_gas_price"`
+	L2GasConsumed     types.Number `json:"l2_gas_consumed"`
+	L2GasPrice        types.Number `json:"l2_gas_price"`
+	OverallFee        types.Number `json:"overall_fee"`
+	Unit              spec.FeeUnit `json:"unit"`
 }
 
 // String returns a string version of the structure.
@@ -15,6 +15,7 @@ package api
 
 import (
 	"github.com/attestantio/go-starknet-client/spec"
+	"github.com/attestantio/go-starknet-client/types"
 )
 
 // SubmitTransactionOpts are the options for transactions.
@@ -23,4 +24,8 @@ type SubmitTransactionOpts struct {
 
 	// Transaction is the transaction to send.
 	Transaction *spec.Transaction
+
+	// ChainID is the chain ID with which the transaction was signed.
+	// If supplied, the transaction is not submitted to a node serving a different chain.
+	ChainID types.Data
 }
@@ -22,7 +22,10 @@ import (
 
 // SubmitTransactionResponse is the response from SubmitTransaction.
 type SubmitTransactionResponse struct {
+	// TransactionHash is the hash of the submitted transaction.
 	TransactionHash types.Hash `json:"transaction_hash"`
+	// ContractAddress is the address of the deployed account, for deploy account transactions.
+	ContractAddress *types.Address `json:"contract_address,omitempty"`
 }
 
 // String returns a string version of the structure.
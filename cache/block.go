@@ -0,0 +1,84 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	client "github.com/attestantio/go-starknet-client"
+	"github.com/attestantio/go-starknet-client/api"
+	"github.com/attestantio/go-starknet-client/spec"
+)
+
+// Block returns the block as per the given parameters.
+//
+// Blocks are cached once they are accepted on L1, and can be obtained from
+// the cache by either their hash or number.
+func (s *Service) Block(ctx context.Context,
+	opts *api.BlockOpts,
+) (
+	*api.Response[*spec.Block],
+	error,
+) {
+	provider, isProvider := s.client.(client.BlockProvider)
+	if !isProvider {
+		return nil, errNotProvider
+	}
+
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	res, err := cachedCall(ctx, s, "Block", blockKey(opts), func(ctx context.Context) (*api.Response[*spec.Block], error) {
+		return provider.Block(ctx, opts)
+	}, finalisedBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	if finalisedBlock(res.Data) && res.Data.BlockHash != nil {
+		// Also make the block available by its hash.
+		hashKey := fmt.Sprintf("block:%s", res.Data.BlockHash.String())
+		if _, exists := s.get(hashKey); !exists {
+			if value, err := json.Marshal(res.Data); err == nil {
+				s.put(hashKey, value)
+			}
+		}
+	}
+
+	return res, nil
+}
+
+// blockKey returns the cache key for a block, or an empty string if it cannot be cached.
+func blockKey(opts *api.BlockOpts) string {
+	if block := immutableBlock(opts.Block); block != "" {
+		return fmt.Sprintf("block:%s", block)
+	}
+
+	// Blocks addressed by number are cached once they have been finalised.
+	if number, isNumber := opts.Block.Number(); isNumber {
+		return fmt.Sprintf("block:%d", number)
+	}
+
+	return ""
+}
+
+// finalisedBlock returns true if the block can no longer change.
+func finalisedBlock(block *spec.Block) bool {
+	return block != nil &&
+		block.Status != nil &&
+		*block.Status == spec.FinalityStatusAcceptedOnL1
+}
@@ -0,0 +1,68 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	client "github.com/attestantio/go-starknet-client"
+	"github.com/attestantio/go-starknet-client/api"
+	"github.com/attestantio/go-starknet-client/crypto"
+	"github.com/attestantio/go-starknet-client/types"
+)
+
+// Call makes a call to the client.
+//
+// Calls are cached if they are made against a block addressed by hash.
+func (s *Service) Call(ctx context.Context,
+	opts *api.CallOpts,
+) (
+	*api.Response[[]types.FieldElement],
+	error,
+) {
+	provider, isProvider := s.client.(client.CallProvider)
+	if !isProvider {
+		return nil, errNotProvider
+	}
+
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	return cachedCall(ctx, s, "Call", callKey(opts), func(ctx context.Context) (*api.Response[[]types.FieldElement], error) {
+		return provider.Call(ctx, opts)
+	}, always)
+}
+
+// callKey returns the cache key for a call, or an empty string if it cannot be cached.
+func callKey(opts *api.CallOpts) string {
+	block := immutableBlock(opts.Block)
+	if block == "" {
+		return ""
+	}
+
+	selector := opts.EntryPointSelector
+	if opts.EntryPoint != "" {
+		selector = crypto.SelectorFromName(opts.EntryPoint)
+	}
+
+	calldata := make([]string, 0, len(opts.Calldata))
+	for i := range opts.Calldata {
+		calldata = append(calldata, opts.Calldata[i].String())
+	}
+
+	return fmt.Sprintf("call:%s:%s:%s:%s", block, opts.Contract.String(), selector.String(), strings.Join(calldata, ","))
+}
@@ -0,0 +1,149 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+)
+
+// persistedEntry is the on-disk representation of a cache entry.
+type persistedEntry struct {
+	Key   string          `json:"key"`
+	Value json.RawMessage `json:"value"`
+}
+
+// diskStore persists cache entries to a directory, one file per entry.
+type diskStore struct {
+	dir string
+}
+
+func newDiskStore(dir string) (*diskStore, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, errors.Join(errors.New("failed to create persistence directory"), err)
+	}
+
+	return &diskStore{
+		dir: dir,
+	}, nil
+}
+
+// load returns the persisted entries, least recently written first.
+func (d *diskStore) load() ([]*persistedEntry, error) {
+	dirEntries, err := os.ReadDir(d.dir)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to read persistence directory"), err)
+	}
+
+	type timedEntry struct {
+		entry   *persistedEntry
+		modTime time.Time
+	}
+	timedEntries := make([]*timedEntry, 0, len(dirEntries))
+	for _, dirEntry := range dirEntries {
+		if dirEntry.IsDir() || !strings.HasSuffix(dirEntry.Name(), ".json") {
+			continue
+		}
+
+		info, err := dirEntry.Info()
+		if err != nil {
+			continue
+		}
+
+		data, err := os.ReadFile(filepath.Join(d.dir, dirEntry.Name()))
+		if err != nil {
+			return nil, errors.Join(errors.New("failed to read persisted entry"), err)
+		}
+
+		var entry persistedEntry
+		if err := json.Unmarshal(data, &entry); err != nil {
+			// Corrupt entry; remove it.
+			_ = os.Remove(filepath.Join(d.dir, dirEntry.Name()))
+
+			continue
+		}
+
+		timedEntries = append(timedEntries, &timedEntry{
+			entry:   &entry,
+			modTime: info.ModTime(),
+		})
+	}
+
+	slices.SortStableFunc(timedEntries, func(a, b *timedEntry) int {
+		return a.modTime.Compare(b.modTime)
+	})
+
+	res := make([]*persistedEntry, 0, len(timedEntries))
+	for _, timedEntry := range timedEntries {
+		res = append(res, timedEntry.entry)
+	}
+
+	return res, nil
+}
+
+// store persists an entry.
+func (d *diskStore) store(key string, value []byte) error {
+	data, err := json.Marshal(&persistedEntry{
+		Key:   key,
+		Value: value,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file and rename, to avoid partial entries.  The
+	// temporary file is unique, as entries can be stored concurrently.
+	path := d.path(key)
+	tmp, err := os.CreateTemp(d.dir, filepath.Base(path)+"-*.tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+// remove removes a persisted entry.
+func (d *diskStore) remove(key string) error {
+	err := os.Remove(d.path(key))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+
+	return err
+}
+
+// path returns the path of the file for the key.
+func (d *diskStore) path(key string) string {
+	hash := sha256.Sum256([]byte(key))
+
+	return filepath.Join(d.dir, hex.EncodeToString(hash[:])+".json")
+}
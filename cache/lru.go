@@ -0,0 +1,83 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import "container/list"
+
+// lru is a bounded cache that evicts its least recently used entries.
+// It is not safe for concurrent use.
+type lru struct {
+	maxEntries int
+	entries    *list.List
+	index      map[string]*list.Element
+}
+
+// lruEntry is an entry in the cache.
+type lruEntry struct {
+	key   string
+	value []byte
+}
+
+func newLRU(maxEntries int) *lru {
+	return &lru{
+		maxEntries: maxEntries,
+		entries:    list.New(),
+		index:      make(map[string]*list.Element),
+	}
+}
+
+// get returns the value for the key, marking it as recently used.
+func (l *lru) get(key string) ([]byte, bool) {
+	element, exists := l.index[key]
+	if !exists {
+		return nil, false
+	}
+	l.entries.MoveToFront(element)
+
+	//nolint:forcetypeassert
+	return element.Value.(*lruEntry).value, true
+}
+
+// put sets the value for the key, returning the keys of any evicted entries.
+func (l *lru) put(key string, value []byte) []string {
+	if element, exists := l.index[key]; exists {
+		//nolint:forcetypeassert
+		element.Value.(*lruEntry).value = value
+		l.entries.MoveToFront(element)
+
+		return nil
+	}
+
+	l.index[key] = l.entries.PushFront(&lruEntry{
+		key:   key,
+		value: value,
+	})
+
+	evicted := make([]string, 0)
+	for l.entries.Len() > l.maxEntries {
+		element := l.entries.Back()
+		l.entries.Remove(element)
+		//nolint:forcetypeassert
+		evictedKey := element.Value.(*lruEntry).key
+		delete(l.index, evictedKey)
+		evicted = append(evicted, evictedKey)
+	}
+
+	return evicted
+}
+
+// len returns the number of entries in the cache.
+func (l *lru) len() int {
+	return l.entries.Len()
+}
@@ -0,0 +1,85 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"errors"
+
+	"github.com/attestantio/go-starknet-client/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	requestsMetric *prometheus.CounterVec
+	entriesMetric  prometheus.Gauge
+)
+
+func registerMetrics(ctx context.Context, monitor metrics.Service) error {
+	if requestsMetric != nil {
+		// Already registered.
+		return nil
+	}
+
+	if monitor == nil {
+		// No monitor.
+		return nil
+	}
+
+	if monitor.Presenter() == "prometheus" {
+		return registerPrometheusMetrics(ctx)
+	}
+
+	return nil
+}
+
+func registerPrometheusMetrics(_ context.Context) error {
+	requestsMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "starknetclient",
+		Subsystem: "cache",
+		Name:      "requests_total",
+		Help:      "The number of cacheable requests by result (hit/miss)",
+	}, []string{"method", "result"})
+	if err := prometheus.Register(requestsMetric); err != nil {
+		return errors.Join(errors.New("failed to register requests"), err)
+	}
+
+	entriesMetric = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "starknetclient",
+		Subsystem: "cache",
+		Name:      "entries",
+		Help:      "The number of entries in the cache",
+	})
+	if err := prometheus.Register(entriesMetric); err != nil {
+		return errors.Join(errors.New("failed to register entries"), err)
+	}
+
+	return nil
+}
+
+func monitorRequest(method string, result string) {
+	if requestsMetric == nil {
+		return
+	}
+
+	requestsMetric.WithLabelValues(method, result).Inc()
+}
+
+func monitorEntries(entries int) {
+	if entriesMetric == nil {
+		return
+	}
+
+	entriesMetric.Set(float64(entries))
+}
@@ -0,0 +1,50 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"fmt"
+
+	client "github.com/attestantio/go-starknet-client"
+	"github.com/attestantio/go-starknet-client/api"
+)
+
+// Nonce returns the nonce of the given contract at the given block.
+//
+// Nonces are cached if they are obtained for a block addressed by hash.
+func (s *Service) Nonce(ctx context.Context,
+	opts *api.NonceOpts,
+) (
+	*api.Response[uint32],
+	error,
+) {
+	provider, isProvider := s.client.(client.NonceProvider)
+	if !isProvider {
+		return nil, errNotProvider
+	}
+
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	key := ""
+	if block := immutableBlock(opts.Block); block != "" {
+		key = fmt.Sprintf("nonce:%s:%s", block, opts.Contract.String())
+	}
+
+	return cachedCall(ctx, s, "Nonce", key, func(ctx context.Context) (*api.Response[uint32], error) {
+		return provider.Nonce(ctx, opts)
+	}, always)
+}
@@ -0,0 +1,164 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+
+	client "github.com/attestantio/go-starknet-client"
+	"github.com/attestantio/go-starknet-client/api"
+	"github.com/attestantio/go-starknet-client/spec"
+	"github.com/attestantio/go-starknet-client/types"
+)
+
+// The functions in this file are passed through to the underlying client
+// without caching, as their results are not immutable.
+
+// BlockHashAndNumber returns the hash and number of the latest block as understood by the node.
+func (s *Service) BlockHashAndNumber(ctx context.Context,
+	opts *api.BlockHashAndNumberOpts,
+) (
+	*api.Response[*api.BlockHashAndNumber],
+	error,
+) {
+	provider, isProvider := s.client.(client.BlockHashAndNumberProvider)
+	if !isProvider {
+		return nil, errNotProvider
+	}
+
+	return provider.BlockHashAndNumber(ctx, opts)
+}
+
+// BlockNumber returns the number of the latest block as understood by the node.
+func (s *Service) BlockNumber(ctx context.Context,
+	opts *api.BlockNumberOpts,
+) (
+	*api.Response[uint32],
+	error,
+) {
+	provider, isProvider := s.client.(client.BlockNumberProvider)
+	if !isProvider {
+		return nil, errNotProvider
+	}
+
+	return provider.BlockNumber(ctx, opts)
+}
+
+// ChainID returns the chain ID.
+//
+// The chain ID is not cached, as the node at an address may come to serve a
+// different chain.
+func (s *Service) ChainID(ctx context.Context,
+	opts *api.ChainIDOpts,
+) (
+	*api.Response[types.Data],
+	error,
+) {
+	provider, isProvider := s.client.(client.ChainIDProvider)
+	if !isProvider {
+		return nil, errNotProvider
+	}
+
+	return provider.ChainID(ctx, opts)
+}
+
+// EstimateFee estimates the fee for a transaction.
+func (s *Service) EstimateFee(ctx context.Context,
+	opts *api.EstimateFeeOpts,
+) (
+	*api.Response[[]api.FeeEstimate],
+	error,
+) {
+	provider, isProvider := s.client.(client.EstimateFeeProvider)
+	if !isProvider {
+		return nil, errNotProvider
+	}
+
+	return provider.EstimateFee(ctx, opts)
+}
+
+// Events returns the events matching the filter.
+func (s *Service) Events(ctx context.Context,
+	opts *api.EventsOpts,
+) (
+	*api.Response[[]*spec.TransactionEvent],
+	error,
+) {
+	provider, isProvider := s.client.(client.EventsProvider)
+	if !isProvider {
+		return nil, errNotProvider
+	}
+
+	return provider.Events(ctx, opts)
+}
+
+// ProtocolVersion returns the protocol version of the node.
+func (s *Service) ProtocolVersion(ctx context.Context,
+	opts *api.ProtocolVersionOpts,
+) (
+	*api.Response[uint32],
+	error,
+) {
+	provider, isProvider := s.client.(client.ProtocolVersionProvider)
+	if !isProvider {
+		return nil, errNotProvider
+	}
+
+	return provider.ProtocolVersion(ctx, opts)
+}
+
+// SpecVersion returns the version of the specification followed by the node.
+func (s *Service) SpecVersion(ctx context.Context,
+	opts *api.SpecVersionOpts,
+) (
+	*api.Response[string],
+	error,
+) {
+	provider, isProvider := s.client.(client.SpecVersionProvider)
+	if !isProvider {
+		return nil, errNotProvider
+	}
+
+	return provider.SpecVersion(ctx, opts)
+}
+
+// Syncing obtains information about the sync state of the node.
+func (s *Service) Syncing(ctx context.Context,
+	opts *api.SyncingOpts,
+) (
+	*api.Response[*api.SyncState],
+	error,
+) {
+	provider, isProvider := s.client.(client.SyncingProvider)
+	if !isProvider {
+		return nil, errNotProvider
+	}
+
+	return provider.Syncing(ctx, opts)
+}
+
+// SubmitTransaction submits a transaction to the client.
+func (s *Service) SubmitTransaction(ctx context.Context,
+	opts *api.SubmitTransactionOpts,
+) (
+	*api.Response[*api.SubmitTransactionResponse],
+	error,
+) {
+	provider, isProvider := s.client.(client.TransactionSubmitter)
+	if !isProvider {
+		return nil, errNotProvider
+	}
+
+	return provider.SubmitTransaction(ctx, opts)
+}
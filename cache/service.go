@@ -0,0 +1,240 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cache provides a client that caches responses from an underlying
+// client where they are keyed by immutable identifiers.
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+
+	client "github.com/attestantio/go-starknet-client"
+	"github.com/attestantio/go-starknet-client/api"
+	"github.com/attestantio/go-starknet-client/types"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// errNotProvider is returned when the underlying client does not provide the function called.
+var errNotProvider = errors.New("client does not provide function")
+
+// connectionStateProvider is implemented by clients that track the state of their connection.
+type connectionStateProvider interface {
+	// CheckConnectionState checks the connection state for the client.
+	CheckConnectionState(ctx context.Context)
+	// IsActive returns true if the client is active.
+	IsActive() bool
+	// IsSynced returns true if the client is synced.
+	IsSynced() bool
+}
+
+// Service is a client that caches responses from an underlying client.
+type Service struct {
+	log    zerolog.Logger
+	client client.Service
+	// entriesMu protects entries.
+	entriesMu sync.Mutex
+	entries   *lru
+	disk      *diskStore
+}
+
+// New creates a new caching client service.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "client").Str("impl", "cache").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	if parameters.monitor != nil {
+		if err := registerMetrics(ctx, parameters.monitor); err != nil {
+			return nil, errors.Join(errors.New("failed to register metrics"), err)
+		}
+	}
+
+	s := &Service{
+		log:     log,
+		client:  parameters.client,
+		entries: newLRU(parameters.maxEntries),
+	}
+
+	if parameters.persistenceDir != "" {
+		if err := s.loadPersisted(parameters.persistenceDir); err != nil {
+			return nil, err
+		}
+	}
+	monitorEntries(s.entries.len())
+
+	return s, nil
+}
+
+// Name provides the name of the service.
+func (*Service) Name() string {
+	return "cache"
+}
+
+// Address provides the address of the underlying client.
+func (s *Service) Address() string {
+	return s.client.Address()
+}
+
+// CheckConnectionState checks the connection state of the underlying client.
+func (s *Service) CheckConnectionState(ctx context.Context) {
+	if provider, isProvider := s.client.(connectionStateProvider); isProvider {
+		provider.CheckConnectionState(ctx)
+	}
+}
+
+// IsActive returns true if the underlying client is active.
+func (s *Service) IsActive() bool {
+	provider, isProvider := s.client.(connectionStateProvider)
+
+	return !isProvider || provider.IsActive()
+}
+
+// IsSynced returns true if the underlying client is synced.
+func (s *Service) IsSynced() bool {
+	provider, isProvider := s.client.(connectionStateProvider)
+
+	return !isProvider || provider.IsSynced()
+}
+
+// loadPersisted loads persisted entries in to the cache.
+func (s *Service) loadPersisted(dir string) error {
+	disk, err := newDiskStore(dir)
+	if err != nil {
+		return err
+	}
+
+	entries, err := disk.load()
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		for _, evicted := range s.entries.put(entry.Key, entry.Value) {
+			if err := disk.remove(evicted); err != nil {
+				s.log.Warn().Err(err).Msg("Failed to remove persisted entry")
+			}
+		}
+	}
+	s.disk = disk
+	s.log.Trace().Int("entries", s.entries.len()).Msg("Loaded persisted entries")
+
+	return nil
+}
+
+// get obtains an entry from the cache.
+func (s *Service) get(key string) ([]byte, bool) {
+	s.entriesMu.Lock()
+	defer s.entriesMu.Unlock()
+
+	return s.entries.get(key)
+}
+
+// put adds an entry to the cache.
+func (s *Service) put(key string, value []byte) {
+	s.entriesMu.Lock()
+	evicted := s.entries.put(key, value)
+	monitorEntries(s.entries.len())
+	s.entriesMu.Unlock()
+
+	// Disk operations are carried out without holding the lock, so that they
+	// do not block readers of the cache.
+	if s.disk == nil {
+		return
+	}
+
+	if err := s.disk.store(key, value); err != nil {
+		s.log.Warn().Err(err).Msg("Failed to persist entry")
+	}
+	for _, key := range evicted {
+		if err := s.disk.remove(key); err != nil {
+			s.log.Warn().Err(err).Msg("Failed to remove persisted entry")
+		}
+	}
+}
+
+// cachedCall returns the cached data for the key if present, otherwise
+// carrying out the call and caching its data if it is immutable.  An empty
+// key means that the request does not refer to immutable data, and so is
+// never cached.
+func cachedCall[T any](ctx context.Context,
+	s *Service,
+	method string,
+	key string,
+	call func(ctx context.Context) (*api.Response[T], error),
+	immutable func(data T) bool,
+) (
+	*api.Response[T],
+	error,
+) {
+	if key == "" {
+		return call(ctx)
+	}
+
+	if value, exists := s.get(key); exists {
+		var data T
+		if err := json.Unmarshal(value, &data); err == nil {
+			monitorRequest(method, "hit")
+
+			return &api.Response[T]{
+				Data:     data,
+				Metadata: map[string]any{},
+			}, nil
+		}
+		s.log.Debug().Str("key", key).Msg("Failed to decode cached entry; refetching")
+	}
+	monitorRequest(method, "miss")
+
+	res, err := call(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if immutable(res.Data) {
+		value, err := json.Marshal(res.Data)
+		if err != nil {
+			s.log.Debug().Err(err).Msg("Failed to encode entry")
+		} else {
+			s.put(key, value)
+		}
+	}
+
+	return res, nil
+}
+
+// always returns true, for data that is always immutable.
+func always[T any](_ T) bool {
+	return true
+}
+
+// immutableBlock returns a key component for the block if it refers to an
+// immutable block, otherwise an empty string.  Only blocks addressed by hash
+// are immutable, as blocks addressed by number can be replaced by a reorg.
+func immutableBlock(block types.BlockID) string {
+	hash, isHash := block.Hash()
+	if !isHash {
+		return ""
+	}
+
+	return hash.String()
+}
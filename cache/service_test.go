@@ -0,0 +1,397 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	client "github.com/attestantio/go-starknet-client"
+	"github.com/attestantio/go-starknet-client/api"
+	"github.com/attestantio/go-starknet-client/cache"
+	"github.com/attestantio/go-starknet-client/mock"
+	"github.com/attestantio/go-starknet-client/spec"
+	"github.com/attestantio/go-starknet-client/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// countingClient counts the calls made to it.
+type countingClient struct {
+	*mock.Service
+	status spec.FinalityStatus
+	calls  atomic.Int32
+}
+
+func newCountingClient(status spec.FinalityStatus) *countingClient {
+	return &countingClient{
+		Service: &mock.Service{},
+		status:  status,
+	}
+}
+
+func (c *countingClient) Block(_ context.Context,
+	opts *api.BlockOpts,
+) (
+	*api.Response[*spec.Block],
+	error,
+) {
+	c.calls.Add(1)
+
+	status := c.status
+	hash := types.Hash{0x01, 0x02}
+
+	return &api.Response[*spec.Block]{
+		Data: &spec.Block{
+			Status:     &status,
+			BlockHash:  &hash,
+			ParentHash: &types.Hash{0x01},
+			L1DAMode:   spec.BlockDAModeL1,
+		},
+		Metadata: map[string]any{},
+	}, nil
+}
+
+func (c *countingClient) Nonce(_ context.Context,
+	_ *api.NonceOpts,
+) (
+	*api.Response[uint32],
+	error,
+) {
+	return &api.Response[uint32]{
+		Data:     uint32(c.calls.Add(1)),
+		Metadata: map[string]any{},
+	}, nil
+}
+
+func (c *countingClient) ChainID(_ context.Context,
+	_ *api.ChainIDOpts,
+) (
+	*api.Response[types.Data],
+	error,
+) {
+	c.calls.Add(1)
+
+	return &api.Response[types.Data]{
+		Data:     types.Data{0x01},
+		Metadata: map[string]any{},
+	}, nil
+}
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		parameters []cache.Parameter
+		err        string
+	}{
+		{
+			name: "ClientMissing",
+			parameters: []cache.Parameter{
+				cache.WithLogLevel(zerolog.Disabled),
+			},
+			err: "no client specified",
+		},
+		{
+			name: "MaxEntriesZero",
+			parameters: []cache.Parameter{
+				cache.WithLogLevel(zerolog.Disabled),
+				cache.WithClient(&mock.Service{}),
+				cache.WithMaxEntries(0),
+			},
+			err: "no maximum entries specified",
+		},
+		{
+			name: "Good",
+			parameters: []cache.Parameter{
+				cache.WithLogLevel(zerolog.Disabled),
+				cache.WithClient(&mock.Service{}),
+			},
+		},
+		{
+			name: "Persisted",
+			parameters: []cache.Parameter{
+				cache.WithLogLevel(zerolog.Disabled),
+				cache.WithClient(&mock.Service{}),
+				cache.WithPersistenceDir(t.TempDir()),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, err := cache.New(ctx, test.parameters...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, "cache", s.Name())
+				require.Equal(t, "mock", s.Address())
+			}
+		})
+	}
+}
+
+func TestNonce(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		block types.BlockID
+		calls int32
+	}{
+		{
+			name:  "Latest",
+			block: "latest",
+			calls: 2,
+		},
+		{
+			name:  "Number",
+			block: "12345",
+			calls: 2,
+		},
+		{
+			name:  "Hash",
+			block: "0x0102",
+			calls: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			underlying := newCountingClient(spec.FinalityStatusAcceptedOnL1)
+			s, err := cache.New(ctx,
+				cache.WithLogLevel(zerolog.Disabled),
+				cache.WithClient(underlying),
+			)
+			require.NoError(t, err)
+
+			opts := &api.NonceOpts{
+				Block:    test.block,
+				Contract: types.Address{0x01},
+			}
+			first, err := s.Nonce(ctx, opts)
+			require.NoError(t, err)
+			second, err := s.Nonce(ctx, opts)
+			require.NoError(t, err)
+			require.Equal(t, test.calls, underlying.calls.Load())
+			if test.calls == 1 {
+				require.Equal(t, first.Data, second.Data)
+			}
+		})
+	}
+}
+
+func TestBlock(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		status spec.FinalityStatus
+		blocks []types.BlockID
+		calls  int32
+	}{
+		{
+			name:   "Latest",
+			status: spec.FinalityStatusAcceptedOnL1,
+			blocks: []types.BlockID{"latest", "latest"},
+			calls:  2,
+		},
+		{
+			name:   "NumberNotFinalised",
+			status: spec.FinalityStatusAcceptedOnL2,
+			blocks: []types.BlockID{"12345", "12345"},
+			calls:  2,
+		},
+		{
+			name:   "HashNotFinalised",
+			status: spec.FinalityStatusAcceptedOnL2,
+			blocks: []types.BlockID{"0x0102", "0x0102"},
+			calls:  2,
+		},
+		{
+			name:   "NumberFinalised",
+			status: spec.FinalityStatusAcceptedOnL1,
+			blocks: []types.BlockID{"12345", "12345"},
+			calls:  1,
+		},
+		{
+			name:   "HashFinalised",
+			status: spec.FinalityStatusAcceptedOnL1,
+			blocks: []types.BlockID{"0x0102", "0x102"},
+			calls:  1,
+		},
+		{
+			name:   "NumberThenHash",
+			status: spec.FinalityStatusAcceptedOnL1,
+			blocks: []types.BlockID{"12345", "0x0102000000000000000000000000000000000000000000000000000000000000"},
+			calls:  1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			underlying := newCountingClient(test.status)
+			s, err := cache.New(ctx,
+				cache.WithLogLevel(zerolog.Disabled),
+				cache.WithClient(underlying),
+			)
+			require.NoError(t, err)
+
+			var previous *spec.Block
+			for _, block := range test.blocks {
+				res, err := s.Block(ctx, &api.BlockOpts{Block: block})
+				require.NoError(t, err)
+				if previous != nil {
+					require.Equal(t, previous, res.Data)
+				}
+				previous = res.Data
+			}
+			require.Equal(t, test.calls, underlying.calls.Load())
+		})
+	}
+}
+
+func TestChainID(t *testing.T) {
+	ctx := context.Background()
+
+	underlying := newCountingClient(spec.FinalityStatusAcceptedOnL1)
+	s, err := cache.New(ctx,
+		cache.WithLogLevel(zerolog.Disabled),
+		cache.WithClient(underlying),
+		cache.WithPersistenceDir(t.TempDir()),
+	)
+	require.NoError(t, err)
+
+	// Chain ID is always obtained from the underlying client.
+	for range 2 {
+		res, err := s.ChainID(ctx, &api.ChainIDOpts{})
+		require.NoError(t, err)
+		require.Equal(t, types.Data{0x01}, res.Data)
+	}
+	require.Equal(t, int32(2), underlying.calls.Load())
+}
+
+func TestEviction(t *testing.T) {
+	ctx := context.Background()
+
+	underlying := newCountingClient(spec.FinalityStatusAcceptedOnL1)
+	s, err := cache.New(ctx,
+		cache.WithLogLevel(zerolog.Disabled),
+		cache.WithClient(underlying),
+		cache.WithMaxEntries(1),
+	)
+	require.NoError(t, err)
+
+	first := &api.NonceOpts{Block: "0x01", Contract: types.Address{0x01}}
+	second := &api.NonceOpts{Block: "0x01", Contract: types.Address{0x02}}
+
+	_, err = s.Nonce(ctx, first)
+	require.NoError(t, err)
+	_, err = s.Nonce(ctx, second)
+	require.NoError(t, err)
+	// First entry has been evicted.
+	_, err = s.Nonce(ctx, first)
+	require.NoError(t, err)
+	require.Equal(t, int32(3), underlying.calls.Load())
+}
+
+func TestPersistence(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	opts := &api.NonceOpts{Block: "0x01", Contract: types.Address{0x01}}
+
+	underlying := newCountingClient(spec.FinalityStatusAcceptedOnL1)
+	s, err := cache.New(ctx,
+		cache.WithLogLevel(zerolog.Disabled),
+		cache.WithClient(underlying),
+		cache.WithPersistenceDir(dir),
+	)
+	require.NoError(t, err)
+	_, err = s.Nonce(ctx, opts)
+	require.NoError(t, err)
+
+	// New cache with the same directory obtains the entry from disk.
+	underlying2 := newCountingClient(spec.FinalityStatusAcceptedOnL1)
+	s2, err := cache.New(ctx,
+		cache.WithLogLevel(zerolog.Disabled),
+		cache.WithClient(underlying2),
+		cache.WithPersistenceDir(dir),
+	)
+	require.NoError(t, err)
+	res, err := s2.Nonce(ctx, opts)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), res.Data)
+	require.Equal(t, int32(0), underlying2.calls.Load())
+}
+
+func TestPersistenceConcurrent(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	underlying := newCountingClient(spec.FinalityStatusAcceptedOnL1)
+	s, err := cache.New(ctx,
+		cache.WithLogLevel(zerolog.Disabled),
+		cache.WithClient(underlying),
+		cache.WithMaxEntries(4),
+		cache.WithPersistenceDir(dir),
+	)
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := range 32 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := s.Nonce(ctx, &api.NonceOpts{Block: "0x01", Contract: types.Address{byte(i % 8)}})
+			require.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	// Only complete entries remain on disk.
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+	for _, file := range files {
+		require.Equal(t, ".json", filepath.Ext(file.Name()))
+	}
+}
+
+func TestNotProvider(t *testing.T) {
+	ctx := context.Background()
+
+	s, err := cache.New(ctx,
+		cache.WithLogLevel(zerolog.Disabled),
+		cache.WithClient(&bareClient{}),
+	)
+	require.NoError(t, err)
+
+	_, err = s.Nonce(ctx, &api.NonceOpts{Block: "latest"})
+	require.EqualError(t, err, "client does not provide function")
+}
+
+// bareClient provides no functions.
+type bareClient struct{}
+
+func (*bareClient) Name() string { return "bare" }
+
+func (*bareClient) Address() string { return "bare" }
+
+var _ client.Service = (*bareClient)(nil)
@@ -0,0 +1,79 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crypto
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/attestantio/go-starknet-client/types"
+)
+
+// contractAddressPrefix is the short string "STARKNET_CONTRACT_ADDRESS", used as a domain separator.
+var contractAddressPrefix = types.MustFieldElementFromShortString("STARKNET_CONTRACT_ADDRESS")
+
+// addressBound is the exclusive upper bound for a Starknet address, 2^251 - 256.
+var addressBound = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 251), big.NewInt(256))
+
+// ContractAddress calculates the address of a contract with the given class hash,
+// salt and constructor calldata when deployed by the given deployer.  Accounts
+// deploying themselves, and contracts deployed without a unique address by the
+// universal deployer, use a zero deployer.
+func ContractAddress(deployer types.Address,
+	salt types.FieldElement,
+	classHash types.Hash,
+	calldata []types.FieldElement,
+) (
+	types.Address,
+	error,
+) {
+	elements, err := FeltsFromFieldElements(append([]types.FieldElement{
+		contractAddressPrefix,
+		types.FieldElement(deployer),
+		salt,
+		types.FieldElement(classHash),
+	}, calldata...))
+	if err != nil {
+		return types.Address{}, err
+	}
+
+	hash := PedersenArray(
+		elements[0],
+		elements[1],
+		elements[2],
+		elements[3],
+		PedersenArray(elements[4:]...),
+	).BigInt()
+	hash.Mod(hash, addressBound)
+
+	var res types.Address
+	hash.FillBytes(res[:])
+
+	return res, nil
+}
+
+// FeltsFromFieldElements converts field elements to felts for hashing.
+func FeltsFromFieldElements(input []types.FieldElement) ([]types.Felt, error) {
+	res := make([]types.Felt, len(input))
+	for i := range input {
+		felt, err := input[i].Felt()
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("invalid element %d", i), err)
+		}
+		res[i] = felt
+	}
+
+	return res, nil
+}
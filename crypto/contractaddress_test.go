@@ -0,0 +1,91 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crypto_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-starknet-client/crypto"
+	"github.com/attestantio/go-starknet-client/types"
+	"github.com/stretchr/testify/require"
+)
+
+// fieldElements creates a slice of field elements from hex strings.
+func fieldElements(inputs ...string) []types.FieldElement {
+	res := make([]types.FieldElement, len(inputs))
+	for i := range inputs {
+		res[i] = *new(types.FieldElement).MustParse(inputs[i])
+	}
+
+	return res
+}
+
+func TestContractAddress(t *testing.T) {
+	tests := []struct {
+		name      string
+		deployer  string
+		salt      string
+		classHash string
+		calldata  []types.FieldElement
+		expected  string
+		err       string
+	}{
+		{
+			name:      "OpenZeppelin",
+			deployer:  "0x0",
+			salt:      "0x2e94ba2293dfa45f86dfcf9952d7a33dc50ce2b00b932999fbe0844772604f3",
+			classHash: "0x61dac032f228abef9c6626f995015233097ae253a7f72d68552db02f2971b8f",
+			calldata:  fieldElements("0x2e94ba2293dfa45f86dfcf9952d7a33dc50ce2b00b932999fbe0844772604f3"),
+			expected:  "0x48419d3cc27f158917b45255d5376c06a9524484e19a1102279cbdc715c5522",
+		},
+		{
+			name:      "MultipleCalldata",
+			deployer:  "0x0",
+			salt:      "0x520b540d51c06e1539cbc42e93a37cbef534082c75a3991179cfac83da67fdb",
+			classHash: "0x26ec026985a3bf9d0cc1fe17326b245dfdc3ff89b8fde106542a3ea56c5a918",
+			calldata: fieldElements(
+				"0x33444ad846cdd5f23eb73ff09fe6fddd568284a0fb7d1be20ee482f044dabe2",
+				"0x79dc0da7c54b95f10aa182ad0a46400db63156920adb65eca2654c0945a463",
+				"0x2",
+				"0x510b540d51c06e1539cbc42e93a37cbef534082c75a3991179cfac83da67fdb",
+				"0x0",
+			),
+			expected: "0x55e3ecdbd8f0b537b3cf6c31a77dff63ddfd5bf5dcc5ba7eb4d09e91fbe0f91",
+		},
+		{
+			name:      "CalldataOutOfRange",
+			deployer:  "0x0",
+			salt:      "0x0",
+			classHash: "0x0",
+			calldata:  []types.FieldElement{{0xff}},
+			err:       "invalid element 4\nvalue outside of field range",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := crypto.ContractAddress(*new(types.Address).MustParse(test.deployer),
+				*new(types.FieldElement).MustParse(test.salt),
+				*new(types.Hash).MustParse(test.classHash),
+				test.calldata,
+			)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crypto
+
+import (
+	"github.com/attestantio/go-starknet-client/types"
+)
+
+// curveAlpha is the alpha parameter of the STARK curve y^2 = x^3 + alpha*x + beta.
+var curveAlpha = types.FeltFromUint64(1)
+
+// affinePoint is a point on the STARK curve in affine co-ordinates.
+type affinePoint struct {
+	x        types.Felt
+	y        types.Felt
+	infinity bool
+}
+
+// jacobianPoint is a point on the STARK curve in Jacobian co-ordinates,
+// where (X, Y, Z) represents the affine point (X/Z^2, Y/Z^3).
+// The point at infinity has Z = 0.
+type jacobianPoint struct {
+	x types.Felt
+	y types.Felt
+	z types.Felt
+}
+
+// mustAffinePoint creates an affine point from hex co-ordinates, panicking on error.
+func mustAffinePoint(x string, y string) affinePoint {
+	xFE := new(types.FieldElement).MustParse(x)
+	yFE := new(types.FieldElement).MustParse(y)
+
+	xFelt, err := xFE.Felt()
+	if err != nil {
+		panic(err)
+	}
+
+	yFelt, err := yFE.Felt()
+	if err != nil {
+		panic(err)
+	}
+
+	return affinePoint{x: xFelt, y: yFelt}
+}
+
+// toJacobian converts an affine point to Jacobian co-ordinates.
+func (p affinePoint) toJacobian() jacobianPoint {
+	if p.infinity {
+		return jacobianPoint{}
+	}
+
+	return jacobianPoint{x: p.x, y: p.y, z: types.FeltFromUint64(1)}
+}
+
+// isInfinity returns true if the point is the point at infinity.
+func (p jacobianPoint) isInfinity() bool {
+	return p.z.IsZero()
+}
+
+// toAffine converts a Jacobian point to affine co-ordinates.
+func (p jacobianPoint) toAffine() affinePoint {
+	if p.isInfinity() {
+		return affinePoint{infinity: true}
+	}
+
+	// Z cannot be zero here, so the inverse always exists.
+	zInv, _ := p.z.Inverse()
+	zInv2 := zInv.Square()
+
+	return affinePoint{
+		x: p.x.Mul(zInv2),
+		y: p.y.Mul(zInv2.Mul(zInv)),
+	}
+}
+
+// double returns 2p, using the dbl-2007-bl formula.
+func (p jacobianPoint) double() jacobianPoint {
+	if p.isInfinity() || p.y.IsZero() {
+		return jacobianPoint{}
+	}
+
+	xx := p.x.Square()
+	yy := p.y.Square()
+	yyyy := yy.Square()
+	zz := p.z.Square()
+	s := p.x.Add(yy).Square().Sub(xx).Sub(yyyy)
+	s = s.Add(s)
+	m := xx.Add(xx).Add(xx).Add(curveAlpha.Mul(zz.Square()))
+	t := m.Square().Sub(s).Sub(s)
+	eightYYYY := yyyy.Add(yyyy)
+	eightYYYY = eightYYYY.Add(eightYYYY)
+	eightYYYY = eightYYYY.Add(eightYYYY)
+
+	return jacobianPoint{
+		x: t,
+		y: m.Mul(s.Sub(t)).Sub(eightYYYY),
+		z: p.y.Add(p.z).Square().Sub(yy).Sub(zz),
+	}
+}
+
+// addAffine returns p + q for an affine q, using the madd-2007-bl formula.
+func (p jacobianPoint) addAffine(q affinePoint) jacobianPoint {
+	if q.infinity {
+		return p
+	}
+
+	if p.isInfinity() {
+		return q.toJacobian()
+	}
+
+	z1z1 := p.z.Square()
+	u2 := q.x.Mul(z1z1)
+	s2 := q.y.Mul(p.z).Mul(z1z1)
+	h := u2.Sub(p.x)
+	r := s2.Sub(p.y)
+
+	if h.IsZero() {
+		if r.IsZero() {
+			return p.double()
+		}
+
+		return jacobianPoint{}
+	}
+
+	hh := h.Square()
+	i := hh.Add(hh)
+	i = i.Add(i)
+	j := h.Mul(i)
+	r = r.Add(r)
+	v := p.x.Mul(i)
+	x3 := r.Square().Sub(j).Sub(v).Sub(v)
+	y1j := p.y.Mul(j)
+
+	return jacobianPoint{
+		x: x3,
+		y: r.Mul(v.Sub(x3)).Sub(y1j).Sub(y1j),
+		z: p.z.Add(h).Square().Sub(z1z1).Sub(hh),
+	}
+}
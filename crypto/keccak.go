@@ -0,0 +1,35 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package crypto provides the cryptographic primitives used by Starknet.
+package crypto
+
+import (
+	"github.com/attestantio/go-starknet-client/types"
+	"golang.org/x/crypto/sha3"
+)
+
+// StarknetKeccak returns the Starknet variant of keccak256 for the data, being
+// the standard keccak256 hash truncated to its lowest 250 bits.
+func StarknetKeccak(data []byte) types.FieldElement {
+	hasher := sha3.NewLegacyKeccak256()
+	// Write() on a hash never returns an error.
+	_, _ = hasher.Write(data)
+
+	var res types.FieldElement
+	copy(res[:], hasher.Sum(nil))
+	// Mask the top 6 bits to truncate the value to 250 bits.
+	res[0] &= 0x03
+
+	return res
+}
@@ -0,0 +1,121 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crypto
+
+import (
+	"sync"
+
+	"github.com/attestantio/go-starknet-client/types"
+)
+
+// Constant points for the Pedersen hash, as defined by Starkware.
+var (
+	pedersenShiftPoint = mustAffinePoint(
+		"0x49ee3eba8c1600700ee1b87eb599f16716b0b1022947733551fde4050ca6804",
+		"0x3ca0cfe4b3bc6ddf346d49d06ea0ed34e621062c0e056c1d0405d266e10268a",
+	)
+	pedersenPoints = [4]affinePoint{
+		mustAffinePoint(
+			"0x234287dcbaffe7f969c748655fca9e58fa8120b6d56eb0c1080d17957ebe47b",
+			"0x3b056f100f96fb21e889527d41f4e39940135dd7a6c94cc6ed0268ee89e5615",
+		),
+		mustAffinePoint(
+			"0x4fa56f376c83db33f9dab2656558f3399099ec1de5e3018b7a6932dba8aa378",
+			"0x3fa0984c931c9e38113e0c0e47e4401562761f92a7a23b45168f4e80ff5b54d",
+		),
+		mustAffinePoint(
+			"0x4ba4cc166be8dec764910f75b45f74b40c690c74709e90f3aa372f0bd2d6997",
+			"0x40301cf5c1751f4b971e46c4ede85fcac5c59a5ce5ae7c48151f27b24b219c",
+		),
+		mustAffinePoint(
+			"0x54302dcb0e6cc1c6e44cca8f61a63bb2ca65048d53fb325d36ff12c49a58202",
+			"0x1b77b3e37d13504b348046268d8ae25ce98ad783c25561a879dcc77e99c2426",
+		),
+	}
+)
+
+const (
+	// pedersenLowBits is the number of low bits of each input that use the first point of a pair.
+	pedersenLowBits = 248
+	// pedersenHighBits is the number of high bits of each input that use the second point of a pair.
+	pedersenHighBits = 4
+)
+
+var (
+	pedersenTablesOnce sync.Once
+	// pedersenTables holds 2^i multiples of each constant point, to avoid doublings when hashing.
+	pedersenTables [4][]affinePoint
+)
+
+// Pedersen returns the Starknet Pedersen hash of two felts.
+func Pedersen(a types.Felt, b types.Felt) types.Felt {
+	pedersenTablesOnce.Do(initPedersenTables)
+
+	acc := pedersenShiftPoint.toJacobian()
+	acc = addPedersenTerm(acc, a, 0)
+	acc = addPedersenTerm(acc, b, 2)
+
+	return acc.toAffine().x
+}
+
+// PedersenArray returns the Starknet Pedersen hash of an array of felts,
+// which chains the hash over the elements and finally the length of the array.
+func PedersenArray(elements ...types.Felt) types.Felt {
+	res := types.Felt{}
+	for i := range elements {
+		res = Pedersen(res, elements[i])
+	}
+
+	return Pedersen(res, types.FeltFromUint64(uint64(len(elements))))
+}
+
+// addPedersenTerm adds the multiples of the pair of constant points selected by
+// the bits of the input to the accumulator.
+func addPedersenTerm(acc jacobianPoint, input types.Felt, pair int) jacobianPoint {
+	for i := range pedersenLowBits {
+		if feltBit(input, i) {
+			acc = acc.addAffine(pedersenTables[pair][i])
+		}
+	}
+
+	for i := range pedersenHighBits {
+		if feltBit(input, pedersenLowBits+i) {
+			acc = acc.addAffine(pedersenTables[pair+1][i])
+		}
+	}
+
+	return acc
+}
+
+// feltBit returns true if the given bit of the felt is set.
+func feltBit(input types.Felt, bit int) bool {
+	return (input[bit/64]>>(bit%64))&1 == 1
+}
+
+// initPedersenTables generates the tables of multiples of the constant points.
+func initPedersenTables() {
+	for i := range pedersenPoints {
+		bits := pedersenLowBits
+		if i%2 == 1 {
+			bits = pedersenHighBits
+		}
+
+		pedersenTables[i] = make([]affinePoint, bits)
+		point := pedersenPoints[i].toJacobian()
+		for j := range bits {
+			pedersenTables[i][j] = point.toAffine()
+			point = point.double()
+		}
+	}
+}
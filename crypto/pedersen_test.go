@@ -0,0 +1,131 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crypto_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-starknet-client/crypto"
+	"github.com/attestantio/go-starknet-client/types"
+	"github.com/stretchr/testify/require"
+)
+
+// felt creates a felt from a hex string.
+func felt(t *testing.T, input string) types.Felt {
+	t.Helper()
+
+	res, err := new(types.FieldElement).MustParse(input).Felt()
+	require.NoError(t, err)
+
+	return res
+}
+
+// felts creates a slice of felts from hex strings.
+func felts(t *testing.T, inputs ...string) []types.Felt {
+	t.Helper()
+
+	res := make([]types.Felt, len(inputs))
+	for i := range inputs {
+		res[i] = felt(t, inputs[i])
+	}
+
+	return res
+}
+
+func TestPedersen(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        string
+		b        string
+		expected string
+	}{
+		{
+			name:     "Zero",
+			a:        "0x0",
+			b:        "0x0",
+			expected: "0x49ee3eba8c1600700ee1b87eb599f16716b0b1022947733551fde4050ca6804",
+		},
+		{
+			name:     "Vector1",
+			a:        "0x3d937c035c878245caf64531a5756109c53068da139362728feb561405371cb",
+			b:        "0x208a0a10250e382e1e4bbe2880906c2791bf6275695e02fbbc6aeff9cd8b31a",
+			expected: "0x30e480bed5fe53fa909cc0f8c4d99b8f9f2c016be4c41e13a4848797979c662",
+		},
+		{
+			name:     "Vector2",
+			a:        "0x58f580910a6ca59b28927c08fe6c43e2e303ca384badc365795fc645d479d45",
+			b:        "0x78734f65a067be9bdb39de18434d71e79f7b6466a4b66bbd979ab9e7515fe0b",
+			expected: "0x68cc0b76cddd1dd4ed2301ada9b7c872b23875d5ff837b3a87993e0d9996b87",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res := crypto.Pedersen(felt(t, test.a), felt(t, test.b))
+			require.Equal(t, test.expected, res.String())
+		})
+	}
+}
+
+func TestPedersenArray(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []string
+		expected string
+	}{
+		{
+			name:     "Empty",
+			input:    []string{},
+			expected: "0x49ee3eba8c1600700ee1b87eb599f16716b0b1022947733551fde4050ca6804",
+		},
+		{
+			name: "ContractAddress",
+			input: []string{
+				"0x535441524b4e45545f434f4e54524143545f41444452455353",
+				"0x0",
+				"0x5bebda1b28ba6daa824126577b9fbc984033e8b18360f5e1ef694cb172c7aa5",
+				"0x439218681f9108b470d2379cf589ef47e60dc5888ee49ec70071671d74ca9c6",
+				"0x49ee3eba8c1600700ee1b87eb599f16716b0b1022947733551fde4050ca6804",
+			},
+			expected: "0x43c6817e70b3fd99a4f120790b2e82c6843df62b573fdadf9e2d677b60ac5eb",
+		},
+		{
+			name: "TransactionHash",
+			input: []string{
+				"0x6465706c6f79",
+				"0x20cfa74ee3564b4cd5435cdace0f9c4d43b939620e4a0bb5076105df0a626c6",
+				"0x28ffe4ff0f226a9107253e17a904099aa4f63a02a5621de0576e5aa71bc5194",
+				"0x7885ba4f628b6cdcd0b5e6282d2a1b17fe7cd4dd536230c5db3eac890528b4d",
+				"0x534e5f4d41494e",
+			},
+			expected: "0xe0a2e45a80bb827967e096bcf58874f6c01c191e0a0530624cba66a508ae75",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res := crypto.PedersenArray(felts(t, test.input...)...)
+			require.Equal(t, test.expected, res.String())
+		})
+	}
+}
+
+func BenchmarkPedersen(b *testing.B) {
+	x, _ := new(types.FieldElement).MustParse("0x3d937c035c878245caf64531a5756109c53068da139362728feb561405371cb").Felt()
+	y, _ := new(types.FieldElement).MustParse("0x208a0a10250e382e1e4bbe2880906c2791bf6275695e02fbbc6aeff9cd8b31a").Felt()
+
+	for b.Loop() {
+		crypto.Pedersen(x, y)
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crypto
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/attestantio/go-starknet-client/types"
+)
+
+const (
+	// poseidonFullRounds is the number of full rounds of the Hades permutation.
+	poseidonFullRounds = 8
+	// poseidonPartialRounds is the number of partial rounds of the Hades permutation.
+	poseidonPartialRounds = 83
+)
+
+var (
+	poseidonRoundKeysOnce sync.Once
+	// poseidonRoundKeys are the round constants of the Hades permutation, one per state element per round.
+	poseidonRoundKeys [poseidonFullRounds + poseidonPartialRounds][3]types.Felt
+)
+
+// Poseidon returns the Starknet Poseidon hash of two felts.
+func Poseidon(a types.Felt, b types.Felt) types.Felt {
+	state := [3]types.Felt{a, b, types.FeltFromUint64(2)}
+	hadesPermutation(&state)
+
+	return state[0]
+}
+
+// PoseidonArray returns the Starknet Poseidon hash of an array of felts.
+func PoseidonArray(elements ...types.Felt) types.Felt {
+	state := [3]types.Felt{}
+
+	for i := 0; i+1 < len(elements); i += 2 {
+		state[0] = state[0].Add(elements[i])
+		state[1] = state[1].Add(elements[i+1])
+		hadesPermutation(&state)
+	}
+
+	// Pad the final block.
+	if len(elements)%2 == 1 {
+		state[0] = state[0].Add(elements[len(elements)-1])
+		state[1] = state[1].Add(types.FeltFromUint64(1))
+	} else {
+		state[0] = state[0].Add(types.FeltFromUint64(1))
+	}
+	hadesPermutation(&state)
+
+	return state[0]
+}
+
+// hadesPermutation applies the Hades permutation to the state.
+func hadesPermutation(state *[3]types.Felt) {
+	poseidonRoundKeysOnce.Do(initPoseidonRoundKeys)
+
+	halfFullRounds := poseidonFullRounds / 2
+	for round := range poseidonFullRounds + poseidonPartialRounds {
+		full := round < halfFullRounds || round >= halfFullRounds+poseidonPartialRounds
+		for i := range state {
+			state[i] = state[i].Add(poseidonRoundKeys[round][i])
+		}
+		if full {
+			for i := range state {
+				state[i] = state[i].Square().Mul(state[i])
+			}
+		} else {
+			state[2] = state[2].Square().Mul(state[2])
+		}
+		poseidonMix(state)
+	}
+}
+
+// poseidonMix multiplies the state by the MDS matrix.
+func poseidonMix(state *[3]types.Felt) {
+	sum := state[0].Add(state[1]).Add(state[2])
+	state[0] = sum.Add(state[0]).Add(state[0])
+	state[1] = sum.Sub(state[1]).Sub(state[1])
+	state[2] = sum.Sub(state[2]).Sub(state[2]).Sub(state[2])
+}
+
+// initPoseidonRoundKeys generates the round keys, each being sha256("Hades<n>") reduced modulo the field prime.
+func initPoseidonRoundKeys() {
+	prime := types.FieldPrime()
+	index := 0
+	for round := range poseidonRoundKeys {
+		for i := range poseidonRoundKeys[round] {
+			digest := sha256.Sum256(fmt.Appendf(nil, "Hades%d", index))
+			value := new(big.Int).SetBytes(digest[:])
+			value.Mod(value, prime)
+			// Value has been reduced so is always in range.
+			poseidonRoundKeys[round][i], _ = types.FeltFromBigInt(value)
+			index++
+		}
+	}
+}
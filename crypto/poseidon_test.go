@@ -0,0 +1,85 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crypto_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-starknet-client/crypto"
+	"github.com/attestantio/go-starknet-client/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPoseidon(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        string
+		b        string
+		expected string
+	}{
+		{
+			name:     "Simple",
+			a:        "0x1",
+			b:        "0x2",
+			expected: "0x5d44a3decb2b2e0cc71071f7b802f45dd792d064f0fc7316c46514f70f9891a",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res := crypto.Poseidon(felt(t, test.a), felt(t, test.b))
+			require.Equal(t, test.expected, res.String())
+		})
+	}
+}
+
+func TestPoseidonArray(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []string
+		expected string
+	}{
+		{
+			name:     "Empty",
+			input:    []string{},
+			expected: "0x2272be0f580fd156823304800919530eaa97430e972d7213ee13f4fbf7a5dbc",
+		},
+		{
+			name:     "Odd",
+			input:    []string{"0x0", "0x1", "0x2"},
+			expected: "0x7a01142da8aecae3782ba66fc3285fd02fcd2c55aa868fe50fd95c089068d16",
+		},
+		{
+			name:     "Even",
+			input:    []string{"0x0", "0x1", "0x2", "0x3"},
+			expected: "0x7b8f30ac298ea12d170c0873f1fa631a18c00756c6e7d1fd273b9a239d0d413",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res := crypto.PoseidonArray(felts(t, test.input...)...)
+			require.Equal(t, test.expected, res.String())
+		})
+	}
+}
+
+func BenchmarkPoseidon(b *testing.B) {
+	x := types.FeltFromUint64(1)
+	y := types.FeltFromUint64(2)
+
+	for b.Loop() {
+		crypto.Poseidon(x, y)
+	}
+}
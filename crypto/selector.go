@@ -0,0 +1,36 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crypto
+
+import (
+	"github.com/attestantio/go-starknet-client/types"
+)
+
+const (
+	// DefaultEntryPointName is the name of the default entry point of a contract.
+	DefaultEntryPointName = "__default__"
+	// L1DefaultEntryPointName is the name of the default L1 handler entry point of a contract.
+	L1DefaultEntryPointName = "__l1_default__"
+)
+
+// SelectorFromName returns the selector for a function or event name.
+// The default entry points have a selector of 0; all other names have
+// a selector of the Starknet keccak of the name.
+func SelectorFromName(name string) types.FieldElement {
+	if name == DefaultEntryPointName || name == L1DefaultEntryPointName {
+		return types.FieldElement{}
+	}
+
+	return StarknetKeccak([]byte(name))
+}
@@ -0,0 +1,57 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crypto_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-starknet-client/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectorFromName(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "Default",
+			input:    "__default__",
+			expected: "0x0",
+		},
+		{
+			name:     "L1Default",
+			input:    "__l1_default__",
+			expected: "0x0",
+		},
+		{
+			name:     "Function",
+			input:    "balanceOf",
+			expected: "0x2e4263afad30923c891518314c3c95dbe830a16874e8abc5777a9a20b54c76e",
+		},
+		{
+			name:     "Event",
+			input:    "Transfer",
+			expected: "0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res := crypto.SelectorFromName(test.input)
+			require.Equal(t, test.expected, res.String())
+		})
+	}
+}
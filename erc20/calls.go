@@ -0,0 +1,42 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package erc20
+
+import (
+	"github.com/attestantio/go-starknet-client/crypto"
+	"github.com/attestantio/go-starknet-client/spec"
+	"github.com/attestantio/go-starknet-client/types"
+)
+
+// Transfer returns a call that transfers the amount to the recipient.
+func (s *Service) Transfer(recipient types.Address, amount types.Amount) *spec.FunctionCall {
+	low, high := amount.U256()
+
+	return &spec.FunctionCall{
+		ContractAddress:    s.address,
+		EntryPointSelector: crypto.SelectorFromName("transfer"),
+		Calldata:           []types.FieldElement{types.FieldElement(recipient), low, high},
+	}
+}
+
+// Approve returns a call that allows the spender to transfer up to the amount.
+func (s *Service) Approve(spender types.Address, amount types.Amount) *spec.FunctionCall {
+	low, high := amount.U256()
+
+	return &spec.FunctionCall{
+		ContractAddress:    s.address,
+		EntryPointSelector: crypto.SelectorFromName("approve"),
+		Calldata:           []types.FieldElement{types.FieldElement(spender), low, high},
+	}
+}
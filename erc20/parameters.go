@@ -0,0 +1,96 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package erc20
+
+import (
+	"errors"
+
+	client "github.com/attestantio/go-starknet-client"
+	"github.com/attestantio/go-starknet-client/types"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel     zerolog.Level
+	callProvider client.CallProvider
+	address      types.Address
+	block        types.BlockID
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(p *parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithCallProvider sets the provider used to call the token contract.
+func WithCallProvider(provider client.CallProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.callProvider = provider
+	})
+}
+
+// WithAddress sets the address of the token contract.
+func WithAddress(address types.Address) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.address = address
+	})
+}
+
+// WithBlock sets the block at which the token contract is read.
+func WithBlock(block types.BlockID) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.block = block
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel: zerolog.GlobalLevel(),
+		block:    "latest",
+	}
+
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.callProvider == nil {
+		return nil, errors.New("no call provider specified")
+	}
+
+	if parameters.address.IsZero() {
+		return nil, errors.New("no address specified")
+	}
+
+	if parameters.block == "" {
+		return nil, errors.New("no block specified")
+	}
+
+	return &parameters, nil
+}
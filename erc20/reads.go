@@ -0,0 +1,66 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package erc20
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/attestantio/go-starknet-client/types"
+)
+
+// BalanceOf returns the balance of the account.
+func (s *Service) BalanceOf(ctx context.Context, account types.Address) (types.Amount, error) {
+	return s.callU256(ctx, "balance_of", types.FieldElement(account))
+}
+
+// Allowance returns the amount that the spender is allowed to transfer on behalf of the owner.
+func (s *Service) Allowance(ctx context.Context, owner types.Address, spender types.Address) (types.Amount, error) {
+	return s.callU256(ctx, "allowance", types.FieldElement(owner), types.FieldElement(spender))
+}
+
+// TotalSupply returns the total supply of the token.
+func (s *Service) TotalSupply(ctx context.Context) (types.Amount, error) {
+	return s.callU256(ctx, "total_supply")
+}
+
+// Decimals returns the number of decimals used by the token.
+func (s *Service) Decimals(ctx context.Context) (uint8, error) {
+	data, err := s.call(ctx, "decimals")
+	if err != nil {
+		return 0, err
+	}
+
+	if len(data) != 1 {
+		return 0, errors.New("unexpected response from decimals")
+	}
+
+	res, err := data[0].Uint64()
+	if err != nil || res > 255 {
+		return 0, fmt.Errorf("invalid response from decimals: %s", data[0].String())
+	}
+
+	return uint8(res), nil
+}
+
+// Name returns the name of the token.
+func (s *Service) Name(ctx context.Context) (string, error) {
+	return s.callString(ctx, "name")
+}
+
+// Symbol returns the symbol of the token.
+func (s *Service) Symbol(ctx context.Context) (string, error) {
+	return s.callString(ctx, "symbol")
+}
@@ -0,0 +1,152 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package erc20 provides access to ERC-20 token contracts.
+package erc20
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	client "github.com/attestantio/go-starknet-client"
+	"github.com/attestantio/go-starknet-client/api"
+	"github.com/attestantio/go-starknet-client/types"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Addresses of well-known tokens.  STRK and ETH are deployed at the same
+// addresses on mainnet and Sepolia.
+var (
+	// MainnetSTRKAddress is the address of the STRK token on mainnet.
+	MainnetSTRKAddress = *new(types.Address).MustParse("0x04718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d")
+	// SepoliaSTRKAddress is the address of the STRK token on Sepolia.
+	SepoliaSTRKAddress = MainnetSTRKAddress
+	// MainnetETHAddress is the address of the ETH token on mainnet.
+	MainnetETHAddress = *new(types.Address).MustParse("0x049d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7")
+	// SepoliaETHAddress is the address of the ETH token on Sepolia.
+	SepoliaETHAddress = MainnetETHAddress
+)
+
+// Service is an ERC-20 token.
+type Service struct {
+	log          zerolog.Logger
+	callProvider client.CallProvider
+	address      types.Address
+	block        types.BlockID
+}
+
+// New creates a new ERC-20 token service.
+func New(_ context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "erc20").Stringer("address", parameters.address).Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	return &Service{
+		log:          log,
+		callProvider: parameters.callProvider,
+		address:      parameters.address,
+		block:        parameters.block,
+	}, nil
+}
+
+// Address returns the address of the token contract.
+func (s *Service) Address() types.Address {
+	return s.address
+}
+
+// call calls an entry point of the token contract.
+func (s *Service) call(ctx context.Context,
+	entryPoint string,
+	calldata ...types.FieldElement,
+) (
+	[]types.FieldElement,
+	error,
+) {
+	response, err := s.callProvider.Call(ctx, &api.CallOpts{
+		Block:      s.block,
+		Contract:   s.address,
+		EntryPoint: entryPoint,
+		Calldata:   calldata,
+	})
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to call %s", entryPoint), err)
+	}
+
+	return response.Data, nil
+}
+
+// callU256 calls an entry point of the token contract that returns a u256.
+func (s *Service) callU256(ctx context.Context,
+	entryPoint string,
+	calldata ...types.FieldElement,
+) (
+	types.Amount,
+	error,
+) {
+	data, err := s.call(ctx, entryPoint, calldata...)
+	if err != nil {
+		return types.Amount{}, err
+	}
+
+	if len(data) != 2 {
+		return types.Amount{}, fmt.Errorf("unexpected response from %s", entryPoint)
+	}
+
+	res, err := types.AmountFromU256(data[0], data[1])
+	if err != nil {
+		return types.Amount{}, errors.Join(fmt.Errorf("invalid response from %s", entryPoint), err)
+	}
+
+	return res, nil
+}
+
+// callString calls an entry point of the token contract that returns a
+// string, either as a short string or as a byte array.
+func (s *Service) callString(ctx context.Context, entryPoint string) (string, error) {
+	data, err := s.call(ctx, entryPoint)
+	if err != nil {
+		return "", err
+	}
+
+	switch len(data) {
+	case 0:
+		return "", fmt.Errorf("unexpected response from %s", entryPoint)
+	case 1:
+		res, err := data[0].ShortString()
+		if err != nil {
+			return "", errors.Join(fmt.Errorf("invalid response from %s", entryPoint), err)
+		}
+
+		return res, nil
+	default:
+		byteArray, consumed, err := types.ByteArrayFromFieldElements(data)
+		if err != nil {
+			return "", errors.Join(fmt.Errorf("invalid response from %s", entryPoint), err)
+		}
+
+		if consumed != len(data) {
+			return "", fmt.Errorf("unexpected response from %s", entryPoint)
+		}
+
+		return byteArray.String(), nil
+	}
+}
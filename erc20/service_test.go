@@ -0,0 +1,241 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package erc20_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/attestantio/go-starknet-client/api"
+	"github.com/attestantio/go-starknet-client/crypto"
+	"github.com/attestantio/go-starknet-client/erc20"
+	"github.com/attestantio/go-starknet-client/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// callProvider returns canned responses by entry point.
+type callProvider struct {
+	responses map[string][]types.FieldElement
+	calls     []*api.CallOpts
+}
+
+func (c *callProvider) Call(_ context.Context,
+	opts *api.CallOpts,
+) (
+	*api.Response[[]types.FieldElement],
+	error,
+) {
+	c.calls = append(c.calls, opts)
+
+	res, exists := c.responses[opts.EntryPoint]
+	if !exists {
+		return nil, errors.New("entry point not found")
+	}
+
+	return &api.Response[[]types.FieldElement]{
+		Data: res,
+	}, nil
+}
+
+func fieldElement(input string) types.FieldElement {
+	return *new(types.FieldElement).MustParse(input)
+}
+
+func newService(t *testing.T, provider *callProvider) *erc20.Service {
+	t.Helper()
+
+	s, err := erc20.New(context.Background(),
+		erc20.WithLogLevel(zerolog.Disabled),
+		erc20.WithCallProvider(provider),
+		erc20.WithAddress(erc20.SepoliaSTRKAddress),
+	)
+	require.NoError(t, err)
+
+	return s
+}
+
+func TestNew(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := erc20.New(ctx, erc20.WithLogLevel(zerolog.Disabled), erc20.WithAddress(erc20.MainnetETHAddress))
+	require.EqualError(t, err, "no call provider specified")
+
+	_, err = erc20.New(ctx, erc20.WithLogLevel(zerolog.Disabled), erc20.WithCallProvider(&callProvider{}))
+	require.EqualError(t, err, "no address specified")
+
+	s, err := erc20.New(ctx,
+		erc20.WithLogLevel(zerolog.Disabled),
+		erc20.WithCallProvider(&callProvider{}),
+		erc20.WithAddress(erc20.MainnetETHAddress),
+	)
+	require.NoError(t, err)
+	require.Equal(t, erc20.MainnetETHAddress, s.Address())
+}
+
+func TestBalanceOf(t *testing.T) {
+	ctx := context.Background()
+	account := *new(types.Address).MustParse("0x1234")
+
+	provider := &callProvider{
+		responses: map[string][]types.FieldElement{
+			"balance_of": {fieldElement("0x1"), fieldElement("0x2")},
+		},
+	}
+	s := newService(t, provider)
+
+	res, err := s.BalanceOf(ctx, account)
+	require.NoError(t, err)
+	expected := new(big.Int).Add(new(big.Int).Lsh(big.NewInt(2), 128), big.NewInt(1))
+	require.Equal(t, expected, res.BigInt())
+	require.Equal(t, types.BlockID("latest"), provider.calls[0].Block)
+	require.Equal(t, erc20.SepoliaSTRKAddress, provider.calls[0].Contract)
+	require.Equal(t, []types.FieldElement{types.FieldElement(account)}, provider.calls[0].Calldata)
+
+	_, err = newService(t, &callProvider{}).BalanceOf(ctx, account)
+	require.EqualError(t, err, "failed to call balance_of\nentry point not found")
+
+	_, err = newService(t, &callProvider{
+		responses: map[string][]types.FieldElement{
+			"balance_of": {fieldElement("0x1")},
+		},
+	}).BalanceOf(ctx, account)
+	require.EqualError(t, err, "unexpected response from balance_of")
+}
+
+func TestAllowance(t *testing.T) {
+	ctx := context.Background()
+	owner := *new(types.Address).MustParse("0x1")
+	spender := *new(types.Address).MustParse("0x2")
+
+	provider := &callProvider{
+		responses: map[string][]types.FieldElement{
+			"allowance": {fieldElement("0x64"), fieldElement("0x0")},
+		},
+	}
+
+	res, err := newService(t, provider).Allowance(ctx, owner, spender)
+	require.NoError(t, err)
+	require.Equal(t, types.AmountFromUint64(100), res)
+	require.Equal(t, []types.FieldElement{types.FieldElement(owner), types.FieldElement(spender)}, provider.calls[0].Calldata)
+}
+
+func TestTotalSupply(t *testing.T) {
+	res, err := newService(t, &callProvider{
+		responses: map[string][]types.FieldElement{
+			"total_supply": {fieldElement("0xde0b6b3a7640000"), fieldElement("0x0")},
+		},
+	}).TotalSupply(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, types.AmountFromUint64(1000000000000000000), res)
+}
+
+func TestDecimals(t *testing.T) {
+	ctx := context.Background()
+
+	res, err := newService(t, &callProvider{
+		responses: map[string][]types.FieldElement{
+			"decimals": {fieldElement("0x12")},
+		},
+	}).Decimals(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint8(18), res)
+
+	_, err = newService(t, &callProvider{
+		responses: map[string][]types.FieldElement{
+			"decimals": {fieldElement("0x100")},
+		},
+	}).Decimals(ctx)
+	require.EqualError(t, err, "invalid response from decimals: 0x100")
+}
+
+func TestNameAndSymbol(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		response []types.FieldElement
+		expected string
+		err      string
+	}{
+		{
+			name:     "ShortString",
+			response: []types.FieldElement{types.MustFieldElementFromShortString("Starknet Token")},
+			expected: "Starknet Token",
+		},
+		{
+			name:     "ByteArray",
+			response: types.ByteArrayFromString("A token with a name longer than thirty-one characters").FieldElements(),
+			expected: "A token with a name longer than thirty-one characters",
+		},
+		{
+			name:     "Empty",
+			response: []types.FieldElement{},
+			err:      "unexpected response from %s",
+		},
+		{
+			name: "ByteArrayTrailingData",
+			response: append(types.ByteArrayFromString("STRK").FieldElements(),
+				fieldElement("0x1"),
+			),
+			err: "unexpected response from %s",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := newService(t, &callProvider{
+				responses: map[string][]types.FieldElement{
+					"name":   test.response,
+					"symbol": test.response,
+				},
+			})
+
+			name, err := s.Name(ctx)
+			if test.err != "" {
+				require.EqualError(t, err, fmt.Sprintf(test.err, "name"))
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, name)
+			}
+
+			symbol, err := s.Symbol(ctx)
+			if test.err != "" {
+				require.EqualError(t, err, fmt.Sprintf(test.err, "symbol"))
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, symbol)
+			}
+		})
+	}
+}
+
+func TestTransferAndApprove(t *testing.T) {
+	s := newService(t, &callProvider{})
+	recipient := *new(types.Address).MustParse("0x1234")
+	amount, err := types.AmountFromBigInt(new(big.Int).Add(new(big.Int).Lsh(big.NewInt(3), 128), big.NewInt(5)))
+	require.NoError(t, err)
+
+	transfer := s.Transfer(recipient, amount)
+	require.Equal(t, erc20.SepoliaSTRKAddress, transfer.ContractAddress)
+	require.Equal(t, crypto.SelectorFromName("transfer"), transfer.EntryPointSelector)
+	require.Equal(t, []types.FieldElement{types.FieldElement(recipient), fieldElement("0x5"), fieldElement("0x3")}, transfer.Calldata)
+
+	approve := s.Approve(recipient, amount)
+	require.Equal(t, crypto.SelectorFromName("approve"), approve.EntryPointSelector)
+	require.Equal(t, transfer.Calldata, approve.Calldata)
+}
@@ -18,6 +18,10 @@ import "errors"
 var (
 	// ErrNotActive is returned when a client is not active.
 	ErrNotActive = errors.New("client is not active")
+	// ErrUnavailable is returned when a client cannot be reached or fails to serve a request.
+	ErrUnavailable = errors.New("client is unavailable")
+	// ErrOutcomeUnknown is returned when a transaction submission fails after the node may have received it.
+	ErrOutcomeUnknown = errors.New("outcome unknown")
 	// ErrNotSynced is returned when a client is not synced.
 	ErrNotSynced = errors.New("client is not synced")
 	// ErrNoOptions is returned when a request is made without options.
@@ -26,8 +30,16 @@ var (
 	ErrInvalidOptions = errors.New("invalid options")
 	// ErrInconsistentResult is returned when a request returns with data at odds to that requested.
 	ErrInconsistentResult = errors.New("inconsistent result")
+	// ErrChainMismatch is returned when a node is serving a different chain to that expected.
+	ErrChainMismatch = errors.New("chain ID mismatch")
+	// ErrNoConsensus is returned when a quorum of clients does not agree on a result.
+	ErrNoConsensus = errors.New("no consensus")
+	// ErrTimeout is returned when a request does not complete within its timeout.
+	ErrTimeout = errors.New("request timed out")
 	// ErrRPCCallFailed is returned when an RPC call fails.
 	ErrRPCCallFailed = errors.New("RPC call failed")
+	// ErrUnsupportedVersion is returned when a request is not supported by the version of the node.
+	ErrUnsupportedVersion = errors.New("unsupported by node version")
 	// ErrUnsupportedFormat is returned when data is returned in an unsupported format.
 	ErrUnsupportedFormat = errors.New("unsupported data format")
 )
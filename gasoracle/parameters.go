@@ -0,0 +1,134 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gasoracle
+
+import (
+	"errors"
+	"fmt"
+
+	client "github.com/attestantio/go-starknet-client"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel      zerolog.Level
+	blockProvider client.BlockProvider
+	blocks        int
+	percentile    float64
+	multipliers   map[Resource]float64
+	caps          map[Resource]uint64
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(p *parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithBlockProvider sets the provider of blocks from which prices are sampled.
+func WithBlockProvider(provider client.BlockProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.blockProvider = provider
+	})
+}
+
+// WithBlocks sets the number of recent blocks sampled.
+func WithBlocks(blocks int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.blocks = blocks
+	})
+}
+
+// WithPercentile sets the percentile of sampled prices used as the base price, from 0 to 100.
+func WithPercentile(percentile float64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.percentile = percentile
+	})
+}
+
+// WithMultiplier sets the safety multiplier applied to the price of a resource.
+func WithMultiplier(resource Resource, multiplier float64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.multipliers[resource] = multiplier
+	})
+}
+
+// WithCap sets the maximum price recommended for a resource.
+func WithCap(resource Resource, maxPrice uint64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.caps[resource] = maxPrice
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel:   zerolog.GlobalLevel(),
+		blocks:     10,
+		percentile: 50,
+		multipliers: map[Resource]float64{
+			ResourceL1Gas:     1.5,
+			ResourceL1DataGas: 1.5,
+			ResourceL2Gas:     1.5,
+		},
+		caps: make(map[Resource]uint64),
+	}
+
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.blockProvider == nil {
+		return nil, errors.New("no block provider specified")
+	}
+
+	if parameters.blocks < 1 {
+		return nil, errors.New("blocks must be at least 1")
+	}
+
+	if parameters.percentile < 0 || parameters.percentile > 100 {
+		return nil, errors.New("percentile must be between 0 and 100")
+	}
+
+	for resource, multiplier := range parameters.multipliers {
+		if resource == ResourceUnknown || int(resource) >= len(resourceStrings) {
+			return nil, fmt.Errorf("multiplier supplied for unknown resource %d", resource)
+		}
+		if multiplier < 1 {
+			return nil, fmt.Errorf("multiplier for %v must be at least 1", resource)
+		}
+	}
+
+	for resource := range parameters.caps {
+		if resource == ResourceUnknown || int(resource) >= len(resourceStrings) {
+			return nil, fmt.Errorf("cap supplied for unknown resource %d", resource)
+		}
+	}
+
+	return &parameters, nil
+}
@@ -0,0 +1,130 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gasoracle
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+)
+
+// Recommendation is a recommended price for a resource.
+type Recommendation struct {
+	// Resource is the resource to which the recommendation applies.
+	Resource Resource `json:"resource"`
+	// Samples is the number of blocks sampled.
+	Samples int `json:"samples"`
+	// Min is the lowest sampled price.
+	Min uint64 `json:"min"`
+	// Max is the highest sampled price.
+	Max uint64 `json:"max"`
+	// Percentile is the configured percentile of the sampled prices.
+	Percentile uint64 `json:"percentile"`
+	// Trend is the average change in price per block.
+	Trend float64 `json:"trend"`
+	// Projected is the price projected from the trend for the next block.
+	Projected uint64 `json:"projected"`
+	// Recommended is the recommended maximum price, being the higher of the
+	// percentile and projected prices with the safety multiplier applied.
+	Recommended uint64 `json:"recommended"`
+	// Capped is true if the recommended price was limited by the configured cap.
+	Capped bool `json:"capped"`
+}
+
+// String returns a string version of the structure.
+func (r *Recommendation) String() string {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
+
+// recommend creates a recommendation from prices, ordered from oldest to newest.
+func recommend(resource Resource,
+	prices []uint64,
+	percentile float64,
+	multiplier float64,
+	maxPrice uint64,
+) *Recommendation {
+	sorted := slices.Clone(prices)
+	slices.Sort(sorted)
+
+	res := &Recommendation{
+		Resource:   resource,
+		Samples:    len(prices),
+		Min:        sorted[0],
+		Max:        sorted[len(sorted)-1],
+		Percentile: percentileOf(sorted, percentile),
+	}
+
+	var intercept float64
+	res.Trend, intercept = linearFit(prices)
+	res.Projected = clampUint64(intercept + res.Trend*float64(len(prices)))
+
+	res.Recommended = clampUint64(math.Ceil(float64(max(res.Percentile, res.Projected)) * multiplier))
+	if maxPrice != 0 && res.Recommended > maxPrice {
+		res.Recommended = maxPrice
+		res.Capped = true
+	}
+
+	return res
+}
+
+// percentileOf returns the nearest-rank percentile of sorted values.
+func percentileOf(sorted []uint64, percentile float64) uint64 {
+	rank := int(math.Ceil(percentile / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+
+	return sorted[rank-1]
+}
+
+// linearFit returns the slope and intercept of the least-squares line through
+// the values, with the x co-ordinate being the index of the value.
+func linearFit(values []uint64) (float64, float64) {
+	n := float64(len(values))
+	if len(values) < 2 {
+		return 0, float64(values[0])
+	}
+
+	var sumX, sumY, sumXY, sumXX float64
+	for i, value := range values {
+		x := float64(i)
+		y := float64(value)
+		sumX += x
+		sumY += y
+		sumXY += x * y
+		sumXX += x * x
+	}
+
+	slope := (n*sumXY - sumX*sumY) / (n*sumXX - sumX*sumX)
+
+	return slope, (sumY - slope*sumX) / n
+}
+
+// clampUint64 converts a float to a uint64, clamping it to the valid range.
+func clampUint64(input float64) uint64 {
+	switch {
+	case input <= 0:
+		return 0
+	case input >= math.MaxUint64:
+		return math.MaxUint64
+	default:
+		return uint64(math.Round(input))
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gasoracle
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Resource is a resource whose price is tracked by the oracle.
+type Resource uint8
+
+const (
+	// ResourceUnknown is an unknown resource.
+	ResourceUnknown Resource = iota
+	// ResourceL1Gas is L1 gas.
+	ResourceL1Gas
+	// ResourceL1DataGas is L1 data gas.
+	ResourceL1DataGas
+	// ResourceL2Gas is L2 gas.
+	ResourceL2Gas
+)
+
+var resourceStrings = [...]string{
+	"unknown",
+	"l1_gas",
+	"l1_data_gas",
+	"l2_gas",
+}
+
+// resources are the resources tracked by the oracle.
+var resources = []Resource{ResourceL1Gas, ResourceL1DataGas, ResourceL2Gas}
+
+// MarshalJSON implements json.Marshaler.
+func (r Resource) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf("%q", r.String())), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (r *Resource) UnmarshalJSON(input []byte) error {
+	var err error
+
+	switch strings.ToLower(string(input)) {
+	case `"l1_gas"`:
+		*r = ResourceL1Gas
+	case `"l1_data_gas"`:
+		*r = ResourceL1DataGas
+	case `"l2_gas"`:
+		*r = ResourceL2Gas
+	default:
+		err = fmt.Errorf("unrecognised resource %s", string(input))
+	}
+
+	return err
+}
+
+// String returns a string representation of the resource.
+func (r Resource) String() string {
+	if int(r) >= len(resourceStrings) {
+		return resourceStrings[0]
+	}
+
+	return resourceStrings[r]
+}
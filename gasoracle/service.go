@@ -0,0 +1,189 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package gasoracle recommends prices and resource bounds for transactions
+// from the prices in recent blocks.
+package gasoracle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	client "github.com/attestantio/go-starknet-client"
+	"github.com/attestantio/go-starknet-client/api"
+	"github.com/attestantio/go-starknet-client/spec"
+	"github.com/attestantio/go-starknet-client/types"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Service is a gas price oracle.
+type Service struct {
+	log           zerolog.Logger
+	blockProvider client.BlockProvider
+	blocks        int
+	percentile    float64
+	multipliers   map[Resource]float64
+	caps          map[Resource]uint64
+}
+
+// New creates a new gas price oracle.
+func New(_ context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "gasoracle").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	return &Service{
+		log:           log,
+		blockProvider: parameters.blockProvider,
+		blocks:        parameters.blocks,
+		percentile:    parameters.percentile,
+		multipliers:   parameters.multipliers,
+		caps:          parameters.caps,
+	}, nil
+}
+
+// Recommendations returns price recommendations for each resource, based on
+// the prices in recent blocks.  Prices are in fri.
+func (s *Service) Recommendations(ctx context.Context) (map[Resource]*Recommendation, error) {
+	blocks, err := s.recentBlocks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[Resource]*Recommendation, len(resources))
+	for _, resource := range resources {
+		prices := make([]uint64, len(blocks))
+		for i, block := range blocks {
+			prices[i], err = blockPrice(block, resource)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		multiplier, exists := s.multipliers[resource]
+		if !exists {
+			multiplier = 1
+		}
+
+		res[resource] = recommend(resource, prices, s.percentile, multiplier, s.caps[resource])
+		s.log.Trace().Stringer("recommendation", res[resource]).Msg("Calculated recommendation")
+	}
+
+	return res, nil
+}
+
+// ResourceBounds returns resource bounds with the given maximum amount of each
+// resource, priced at the recommended price.
+func (s *Service) ResourceBounds(ctx context.Context,
+	amounts map[Resource]types.Number,
+) (
+	*spec.ResourceBounds,
+	error,
+) {
+	recommendations, err := s.Recommendations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &spec.ResourceBounds{}
+	for resource, amount := range amounts {
+		switch resource {
+		case ResourceL1Gas:
+			res.L1Gas = spec.ResourceBound{
+				MaxAmount:       amount,
+				MaxPricePerUnit: types.Number(recommendations[resource].Recommended),
+			}
+		case ResourceL1DataGas:
+			res.L1DataGas = &spec.ResourceBound{
+				MaxAmount:       amount,
+				MaxPricePerUnit: types.Number(recommendations[resource].Recommended),
+			}
+		case ResourceL2Gas:
+			res.L2Gas = spec.ResourceBound{
+				MaxAmount:       amount,
+				MaxPricePerUnit: types.Number(recommendations[resource].Recommended),
+			}
+		default:
+			return nil, fmt.Errorf("resource bounds do not support %v", resource)
+		}
+	}
+
+	return res, nil
+}
+
+// recentBlocks fetches the recent blocks, ordered from oldest to newest.
+func (s *Service) recentBlocks(ctx context.Context) ([]*spec.Block, error) {
+	response, err := s.blockProvider.Block(ctx, &api.BlockOpts{
+		Block: "latest",
+	})
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to obtain latest block"), err)
+	}
+
+	latest := response.Data
+	if latest.BlockNumber == nil {
+		return nil, errors.New("latest block has no number")
+	}
+
+	count := min(uint64(s.blocks), *latest.BlockNumber+1)
+	res := make([]*spec.Block, count)
+	res[count-1] = latest
+	for i := uint64(1); i < count; i++ {
+		blockNumber := *latest.BlockNumber - i
+		response, err := s.blockProvider.Block(ctx, &api.BlockOpts{
+			Block: types.BlockID(strconv.FormatUint(blockNumber, 10)),
+		})
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("failed to obtain block %d", blockNumber), err)
+		}
+		res[count-1-i] = response.Data
+	}
+
+	return res, nil
+}
+
+// blockPrice returns the price of a resource in a block, in fri.
+func blockPrice(block *spec.Block, resource Resource) (uint64, error) {
+	var price types.Amount
+	switch resource {
+	case ResourceL1Gas:
+		price = block.L1GasPrice.PriceInFri
+	case ResourceL1DataGas:
+		price = block.L1DataGasPrice.PriceInFri
+	case ResourceL2Gas:
+		// Blocks from nodes that predate L2 gas pricing have no L2 gas price.
+		if block.L2GasPrice == nil {
+			return 0, nil
+		}
+		price = block.L2GasPrice.PriceInFri
+	default:
+		return 0, fmt.Errorf("unsupported resource %v", resource)
+	}
+
+	res, err := price.Uint64()
+	if err != nil {
+		return 0, errors.Join(fmt.Errorf("invalid %v price", resource), err)
+	}
+
+	return res, nil
+}
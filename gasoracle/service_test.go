@@ -0,0 +1,305 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gasoracle_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/attestantio/go-starknet-client/api"
+	"github.com/attestantio/go-starknet-client/gasoracle"
+	"github.com/attestantio/go-starknet-client/spec"
+	"github.com/attestantio/go-starknet-client/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// blockProvider provides blocks with the given L1 gas, L1 data gas and L2 gas
+// prices, the last block being the latest.  Blocks have no L2 gas price if no
+// L2 gas prices are given.
+type blockProvider struct {
+	l1GasPrices     []uint64
+	l1DataGasPrices []uint64
+	l2GasPrices     []uint64
+	err             error
+}
+
+func (b *blockProvider) Block(_ context.Context,
+	opts *api.BlockOpts,
+) (
+	*api.Response[*spec.Block],
+	error,
+) {
+	if b.err != nil {
+		return nil, b.err
+	}
+
+	blockNumber := uint64(len(b.l1GasPrices) - 1)
+	if opts.Block != "latest" {
+		var err error
+		blockNumber, err = strconv.ParseUint(string(opts.Block), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	block := &spec.Block{
+		BlockNumber: &blockNumber,
+		L1GasPrice: spec.Price{
+			PriceInFri: types.AmountFromUint64(b.l1GasPrices[blockNumber]),
+		},
+		L1DataGasPrice: spec.Price{
+			PriceInFri: types.AmountFromUint64(b.l1DataGasPrices[blockNumber]),
+		},
+	}
+	if b.l2GasPrices != nil {
+		block.L2GasPrice = &spec.Price{
+			PriceInFri: types.AmountFromUint64(b.l2GasPrices[blockNumber]),
+		}
+	}
+
+	return &api.Response[*spec.Block]{
+		Data: block,
+	}, nil
+}
+
+func TestNew(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		params []gasoracle.Parameter
+		err    string
+	}{
+		{
+			name: "BlockProviderMissing",
+			params: []gasoracle.Parameter{
+				gasoracle.WithLogLevel(zerolog.Disabled),
+			},
+			err: "no block provider specified",
+		},
+		{
+			name: "BlocksZero",
+			params: []gasoracle.Parameter{
+				gasoracle.WithLogLevel(zerolog.Disabled),
+				gasoracle.WithBlockProvider(&blockProvider{}),
+				gasoracle.WithBlocks(0),
+			},
+			err: "blocks must be at least 1",
+		},
+		{
+			name: "PercentileTooHigh",
+			params: []gasoracle.Parameter{
+				gasoracle.WithLogLevel(zerolog.Disabled),
+				gasoracle.WithBlockProvider(&blockProvider{}),
+				gasoracle.WithPercentile(101),
+			},
+			err: "percentile must be between 0 and 100",
+		},
+		{
+			name: "MultiplierLow",
+			params: []gasoracle.Parameter{
+				gasoracle.WithLogLevel(zerolog.Disabled),
+				gasoracle.WithBlockProvider(&blockProvider{}),
+				gasoracle.WithMultiplier(gasoracle.ResourceL1Gas, 0.9),
+			},
+			err: "multiplier for l1_gas must be at least 1",
+		},
+		{
+			name: "CapUnknownResource",
+			params: []gasoracle.Parameter{
+				gasoracle.WithLogLevel(zerolog.Disabled),
+				gasoracle.WithBlockProvider(&blockProvider{}),
+				gasoracle.WithCap(gasoracle.ResourceUnknown, 1),
+			},
+			err: "cap supplied for unknown resource 0",
+		},
+		{
+			name: "Good",
+			params: []gasoracle.Parameter{
+				gasoracle.WithLogLevel(zerolog.Disabled),
+				gasoracle.WithBlockProvider(&blockProvider{}),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := gasoracle.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRecommendations(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		provider *blockProvider
+		params   []gasoracle.Parameter
+		expected map[gasoracle.Resource]*gasoracle.Recommendation
+		err      string
+	}{
+		{
+			name:     "ProviderError",
+			provider: &blockProvider{err: errors.New("mock")},
+			err:      "failed to obtain latest block\nmock",
+		},
+		{
+			name: "Rising",
+			provider: &blockProvider{
+				l1GasPrices:     []uint64{90, 100, 110, 120, 130, 140},
+				l1DataGasPrices: []uint64{5, 5, 5, 5, 5, 5},
+				l2GasPrices:     []uint64{1000, 1000, 1000, 1000, 1000, 1000},
+			},
+			params: []gasoracle.Parameter{
+				gasoracle.WithBlocks(5),
+			},
+			expected: map[gasoracle.Resource]*gasoracle.Recommendation{
+				gasoracle.ResourceL1Gas: {
+					Resource:    gasoracle.ResourceL1Gas,
+					Samples:     5,
+					Min:         100,
+					Max:         140,
+					Percentile:  120,
+					Trend:       10,
+					Projected:   150,
+					Recommended: 225,
+				},
+				gasoracle.ResourceL1DataGas: {
+					Resource:    gasoracle.ResourceL1DataGas,
+					Samples:     5,
+					Min:         5,
+					Max:         5,
+					Percentile:  5,
+					Projected:   5,
+					Recommended: 8,
+				},
+				gasoracle.ResourceL2Gas: {
+					Resource:    gasoracle.ResourceL2Gas,
+					Samples:     5,
+					Min:         1000,
+					Max:         1000,
+					Percentile:  1000,
+					Projected:   1000,
+					Recommended: 1500,
+				},
+			},
+		},
+		{
+			name: "FallingCapped",
+			provider: &blockProvider{
+				l1GasPrices:     []uint64{200, 180, 160, 140},
+				l1DataGasPrices: []uint64{1, 2, 3, 4},
+			},
+			params: []gasoracle.Parameter{
+				gasoracle.WithPercentile(100),
+				gasoracle.WithMultiplier(gasoracle.ResourceL1Gas, 1),
+				gasoracle.WithCap(gasoracle.ResourceL1DataGas, 6),
+			},
+			expected: map[gasoracle.Resource]*gasoracle.Recommendation{
+				gasoracle.ResourceL1Gas: {
+					Resource:    gasoracle.ResourceL1Gas,
+					Samples:     4,
+					Min:         140,
+					Max:         200,
+					Percentile:  200,
+					Trend:       -20,
+					Projected:   120,
+					Recommended: 200,
+				},
+				gasoracle.ResourceL1DataGas: {
+					Resource:    gasoracle.ResourceL1DataGas,
+					Samples:     4,
+					Min:         1,
+					Max:         4,
+					Percentile:  4,
+					Trend:       1,
+					Projected:   5,
+					Recommended: 6,
+					Capped:      true,
+				},
+				gasoracle.ResourceL2Gas: {
+					Resource: gasoracle.ResourceL2Gas,
+					Samples:  4,
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, err := gasoracle.New(ctx, append([]gasoracle.Parameter{
+				gasoracle.WithLogLevel(zerolog.Disabled),
+				gasoracle.WithBlockProvider(test.provider),
+			}, test.params...)...)
+			require.NoError(t, err)
+
+			res, err := s.Recommendations(ctx)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res)
+			}
+		})
+	}
+}
+
+func TestResourceBounds(t *testing.T) {
+	ctx := context.Background()
+
+	s, err := gasoracle.New(ctx,
+		gasoracle.WithLogLevel(zerolog.Disabled),
+		gasoracle.WithBlockProvider(&blockProvider{
+			l1GasPrices:     []uint64{100, 100},
+			l1DataGasPrices: []uint64{1, 1},
+			l2GasPrices:     []uint64{10, 10},
+		}),
+	)
+	require.NoError(t, err)
+
+	res, err := s.ResourceBounds(ctx, map[gasoracle.Resource]types.Number{
+		gasoracle.ResourceL1Gas:     1000,
+		gasoracle.ResourceL1DataGas: 200,
+		gasoracle.ResourceL2Gas:     50000,
+	})
+	require.NoError(t, err)
+	require.Equal(t, &spec.ResourceBounds{
+		L1Gas: spec.ResourceBound{
+			MaxAmount:       1000,
+			MaxPricePerUnit: 150,
+		},
+		L1DataGas: &spec.ResourceBound{
+			MaxAmount:       200,
+			MaxPricePerUnit: 2,
+		},
+		L2Gas: spec.ResourceBound{
+			MaxAmount:       50000,
+			MaxPricePerUnit: 15,
+		},
+	}, res)
+
+	_, err = s.ResourceBounds(ctx, map[gasoracle.Resource]types.Number{
+		gasoracle.ResourceUnknown: 1000,
+	})
+	require.EqualError(t, err, "resource bounds do not support unknown")
+}
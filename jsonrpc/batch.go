@@ -0,0 +1,278 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	client "github.com/attestantio/go-starknet-client"
+	"github.com/attestantio/go-starknet-client/api"
+	"github.com/attestantio/go-starknet-client/spec"
+	"github.com/attestantio/go-starknet-client/types"
+	"github.com/ybbus/jsonrpc/v2"
+)
+
+// errBatchNotSent is returned when the result of a batch item is requested before the batch is sent.
+var errBatchNotSent = errors.New("batch not sent")
+
+// Batch is a set of requests that are sent to the node together.
+// Requests are queued with the typed methods, which return items that hold
+// the results once the batch has been sent.
+// A batch is not safe for concurrent use; requests must be queued from a
+// single goroutine, or with external synchronisation.
+type Batch struct {
+	service *Service
+	items   []batchItem
+	sent    bool
+}
+
+// batchItem is a request within a batch.
+type batchItem interface {
+	request() *jsonrpc.RPCRequest
+	setResponse(response *jsonrpc.RPCResponse)
+	setError(err error)
+	failed() bool
+}
+
+// BatchItem is a request within a batch, holding its result once the batch has been sent.
+type BatchItem[T any] struct {
+	method string
+	params any
+	parse  func(response *jsonrpc.RPCResponse) (T, error)
+	data   T
+	err    error
+}
+
+// NewBatch creates a new batch of requests.
+func (s *Service) NewBatch() *Batch {
+	return &Batch{
+		service: s,
+		items:   make([]batchItem, 0),
+	}
+}
+
+// Len returns the number of requests in the batch.
+func (b *Batch) Len() int {
+	return len(b.items)
+}
+
+// Block queues a request for a block.
+func (b *Batch) Block(opts *api.BlockOpts) *BatchItem[*spec.Block] {
+	params, err := blockParams(opts)
+	if err == nil {
+		params["block_id"], err = b.service.blockID(opts.Block)
+	}
+
+	return queue(b, "starknet_getBlockWithReceipts", params, err, func(response *jsonrpc.RPCResponse) (*spec.Block, error) {
+		var data spec.Block
+		if err := response.GetObject(&data); err != nil {
+			return nil, err
+		}
+
+		return &data, nil
+	})
+}
+
+// Call queues a call to a contract.
+func (b *Batch) Call(opts *api.CallOpts) *BatchItem[[]types.FieldElement] {
+	params, err := callParams(opts)
+	if err == nil {
+		params["block_id"], err = b.service.blockID(opts.Block)
+	}
+
+	return queue(b, "starknet_call", params, err, func(response *jsonrpc.RPCResponse) ([]types.FieldElement, error) {
+		var data []types.FieldElement
+		if err := response.GetObject(&data); err != nil {
+			return nil, err
+		}
+
+		return data, nil
+	})
+}
+
+// Nonce queues a request for the nonce of a contract.
+func (b *Batch) Nonce(opts *api.NonceOpts) *BatchItem[uint32] {
+	params, err := nonceParams(opts)
+	if err == nil {
+		params["block_id"], err = b.service.blockID(opts.Block)
+	}
+
+	return queue(b, "starknet_getNonce", params, err, func(response *jsonrpc.RPCResponse) (uint32, error) {
+		var data types.Number
+		if err := response.GetObject(&data); err != nil {
+			return 0, err
+		}
+
+		return uint32(data), nil
+	})
+}
+
+// StorageAt queues a request for the value of a storage key of a contract.
+func (b *Batch) StorageAt(opts *api.StorageAtOpts) *BatchItem[types.FieldElement] {
+	params, err := storageAtParams(opts)
+	if err == nil {
+		params["block_id"], err = b.service.blockID(opts.Block)
+	}
+
+	return queue(b, "starknet_getStorageAt", params, err, func(response *jsonrpc.RPCResponse) (types.FieldElement, error) {
+		var data types.FieldElement
+		if err := response.GetObject(&data); err != nil {
+			return types.FieldElement{}, err
+		}
+
+		return data, nil
+	})
+}
+
+// queue adds a request to the batch.  Requests with invalid options are not
+// sent, and hold the error as their result.
+func queue[T any](b *Batch,
+	method string,
+	params any,
+	err error,
+	parse func(response *jsonrpc.RPCResponse) (T, error),
+) *BatchItem[T] {
+	item := &BatchItem[T]{
+		method: method,
+		params: params,
+		parse:  parse,
+		err:    errBatchNotSent,
+	}
+	if err != nil {
+		item.err = err
+	}
+	b.items = append(b.items, item)
+
+	return item
+}
+
+// Send sends the batch to the node, splitting it in to multiple requests if
+// it is larger than the maximum batch size.  An error is returned only if the
+// batch could not be sent; errors for individual requests are held by their
+// items.
+// The common options of the individual requests are ignored; the timeout
+// and sync requirements of the batch are taken from the send options, with
+// the timeout applying to each request sent to the node.
+func (b *Batch) Send(ctx context.Context, opts *BatchSendOpts) error {
+	if opts == nil {
+		return client.ErrNoOptions
+	}
+
+	if b.sent {
+		return errors.New("batch already sent")
+	}
+	b.sent = true
+
+	if err := b.service.assertIsSynced(ctx, &opts.Common); err != nil {
+		return err
+	}
+
+	pending := make([]batchItem, 0, len(b.items))
+	for _, item := range b.items {
+		if !item.failed() {
+			pending = append(pending, item)
+		}
+	}
+
+	for start := 0; start < len(pending); start += b.service.maxBatchSize {
+		end := min(start+b.service.maxBatchSize, len(pending))
+		if err := b.service.sendBatch(ctx, opts.Common.Timeout, pending[start:end]); err != nil {
+			for _, item := range pending[start:] {
+				item.setError(err)
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sendBatch sends a single batch of requests to the node.
+func (s *Service) sendBatch(ctx context.Context, timeout time.Duration, items []batchItem) error {
+	requests := make(jsonrpc.RPCRequests, 0, len(items))
+	for _, item := range items {
+		requests = append(requests, item.request())
+	}
+
+	var responses jsonrpc.RPCResponses
+	err := s.call(ctx, timeout, "batch", func(rpcClient jsonrpc.RPCClient) error {
+		var err error
+		responses, err = rpcClient.CallBatch(requests)
+
+		return err
+	})
+	if err != nil {
+		return errors.Join(errors.New("batch failed"), err, client.ErrRPCCallFailed)
+	}
+
+	// Responses can be returned in any order, so match them by ID.
+	responseMap := responses.AsMap()
+	for i, item := range items {
+		response, exists := responseMap[requests[i].ID]
+		if !exists {
+			item.setError(errors.Join(fmt.Errorf("no response for request %d", i), client.ErrInconsistentResult))
+
+			continue
+		}
+		item.setResponse(response)
+	}
+
+	return nil
+}
+
+// Response returns the response for the item.
+func (i *BatchItem[T]) Response() (*api.Response[T], error) {
+	if i.err != nil {
+		return nil, i.err
+	}
+
+	return &api.Response[T]{
+		Data:     i.data,
+		Metadata: map[string]any{},
+	}, nil
+}
+
+func (i *BatchItem[T]) request() *jsonrpc.RPCRequest {
+	return jsonrpc.NewRequest(i.method, i.params)
+}
+
+func (i *BatchItem[T]) setResponse(response *jsonrpc.RPCResponse) {
+	if response.Error != nil {
+		i.err = errors.Join(parseJSONRPCError(response.Error), client.ErrRPCCallFailed)
+
+		return
+	}
+
+	data, err := i.parse(response)
+	if err != nil {
+		i.err = errors.Join(err, client.ErrUnsupportedFormat)
+
+		return
+	}
+
+	i.data = data
+	i.err = nil
+}
+
+func (i *BatchItem[T]) setError(err error) {
+	i.err = err
+}
+
+func (i *BatchItem[T]) failed() bool {
+	return i.err != nil && !errors.Is(i.err, errBatchNotSent)
+}
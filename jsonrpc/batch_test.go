@@ -0,0 +1,229 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonrpc_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	client "github.com/attestantio/go-starknet-client"
+	"github.com/attestantio/go-starknet-client/api"
+	"github.com/attestantio/go-starknet-client/jsonrpc"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// newBatchTestServer creates a server that responds to batches, recording
+// the size of each batch.
+func newBatchTestServer(t *testing.T) (*httptest.Server, func() []int) {
+	t.Helper()
+
+	var (
+		mu    sync.Mutex
+		sizes []int
+	)
+
+	server := newTestServer(t, func(request *testRequest) (int, string) {
+		if request.Batch == 0 {
+			// Single request, which can only be made when connecting.
+			return 0, ""
+		}
+
+		if request.Index == 0 {
+			mu.Lock()
+			sizes = append(sizes, request.Batch)
+			mu.Unlock()
+		}
+
+		var params map[string]any
+		if err := json.Unmarshal(request.Params, &params); err != nil {
+			return http.StatusBadRequest, ""
+		}
+
+		switch request.Method {
+		case "starknet_getNonce":
+			return http.StatusOK, `"0x5"`
+		case "starknet_getStorageAt":
+			if params["key"] == "0x0" {
+				return statusRPCError, `{"code":20,"message":"Contract not found"}`
+			}
+
+			return http.StatusOK, fmt.Sprintf("%q", params["key"])
+		default:
+			return 0, ""
+		}
+	})
+
+	return server, func() []int {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return slices.Clone(sizes)
+	}
+}
+
+func TestBatch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	server, sizes := newBatchTestServer(t)
+
+	s, err := jsonrpc.New(ctx,
+		jsonrpc.WithLogLevel(zerolog.Disabled),
+		jsonrpc.WithAddress(server.URL),
+		jsonrpc.WithTimeout(timeout),
+		jsonrpc.WithMaxBatchSize(3),
+	)
+	require.NoError(t, err)
+
+	batch := s.NewBatch()
+	nonce := batch.Nonce(&api.NonceOpts{
+		Block:    "latest",
+		Contract: strToAddress("0x1"),
+	})
+	invalid := batch.Nonce(&api.NonceOpts{})
+	storage := batch.StorageAt(&api.StorageAtOpts{
+		Block: "latest",
+		Key:   strToFieldElement("0x1234"),
+	})
+	rejected := batch.StorageAt(&api.StorageAtOpts{
+		Block: "latest",
+		Key:   strToFieldElement("0x0"),
+	})
+	unsupported := batch.Block(&api.BlockOpts{
+		Block: "latest",
+	})
+	storage2 := batch.StorageAt(&api.StorageAtOpts{
+		Block: "latest",
+		Key:   strToFieldElement("0x5678"),
+	})
+	require.Equal(t, 6, batch.Len())
+
+	_, err = nonce.Response()
+	require.EqualError(t, err, "batch not sent")
+
+	require.NoError(t, batch.Send(ctx, &jsonrpc.BatchSendOpts{}))
+	require.Equal(t, []int{3, 2}, sizes())
+
+	nonceRes, err := nonce.Response()
+	require.NoError(t, err)
+	require.Equal(t, uint32(5), nonceRes.Data)
+
+	_, err = invalid.Response()
+	require.EqualError(t, err, "no block specified\ninvalid options")
+
+	storageRes, err := storage.Response()
+	require.NoError(t, err)
+	require.Equal(t, strToFieldElement("0x1234"), storageRes.Data)
+
+	_, err = rejected.Response()
+	require.EqualError(t, err, "20:Contract not found\nRPC call failed")
+
+	_, err = unsupported.Response()
+	require.EqualError(t, err, "-32601:Method not found\nRPC call failed")
+
+	storage2Res, err := storage2.Response()
+	require.NoError(t, err)
+	require.Equal(t, strToFieldElement("0x5678"), storage2Res.Data)
+
+	require.EqualError(t, batch.Send(ctx, &jsonrpc.BatchSendOpts{}), "batch already sent")
+}
+
+func TestBatchSendOpts(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Server is syncing, and slow to respond to batches.
+	server := newTestServer(t, func(request *testRequest) (int, string) {
+		switch {
+		case request.Method == "starknet_syncing":
+			return http.StatusOK, syncingResult
+		case request.Batch > 0:
+			time.Sleep(200 * time.Millisecond)
+
+			return http.StatusOK, `"0x5"`
+		default:
+			return 0, ""
+		}
+	})
+
+	s, err := jsonrpc.New(ctx,
+		jsonrpc.WithLogLevel(zerolog.Disabled),
+		jsonrpc.WithAddress(server.URL),
+		jsonrpc.WithTimeout(timeout),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		opts *jsonrpc.BatchSendOpts
+		err  error
+	}{
+		{
+			name: "Nil",
+			err:  client.ErrNoOptions,
+		},
+		{
+			name: "Unsynced",
+			opts: &jsonrpc.BatchSendOpts{},
+			err:  client.ErrNotSynced,
+		},
+		{
+			name: "AllowUnsynced",
+			opts: &jsonrpc.BatchSendOpts{
+				Common: api.CommonOpts{
+					AllowUnsynced: true,
+				},
+			},
+		},
+		{
+			name: "Timeout",
+			opts: &jsonrpc.BatchSendOpts{
+				Common: api.CommonOpts{
+					Timeout:       50 * time.Millisecond,
+					AllowUnsynced: true,
+				},
+			},
+			err: client.ErrTimeout,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			batch := s.NewBatch()
+			nonce := batch.Nonce(&api.NonceOpts{
+				Block:    "latest",
+				Contract: strToAddress("0x1"),
+			})
+
+			err := batch.Send(ctx, test.opts)
+			if test.err != nil {
+				require.ErrorIs(t, err, test.err)
+
+				return
+			}
+			require.NoError(t, err)
+			res, err := nonce.Response()
+			require.NoError(t, err)
+			require.Equal(t, uint32(5), res.Data)
+		})
+	}
+}
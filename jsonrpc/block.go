@@ -29,25 +29,23 @@ func (s *Service) Block(ctx context.Context,
 	*api.Response[*spec.Block],
 	error,
 ) {
-	if err := s.assertIsSynced(ctx); err != nil {
+	rpcOpts, err := blockParams(opts)
+	if err != nil {
 		return nil, err
 	}
 
-	if opts == nil {
-		return nil, client.ErrNoOptions
-	}
-
-	if opts.Block == "" {
-		return nil, errors.Join(errors.New("no block specified"), client.ErrInvalidOptions)
+	if err := s.assertIsSynced(ctx, &opts.Common); err != nil {
+		return nil, err
 	}
 
-	rpcOpts := map[string]any{
-		"block_id": opts.Block,
+	rpcOpts["block_id"], err = s.blockID(opts.Block)
+	if err != nil {
+		return nil, err
 	}
 
 	var data spec.Block
 
-	err := s.client.CallFor(&data, "starknet_getBlockWithReceipts", rpcOpts)
+	err = s.callFor(ctx, opts.Common.Timeout, &data, "starknet_getBlockWithReceipts", rpcOpts)
 	if err != nil {
 		return nil, errors.Join(errors.New("starknet_getBlockWithReceipts failed"), err)
 	}
@@ -57,3 +55,18 @@ func (s *Service) Block(ctx context.Context,
 		Metadata: map[string]any{},
 	}, nil
 }
+
+// blockParams returns the request parameters for a block.
+func blockParams(opts *api.BlockOpts) (map[string]any, error) {
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	if opts.Block == "" {
+		return nil, errors.Join(errors.New("no block specified"), client.ErrInvalidOptions)
+	}
+
+	return map[string]any{
+		"block_id": opts.Block,
+	}, nil
+}
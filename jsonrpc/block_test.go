@@ -15,7 +15,6 @@ package jsonrpc_test
 
 import (
 	"context"
-	"os"
 	"testing"
 
 	"github.com/attestantio/go-starknet-client/api"
@@ -62,7 +61,7 @@ func TestBlock(t *testing.T) {
 
 	s, err := jsonrpc.New(ctx,
 		jsonrpc.WithLogLevel(zerolog.Disabled),
-		jsonrpc.WithAddress(os.Getenv("JSONRPC_ADDRESS")),
+		jsonrpc.WithAddress(nodeAddress(t)),
 		jsonrpc.WithTimeout(timeout),
 	)
 	require.NoError(t, err)
@@ -34,16 +34,16 @@ func (s *Service) BlockHashAndNumber(ctx context.Context,
 	*api.Response[*api.BlockHashAndNumber],
 	error,
 ) {
-	if err := s.assertIsSynced(ctx); err != nil {
-		return nil, err
-	}
-
 	if opts == nil {
 		return nil, client.ErrNoOptions
 	}
 
+	if err := s.assertIsSynced(ctx, &opts.Common); err != nil {
+		return nil, err
+	}
+
 	res := blockHashAndNumberRes{}
-	if err := s.client.CallFor(&res, "starknet_blockHashAndNumber"); err != nil {
+	if err := s.callFor(ctx, opts.Common.Timeout, &res, "starknet_blockHashAndNumber"); err != nil {
 		return nil, errors.Join(err, client.ErrRPCCallFailed)
 	}
 
@@ -28,16 +28,16 @@ func (s *Service) BlockNumber(ctx context.Context,
 	*api.Response[uint32],
 	error,
 ) {
-	if err := s.assertIsSynced(ctx); err != nil {
-		return nil, err
-	}
-
 	if opts == nil {
 		return nil, client.ErrNoOptions
 	}
 
+	if err := s.assertIsSynced(ctx, &opts.Common); err != nil {
+		return nil, err
+	}
+
 	data := uint32(0)
-	if err := s.client.CallFor(&data, "starknet_blockNumber"); err != nil {
+	if err := s.callFor(ctx, opts.Common.Timeout, &data, "starknet_blockNumber"); err != nil {
 		return nil, errors.Join(err, client.ErrRPCCallFailed)
 	}
 
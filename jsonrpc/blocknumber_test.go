@@ -15,7 +15,6 @@ package jsonrpc_test
 
 import (
 	"context"
-	"os"
 	"testing"
 
 	"github.com/attestantio/go-starknet-client/api"
@@ -30,7 +29,7 @@ func TestBlockNumber(t *testing.T) {
 
 	s, err := jsonrpc.New(ctx,
 		jsonrpc.WithLogLevel(zerolog.Disabled),
-		jsonrpc.WithAddress(os.Getenv("JSONRPC_ADDRESS")),
+		jsonrpc.WithAddress(nodeAddress(t)),
 		jsonrpc.WithTimeout(timeout),
 	)
 	require.NoError(t, err)
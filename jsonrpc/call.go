@@ -21,6 +21,7 @@ import (
 
 	client "github.com/attestantio/go-starknet-client"
 	"github.com/attestantio/go-starknet-client/api"
+	"github.com/attestantio/go-starknet-client/crypto"
 	"github.com/attestantio/go-starknet-client/types"
 	"github.com/ybbus/jsonrpc/v2"
 )
@@ -32,10 +33,35 @@ func (s *Service) Call(ctx context.Context,
 	*api.Response[[]types.FieldElement],
 	error,
 ) {
-	if err := s.assertIsSynced(ctx); err != nil {
+	rpcOpts, err := callParams(opts)
+	if err != nil {
 		return nil, err
 	}
 
+	if err := s.assertIsSynced(ctx, &opts.Common); err != nil {
+		return nil, err
+	}
+
+	rpcOpts["block_id"], err = s.blockID(opts.Block)
+	if err != nil {
+		return nil, err
+	}
+
+	var data []types.FieldElement
+
+	err = s.callFor(ctx, opts.Common.Timeout, &data, "starknet_call", rpcOpts)
+	if err != nil {
+		return nil, parseJSONRPCError(err)
+	}
+
+	return &api.Response[[]types.FieldElement]{
+		Data:     data,
+		Metadata: map[string]any{},
+	}, nil
+}
+
+// callParams returns the request parameters for a call.
+func callParams(opts *api.CallOpts) (map[string]any, error) {
 	if opts == nil {
 		return nil, client.ErrNoOptions
 	}
@@ -44,10 +70,19 @@ func (s *Service) Call(ctx context.Context,
 		return nil, errors.Join(errors.New("no block specified"), client.ErrInvalidOptions)
 	}
 
+	entryPointSelector := opts.EntryPointSelector
+	if opts.EntryPoint != "" {
+		if !entryPointSelector.IsZero() {
+			return nil, errors.Join(errors.New("both entry point and entry point selector specified"), client.ErrInvalidOptions)
+		}
+
+		entryPointSelector = crypto.SelectorFromName(opts.EntryPoint)
+	}
+
 	rpcOpts := make(map[string]any)
 	request := make(map[string]any)
 	request["contract_address"] = opts.Contract.String()
-	request["entry_point_selector"] = opts.EntryPointSelector.String()
+	request["entry_point_selector"] = entryPointSelector.String()
 
 	calldata := make([]string, 0, len(opts.Calldata))
 	for i := range opts.Calldata {
@@ -58,17 +93,7 @@ func (s *Service) Call(ctx context.Context,
 	rpcOpts["request"] = request
 	rpcOpts["block_id"] = opts.Block
 
-	var data []types.FieldElement
-
-	err := s.client.CallFor(&data, "starknet_call", rpcOpts)
-	if err != nil {
-		return nil, parseJSONRPCError(err)
-	}
-
-	return &api.Response[[]types.FieldElement]{
-		Data:     data,
-		Metadata: map[string]any{},
-	}, nil
+	return rpcOpts, nil
 }
 
 // parseJSONRPCError potentially adds more information to a JSONRPC error.
@@ -82,7 +107,7 @@ func parseJSONRPCError(err error) error {
 				return errors.Join(err, client.ErrUnsupportedFormat)
 			}
 
-			err = fmt.Errorf("%s %s", err.Error(), string(additional))
+			err = fmt.Errorf("%w %s", err, string(additional))
 		}
 	}
 
@@ -15,7 +15,6 @@ package jsonrpc_test
 
 import (
 	"context"
-	"os"
 	"testing"
 
 	"github.com/attestantio/go-starknet-client/api"
@@ -87,6 +86,16 @@ func TestCall(t *testing.T) {
 				strToFieldElement("0x9c4"),
 			},
 		},
+		{
+			name: "EntryPointAndSelector",
+			opts: &api.CallOpts{
+				Block:              "latest",
+				Contract:           strToAddress("0x0028c3ac8a0d8e8505486cd2857c309f1557cab0f93d9bb3686704d3cd26af96"),
+				EntryPoint:         "get_public_key",
+				EntryPointSelector: strToFieldElement("0x1a35984e05126dbecb7c3bb9929e7dd9106d460c59b1633739a5c733a5fb13b"),
+			},
+			err: "both entry point and entry point selector specified\ninvalid options",
+		},
 		{
 			name: "Revert",
 			opts: &api.CallOpts{
@@ -103,7 +112,7 @@ func TestCall(t *testing.T) {
 
 	s, err := jsonrpc.New(ctx,
 		jsonrpc.WithLogLevel(zerolog.Disabled),
-		jsonrpc.WithAddress(os.Getenv("JSONRPC_ADDRESS")),
+		jsonrpc.WithAddress(nodeAddress(t)),
 		jsonrpc.WithTimeout(timeout),
 	)
 	require.NoError(t, err)
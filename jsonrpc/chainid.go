@@ -29,16 +29,23 @@ func (s *Service) ChainID(ctx context.Context,
 	*api.Response[types.Data],
 	error,
 ) {
-	if err := s.assertIsActive(ctx); err != nil {
-		return nil, err
-	}
-
 	if opts == nil {
 		return nil, client.ErrNoOptions
 	}
 
+	if chainID := s.cachedChainID(); len(chainID) > 0 {
+		return &api.Response[types.Data]{
+			Data:     chainID,
+			Metadata: map[string]any{},
+		}, nil
+	}
+
+	if err := s.assertIsActive(ctx); err != nil {
+		return nil, err
+	}
+
 	var data types.Data
-	if err := s.client.CallFor(&data, "starknet_chainId"); err != nil {
+	if err := s.callFor(ctx, opts.Common.Timeout, &data, "starknet_chainId"); err != nil {
 		return nil, errors.Join(err, client.ErrRPCCallFailed)
 	}
 
@@ -0,0 +1,158 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonrpc_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	client "github.com/attestantio/go-starknet-client"
+	"github.com/attestantio/go-starknet-client/api"
+	"github.com/attestantio/go-starknet-client/jsonrpc"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// newLaggingTestServer creates a server that reports it is syncing and the
+// given number of blocks behind the chain head.
+func newLaggingTestServer(t *testing.T, lag *atomic.Uint32) *httptest.Server {
+	t.Helper()
+
+	return newTestServer(t, func(request *testRequest) (int, string) {
+		switch request.Method {
+		case "starknet_syncing":
+			return http.StatusOK, fmt.Sprintf(`{"starting_block_hash":"0x1","starting_block_num":1,"current_block_hash":"0x2","current_block_num":100,"highest_block_hash":"0x3","highest_block_num":%d}`, 100+lag.Load())
+		case "starknet_getNonce":
+			return http.StatusOK, `"0x1"`
+		default:
+			return 0, ""
+		}
+	})
+}
+
+func TestSyncTolerance(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tests := []struct {
+		name          string
+		lag           uint32
+		tolerance     uint32
+		allowUnsynced bool
+		synced        bool
+		err           string
+	}{
+		{
+			name:   "Synced",
+			synced: true,
+		},
+		{
+			name: "Lagging",
+			lag:  1,
+			err:  "client is not synced",
+		},
+		{
+			name:          "LaggingAllowUnsynced",
+			lag:           1,
+			allowUnsynced: true,
+		},
+		{
+			name:      "WithinTolerance",
+			lag:       2,
+			tolerance: 2,
+			synced:    true,
+		},
+		{
+			name:      "BeyondTolerance",
+			lag:       3,
+			tolerance: 2,
+			err:       "client is not synced",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			lag := &atomic.Uint32{}
+			lag.Store(test.lag)
+			server := newLaggingTestServer(t, lag)
+
+			s, err := jsonrpc.New(ctx,
+				jsonrpc.WithLogLevel(zerolog.Disabled),
+				jsonrpc.WithAddress(server.URL),
+				jsonrpc.WithTimeout(timeout),
+				jsonrpc.WithSyncTolerance(test.tolerance),
+			)
+			require.NoError(t, err)
+			require.True(t, s.IsActive())
+			require.Equal(t, test.synced, s.IsSynced())
+
+			_, err = s.Nonce(ctx, &api.NonceOpts{
+				Common: api.CommonOpts{
+					AllowUnsynced: test.allowUnsynced,
+				},
+				Block: "latest",
+			})
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				require.ErrorIs(t, err, client.ErrNotSynced)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestHealthCheckInterval(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, err := jsonrpc.New(ctx,
+		jsonrpc.WithLogLevel(zerolog.Disabled),
+		jsonrpc.WithAddress("localhost:1"),
+		jsonrpc.WithTimeout(timeout),
+		jsonrpc.WithHealthCheckInterval(0),
+	)
+	require.EqualError(t, err, "no health check interval specified")
+
+	lag := &atomic.Uint32{}
+	lag.Store(1)
+	server := newLaggingTestServer(t, lag)
+
+	synced := make(chan struct{}, 1)
+	s, err := jsonrpc.New(ctx,
+		jsonrpc.WithLogLevel(zerolog.Disabled),
+		jsonrpc.WithAddress(server.URL),
+		jsonrpc.WithTimeout(timeout),
+		jsonrpc.WithHealthCheckInterval(10*time.Millisecond),
+		jsonrpc.WithSyncedHook(func(_ context.Context, _ *jsonrpc.Service) {
+			synced <- struct{}{}
+		}),
+	)
+	require.NoError(t, err)
+	require.False(t, s.IsSynced())
+
+	// The periodic health check picks up the node catching up.
+	lag.Store(0)
+	select {
+	case <-synced:
+	case <-time.After(time.Second):
+		require.FailNow(t, "node not marked as synced by health check")
+	}
+	require.True(t, s.IsSynced())
+}
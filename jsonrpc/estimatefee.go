@@ -15,6 +15,7 @@ package jsonrpc
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 
 	client "github.com/attestantio/go-starknet-client"
@@ -28,28 +29,43 @@ func (s *Service) EstimateFee(ctx context.Context,
 	*api.Response[[]api.FeeEstimate],
 	error,
 ) {
-	if err := s.assertIsSynced(ctx); err != nil {
-		return nil, err
-	}
-
 	if opts == nil {
 		return nil, client.ErrNoOptions
 	}
 
+	if err := s.assertIsSynced(ctx, &opts.Common); err != nil {
+		return nil, err
+	}
+
 	if opts.Transaction == nil {
 		return nil, errors.Join(errors.New("no transaction specified"), client.ErrInvalidOptions)
 	}
 
+	block, err := s.blockID(opts.Block)
+	if err != nil {
+		return nil, err
+	}
+
 	tx := preFlightTransaction(ctx, opts.Transaction)
 	tx.SetQueryBit()
 
-	var data []api.FeeEstimate
+	encodedTx, err := s.encodeTransaction(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	var encodedData json.RawMessage
 
-	err := s.client.CallFor(&data, "starknet_estimateFee", []any{tx}, []any{"SKIP_VALIDATE"}, opts.Block)
+	err = s.callFor(ctx, opts.Common.Timeout, &encodedData, "starknet_estimateFee", []any{encodedTx}, []any{"SKIP_VALIDATE"}, block)
 	if err != nil {
 		return nil, errors.Join(errors.New("starknet_estimateFee failed"), err)
 	}
 
+	data, err := s.decodeFeeEstimates(encodedData)
+	if err != nil {
+		return nil, err
+	}
+
 	return &api.Response[[]api.FeeEstimate]{
 		Data:     data,
 		Metadata: map[string]any{},
@@ -33,20 +33,33 @@ func (s *Service) Events(ctx context.Context,
 	*api.Response[[]*spec.TransactionEvent],
 	error,
 ) {
-	if err := s.assertIsSynced(ctx); err != nil {
-		return nil, err
-	}
-
 	if opts == nil {
 		return nil, client.ErrNoOptions
 	}
 
+	if err := s.assertIsSynced(ctx, &opts.Common); err != nil {
+		return nil, err
+	}
+
 	if opts.Limit <= 0 {
 		return nil, errors.Join(errors.New("limit must be specified"), client.ErrInvalidOptions)
 	}
 
+	eventsOpts := *opts
+
+	var err error
+	eventsOpts.FromBlock, err = s.blockID(opts.FromBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	eventsOpts.ToBlock, err = s.blockID(opts.ToBlock)
+	if err != nil {
+		return nil, err
+	}
+
 	var res eventsResJSON
-	if err := s.client.CallFor(&res, "starknet_getEvents", opts); err != nil {
+	if err := s.callFor(ctx, opts.Common.Timeout, &res, "starknet_getEvents", &eventsOpts); err != nil {
 		return nil, err
 	}
 
@@ -29,6 +29,8 @@ func preFlightTransaction(ctx context.Context,
 		return preFlightInvokeV1Transaction(ctx, tx)
 	case tx.InvokeV3Transaction != nil:
 		return preFlightInvokeV3Transaction(ctx, tx)
+	case tx.DeployAccountV3Transaction != nil:
+		return preFlightDeployAccountV3Transaction(ctx, tx)
 	}
 
 	return tx
@@ -69,3 +71,25 @@ func preFlightInvokeV3Transaction(_ context.Context,
 
 	return cpTx
 }
+
+func preFlightDeployAccountV3Transaction(_ context.Context,
+	tx *spec.Transaction,
+) *spec.Transaction {
+	cpTx := &spec.Transaction{
+		DeployAccountV3Transaction: tx.DeployAccountV3Transaction.Copy(),
+	}
+
+	if cpTx.DeployAccountV3Transaction.Signature == nil {
+		cpTx.DeployAccountV3Transaction.Signature = types.Signature{}
+	}
+
+	if cpTx.DeployAccountV3Transaction.ConstructorCalldata == nil {
+		cpTx.DeployAccountV3Transaction.ConstructorCalldata = []types.FieldElement{}
+	}
+
+	if cpTx.DeployAccountV3Transaction.PaymasterData == nil {
+		cpTx.DeployAccountV3Transaction.PaymasterData = []types.FieldElement{}
+	}
+
+	return cpTx
+}
@@ -0,0 +1,30 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonrpc
+
+import "context"
+
+// Hook is a function called when the connection state of the node changes.
+// Hooks are called asynchronously, with a context that is not cancelled when
+// the connection state check completes.
+type Hook func(ctx context.Context, s *Service)
+
+// callHook calls the hook asynchronously, if present.
+func (s *Service) callHook(ctx context.Context, hook Hook) {
+	if hook == nil {
+		return
+	}
+
+	go hook(ctx, s)
+}
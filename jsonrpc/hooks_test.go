@@ -0,0 +1,176 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonrpc_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-starknet-client/jsonrpc"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// syncingResult is the result returned by a syncing node.
+const syncingResult = `{"starting_block_hash":"0x1","starting_block_num":1,"current_block_hash":"0x2","current_block_num":2,"highest_block_hash":"0x3","highest_block_num":3}`
+
+// awaitHooks waits for the given number of hook calls, returning their names.
+func awaitHooks(t *testing.T, calls <-chan string, count int) []string {
+	t.Helper()
+
+	res := make([]string, 0, count)
+	for range count {
+		select {
+		case call := <-calls:
+			res = append(res, call)
+		case <-time.After(time.Second):
+			require.FailNow(t, "timed out waiting for hooks", "received %v", res)
+		}
+	}
+
+	// Ensure that there are no further calls.
+	select {
+	case call := <-calls:
+		require.FailNow(t, "unexpected hook", call)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	return res
+}
+
+// newHooksServer returns a server that is unavailable while down is set, and
+// syncing while syncing is set.
+func newHooksServer(t *testing.T, down *atomic.Bool, syncing *atomic.Bool) *httptest.Server {
+	t.Helper()
+
+	return newTestServer(t, func(request *testRequest) (int, string) {
+		switch {
+		case down.Load():
+			return http.StatusServiceUnavailable, ""
+		case request.Method == "starknet_syncing" && syncing.Load():
+			return http.StatusOK, syncingResult
+		default:
+			return 0, ""
+		}
+	})
+}
+
+func TestHooks(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var down, syncing atomic.Bool
+	server := newHooksServer(t, &down, &syncing)
+
+	calls := make(chan string, 16)
+	hook := func(name string) jsonrpc.Hook {
+		return func(_ context.Context, s *jsonrpc.Service) {
+			calls <- fmt.Sprintf("%s %s", name, s.Address())
+		}
+	}
+
+	s, err := jsonrpc.New(ctx,
+		jsonrpc.WithLogLevel(zerolog.Disabled),
+		jsonrpc.WithAddress(server.URL),
+		jsonrpc.WithTimeout(timeout),
+		jsonrpc.WithActiveHook(hook("active")),
+		jsonrpc.WithInactiveHook(hook("inactive")),
+		jsonrpc.WithSyncedHook(hook("synced")),
+		jsonrpc.WithUnsyncedHook(hook("unsynced")),
+	)
+	require.NoError(t, err)
+	active := "active " + s.Address()
+	inactive := "inactive " + s.Address()
+	synced := "synced " + s.Address()
+	unsynced := "unsynced " + s.Address()
+	require.ElementsMatch(t, []string{active, synced}, awaitHooks(t, calls, 2))
+
+	// No transition, so no hooks.
+	s.CheckConnectionState(ctx)
+	require.Empty(t, awaitHooks(t, calls, 0))
+
+	syncing.Store(true)
+	s.CheckConnectionState(ctx)
+	require.Equal(t, []string{unsynced}, awaitHooks(t, calls, 1))
+	require.True(t, s.IsActive())
+	require.False(t, s.IsSynced())
+
+	down.Store(true)
+	s.CheckConnectionState(ctx)
+	require.Equal(t, []string{inactive}, awaitHooks(t, calls, 1))
+	require.False(t, s.IsActive())
+
+	down.Store(false)
+	syncing.Store(false)
+	s.CheckConnectionState(ctx)
+	require.ElementsMatch(t, []string{active, synced}, awaitHooks(t, calls, 2))
+	require.True(t, s.IsActive())
+	require.True(t, s.IsSynced())
+}
+
+func TestHooksConcurrentChecks(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var down, syncing atomic.Bool
+	down.Store(true)
+	server := newHooksServer(t, &down, &syncing)
+
+	// Hooks wait until released, so that they run after the checks' contexts
+	// have been cancelled.
+	release := make(chan struct{})
+	calls := make(chan string, 16)
+	hook := func(name string) jsonrpc.Hook {
+		return func(ctx context.Context, _ *jsonrpc.Service) {
+			<-release
+			calls <- fmt.Sprintf("%s %v", name, ctx.Err())
+		}
+	}
+
+	s, err := jsonrpc.New(ctx,
+		jsonrpc.WithLogLevel(zerolog.Disabled),
+		jsonrpc.WithAddress(server.URL),
+		jsonrpc.WithTimeout(timeout),
+		jsonrpc.WithAllowDelayedStart(true),
+		jsonrpc.WithActiveHook(hook("active")),
+		jsonrpc.WithSyncedHook(hook("synced")),
+	)
+	require.NoError(t, err)
+	require.False(t, s.IsActive())
+
+	down.Store(false)
+	checkCtx, checkCancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.CheckConnectionState(checkCtx)
+		}()
+	}
+	wg.Wait()
+	checkCancel()
+	close(release)
+
+	// Each transition is reported once, with a context that outlives the check.
+	require.ElementsMatch(t, []string{"active <nil>", "synced <nil>"}, awaitHooks(t, calls, 2))
+	require.True(t, s.IsActive())
+	require.True(t, s.IsSynced())
+}
@@ -16,6 +16,9 @@ package jsonrpc_test
 import (
 	"encoding/hex"
 	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"strings"
 	"testing"
@@ -30,9 +33,20 @@ var timeout = 60 * time.Second
 
 func TestMain(m *testing.M) {
 	zerolog.SetGlobalLevel(zerolog.TraceLevel)
-	if os.Getenv("JSONRPC_ADDRESS") != "" {
-		os.Exit(m.Run())
+	os.Exit(m.Run())
+}
+
+// nodeAddress returns the address of the live node used by tests, skipping
+// the test if no address is supplied.
+func nodeAddress(t *testing.T) string {
+	t.Helper()
+
+	address := os.Getenv("JSONRPC_ADDRESS")
+	if address == "" {
+		t.Skip("JSONRPC_ADDRESS not set")
 	}
+
+	return address
 }
 
 // strToHash is a helper to create a hash given a string representation.
@@ -77,3 +91,117 @@ func strToBytes(input string) []byte {
 
 	return bytes
 }
+
+// testChainID is the chain ID returned by test servers.
+const testChainID = "0x534e5f5345504f4c4941"
+
+// staticResults are the results returned by test servers for requests made
+// when connecting.
+var staticResults = map[string]string{
+	"starknet_syncing":     "false",
+	"starknet_chainId":     `"` + testChainID + `"`,
+	"starknet_specVersion": `"0.8.1"`,
+	"juno_version":         `"v0.15.7"`,
+}
+
+// statusRPCError is returned by test server handlers to respond with a
+// JSON-RPC error, whose JSON is returned in place of the result.
+const statusRPCError = -1
+
+// testRequest is a JSON-RPC request received by a test server.
+type testRequest struct {
+	ID     int             `json:"id"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+	// Batch is the number of requests in the batch containing the request,
+	// or 0 if the request was not batched.
+	Batch int `json:"-"`
+	// Index is the index of the request within its batch.
+	Index int `json:"-"`
+}
+
+// isStatic returns true if the method is one whose result is in staticResults.
+func isStatic(method string) bool {
+	_, isStatic := staticResults[method]
+
+	return isStatic
+}
+
+// newTestServer is a helper to create a JSON-RPC server whose responses are
+// provided by the handler, which returns an HTTP status code and a JSON result.
+// A status of 0 leaves the request unhandled, in which case it is answered
+// from staticResults if possible or with a method not found error otherwise.
+// Batch responses are returned in reverse order, so that clients must match
+// them to their requests by ID.
+func newTestServer(t *testing.T, handler func(request *testRequest) (int, string)) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body json.RawMessage
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		if body[0] != '[' {
+			var request testRequest
+			if err := json.Unmarshal(body, &request); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+
+				return
+			}
+
+			status, response := testResponse(&request, handler)
+			w.WriteHeader(status)
+			fmt.Fprint(w, response)
+
+			return
+		}
+
+		var requests []*testRequest
+		if err := json.Unmarshal(body, &requests); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		responses := make([]string, len(requests))
+		for i, request := range requests {
+			request.Batch = len(requests)
+			request.Index = i
+			status, response := testResponse(request, handler)
+			if status != http.StatusOK {
+				w.WriteHeader(status)
+
+				return
+			}
+			responses[len(requests)-1-i] = response
+		}
+		fmt.Fprintf(w, "[%s]", strings.Join(responses, ","))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+// testResponse returns the HTTP status and JSON-RPC response for a request.
+func testResponse(request *testRequest, handler func(request *testRequest) (int, string)) (int, string) {
+	status, result := handler(request)
+	if status == 0 {
+		if staticResult, isStatic := staticResults[request.Method]; isStatic {
+			status, result = http.StatusOK, staticResult
+		} else {
+			status, result = statusRPCError, `{"code":-32601,"message":"Method not found"}`
+		}
+	}
+
+	switch status {
+	case http.StatusOK:
+		return status, fmt.Sprintf(`{"jsonrpc":"2.0","id":%d,"result":%s}`, request.ID, result)
+	case statusRPCError:
+		return http.StatusOK, fmt.Sprintf(`{"jsonrpc":"2.0","id":%d,"error":%s}`, request.ID, result)
+	default:
+		return status, ""
+	}
+}
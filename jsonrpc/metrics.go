@@ -21,7 +21,10 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 )
 
-var stateMetric *prometheus.GaugeVec
+var (
+	stateMetric    *prometheus.GaugeVec
+	attemptsMetric *prometheus.CounterVec
+)
 
 func registerMetrics(ctx context.Context, monitor metrics.Service) error {
 	if stateMetric != nil {
@@ -52,6 +55,16 @@ func registerPrometheusMetrics(_ context.Context) error {
 		return errors.Join(errors.New("failed to register state"), err)
 	}
 
+	attemptsMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "starknetclient",
+		Subsystem: "jsonrpc",
+		Name:      "request_attempts_total",
+		Help:      "The number of request attempts by result (succeeded/retried/failed)",
+	}, []string{"server", "method", "result"})
+	if err := prometheus.Register(attemptsMetric); err != nil {
+		return errors.Join(errors.New("failed to register attempts"), err)
+	}
+
 	return nil
 }
 
@@ -77,3 +90,11 @@ func (s *Service) monitorState(state string) {
 		// Unknown state - metrics will not be updated
 	}
 }
+
+func (s *Service) monitorAttempt(method string, result string) {
+	if attemptsMetric == nil {
+		return
+	}
+
+	attemptsMetric.WithLabelValues(s.address, method, result).Inc()
+}
@@ -29,26 +29,23 @@ func (s *Service) Nonce(ctx context.Context,
 	*api.Response[uint32],
 	error,
 ) {
-	if err := s.assertIsSynced(ctx); err != nil {
+	rpcOpts, err := nonceParams(opts)
+	if err != nil {
 		return nil, err
 	}
 
-	if opts == nil {
-		return nil, client.ErrNoOptions
-	}
-
-	if opts.Block == "" {
-		return nil, errors.Join(errors.New("no block specified"), client.ErrInvalidOptions)
+	if err := s.assertIsSynced(ctx, &opts.Common); err != nil {
+		return nil, err
 	}
 
-	rpcOpts := map[string]any{
-		"block_id":         opts.Block,
-		"contract_address": opts.Contract,
+	rpcOpts["block_id"], err = s.blockID(opts.Block)
+	if err != nil {
+		return nil, err
 	}
 
 	var data types.Number
 
-	err := s.client.CallFor(&data, "starknet_getNonce", rpcOpts)
+	err = s.callFor(ctx, opts.Common.Timeout, &data, "starknet_getNonce", rpcOpts)
 	if err != nil {
 		return nil, errors.Join(err, client.ErrRPCCallFailed)
 	}
@@ -58,3 +55,19 @@ func (s *Service) Nonce(ctx context.Context,
 		Metadata: map[string]any{},
 	}, nil
 }
+
+// nonceParams returns the request parameters for a nonce.
+func nonceParams(opts *api.NonceOpts) (map[string]any, error) {
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	if opts.Block == "" {
+		return nil, errors.Join(errors.New("no block specified"), client.ErrInvalidOptions)
+	}
+
+	return map[string]any{
+		"block_id":         opts.Block,
+		"contract_address": opts.Contract,
+	}, nil
+}
@@ -18,16 +18,27 @@ import (
 	"time"
 
 	"github.com/attestantio/go-starknet-client/metrics"
+	"github.com/attestantio/go-starknet-client/types"
 	"github.com/rs/zerolog"
 )
 
 type parameters struct {
-	logLevel          zerolog.Level
-	monitor           metrics.Service
-	address           string
-	webSocketAddress  string
-	timeout           time.Duration
-	allowDelayedStart bool
+	logLevel            zerolog.Level
+	monitor             metrics.Service
+	address             string
+	webSocketAddress    string
+	timeout             time.Duration
+	allowDelayedStart   bool
+	retryPolicy         *RetryPolicy
+	maxBatchSize        int
+	expectedChainID     types.Data
+	rpcVersion          RPCVersion
+	syncTolerance       uint32
+	healthCheckInterval time.Duration
+	activeHook          Hook
+	inactiveHook        Hook
+	syncedHook          Hook
+	unsyncedHook        Hook
 }
 
 // Parameter is the interface for service parameters.
@@ -70,7 +81,8 @@ func WithWebSocketAddress(address string) Parameter {
 	})
 }
 
-// WithTimeout sets the maximum duration for all requests to the endpoint.
+// WithTimeout sets the maximum duration for requests to the endpoint.
+// This can be overridden for individual requests with their common options.
 func WithTimeout(timeout time.Duration) Parameter {
 	return parameterFunc(func(p *parameters) {
 		p.timeout = timeout
@@ -84,11 +96,94 @@ func WithAllowDelayedStart(allowDelayedStart bool) Parameter {
 	})
 }
 
+// WithRetryPolicy sets the policy for retrying requests that fail with
+// transient errors.  By default requests are not retried.
+func WithRetryPolicy(policy *RetryPolicy) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.retryPolicy = policy
+	})
+}
+
+// WithMaxBatchSize sets the maximum number of requests sent to the endpoint
+// in a single batch.  Larger batches are split.
+func WithMaxBatchSize(maxBatchSize int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxBatchSize = maxBatchSize
+	})
+}
+
+// WithExpectedChainID sets the chain ID that the node is expected to serve.
+// If supplied, the service refuses to use a node serving any other chain.
+func WithExpectedChainID(chainID types.Data) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.expectedChainID = chainID
+	})
+}
+
+// WithRPCVersion pins the version of the RPC specification used to
+// communicate with the node.  If not supplied the version is detected from
+// the node, with versions newer than those supported using the latest
+// supported version.
+func WithRPCVersion(version RPCVersion) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.rpcVersion = version
+	})
+}
+
+// WithSyncTolerance sets the number of blocks that the node can be behind the
+// chain head while syncing and still be considered synced.
+func WithSyncTolerance(blocks uint32) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.syncTolerance = blocks
+	})
+}
+
+// WithHealthCheckInterval sets the interval between checks of the
+// connection state of the node.
+func WithHealthCheckInterval(interval time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.healthCheckInterval = interval
+	})
+}
+
+// WithActiveHook sets a hook that is called when the node becomes active.
+func WithActiveHook(hook Hook) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.activeHook = hook
+	})
+}
+
+// WithInactiveHook sets a hook that is called when the node becomes inactive.
+func WithInactiveHook(hook Hook) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.inactiveHook = hook
+	})
+}
+
+// WithSyncedHook sets a hook that is called when the node becomes synced.
+func WithSyncedHook(hook Hook) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.syncedHook = hook
+	})
+}
+
+// WithUnsyncedHook sets a hook that is called when the node becomes unsynced.
+func WithUnsyncedHook(hook Hook) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.unsyncedHook = hook
+	})
+}
+
 // parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
 func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	parameters := parameters{
 		logLevel: zerolog.GlobalLevel(),
 		timeout:  2 * time.Second,
+		retryPolicy: &RetryPolicy{
+			MaxAttempts: 1,
+		},
+		maxBatchSize:        100,
+		healthCheckInterval: 30 * time.Second,
 	}
 
 	for _, p := range params {
@@ -109,5 +204,21 @@ func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 		return nil, errors.New("no timeout specified")
 	}
 
+	if parameters.retryPolicy == nil {
+		return nil, errors.New("no retry policy specified")
+	}
+
+	if err := parameters.retryPolicy.check(); err != nil {
+		return nil, err
+	}
+
+	if parameters.maxBatchSize < 1 {
+		return nil, errors.New("no maximum batch size specified")
+	}
+
+	if parameters.healthCheckInterval <= 0 {
+		return nil, errors.New("no health check interval specified")
+	}
+
 	return &parameters, nil
 }
@@ -37,7 +37,7 @@ func (s *Service) ProtocolVersion(
 	}
 
 	res := uint32(0)
-	if err := s.client.CallFor(&res, "starknet_protocolVersion"); err != nil {
+	if err := s.callFor(ctx, opts.Common.Timeout, &res, "starknet_protocolVersion"); err != nil {
 		return nil, err
 	}
 
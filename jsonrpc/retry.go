@@ -0,0 +1,190 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonrpc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	client "github.com/attestantio/go-starknet-client"
+	"github.com/ybbus/jsonrpc/v2"
+)
+
+// submissionMethods are the methods that submit transactions.  These are not
+// idempotent, so are only retried if the request cannot have reached the node.
+var submissionMethods = map[string]bool{
+	"starknet_addInvokeTransaction":        true,
+	"starknet_addDeclareTransaction":       true,
+	"starknet_addDeployAccountTransaction": true,
+}
+
+// transientErrors are fragments of transport errors that indicate a
+// transient failure.  The underlying JSON-RPC library flattens transport
+// errors to strings, so they are matched by content.
+var transientErrors = []string{
+	"connection reset",
+	"connection refused",
+	"broken pipe",
+	"timeout",
+	"deadline exceeded",
+	"EOF",
+	"no such host",
+	"server closed idle connection",
+}
+
+// unsentErrors are fragments of transport errors that indicate the request
+// was not delivered to the node.
+var unsentErrors = []string{
+	"connection refused",
+	"no such host",
+}
+
+// callFor calls the given method, retrying transient failures according to the
+// retry policy.  The call is bounded by the given timeout, or the service
+// timeout if zero, and is abandoned if the context is cancelled.
+func (s *Service) callFor(ctx context.Context, timeout time.Duration, out any, method string, params ...any) error {
+	if err := s.checkMethod(method); err != nil {
+		return err
+	}
+
+	return s.call(ctx, timeout, method, func(rpcClient jsonrpc.RPCClient) error {
+		return rpcClient.CallFor(out, method, params...)
+	})
+}
+
+// call carries out a request, retrying transient failures according to the
+// retry policy.
+func (s *Service) call(ctx context.Context,
+	timeout time.Duration,
+	method string,
+	request func(rpcClient jsonrpc.RPCClient) error,
+) error {
+	if timeout == 0 {
+		timeout = s.timeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	retryable := isRetryable
+	if submissionMethods[method] {
+		retryable = isUnsent
+	}
+
+	rpcClient := s.rpcClient(ctx)
+	for attempt := 1; ; attempt++ {
+		err := request(rpcClient)
+		if err == nil {
+			s.monitorAttempt(method, "succeeded")
+
+			return nil
+		}
+
+		if ctx.Err() != nil {
+			s.monitorAttempt(method, "failed")
+
+			if submissionMethods[method] {
+				// The request may have reached the node before it was abandoned.
+				return errors.Join(client.ErrOutcomeUnknown, contextError(ctx, err))
+			}
+
+			return contextError(ctx, err)
+		}
+
+		if attempt >= s.retryPolicy.MaxAttempts || !retryable(err) {
+			s.monitorAttempt(method, "failed")
+
+			return failureError(method, err)
+		}
+		s.monitorAttempt(method, "retried")
+
+		delay := s.retryPolicy.backoff(attempt)
+		s.log.Trace().Str("method", method).Int("attempt", attempt).Dur("delay", delay).Err(err).Msg("Transient failure; retrying")
+
+		select {
+		case <-ctx.Done():
+			return contextError(ctx, err)
+		case <-time.After(delay):
+		}
+	}
+}
+
+// failureError returns an error for a call that has failed.  Transport
+// failures mark the client as unavailable, except for submissions that may
+// have reached the node, whose outcome is unknown.
+func failureError(method string, err error) error {
+	switch {
+	case isUnsent(err):
+		return errors.Join(client.ErrUnavailable, err)
+	case isRetryable(err) && submissionMethods[method]:
+		return errors.Join(client.ErrOutcomeUnknown, err)
+	case isRetryable(err):
+		return errors.Join(client.ErrUnavailable, err)
+	default:
+		return err
+	}
+}
+
+// contextError returns an error for a call abandoned due to its context.
+func contextError(ctx context.Context, err error) error {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return errors.Join(client.ErrTimeout, err)
+	}
+
+	return errors.Join(ctx.Err(), err)
+}
+
+// isRetryable returns true if the error is transient.
+func isRetryable(err error) bool {
+	var rpcErr *jsonrpc.RPCError
+	if errors.As(err, &rpcErr) {
+		// Node returned a response to the request.
+		return false
+	}
+
+	var httpErr *jsonrpc.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.Code == http.StatusTooManyRequests || httpErr.Code >= http.StatusInternalServerError
+	}
+
+	return containsAny(err.Error(), transientErrors)
+}
+
+// isUnsent returns true if the error shows that the request was not delivered.
+func isUnsent(err error) bool {
+	var httpErr *jsonrpc.HTTPError
+	if errors.As(err, &httpErr) {
+		// Rate limiting rejects the request before it is processed.
+		return httpErr.Code == http.StatusTooManyRequests
+	}
+
+	var rpcErr *jsonrpc.RPCError
+	if errors.As(err, &rpcErr) {
+		return false
+	}
+
+	return containsAny(err.Error(), unsentErrors)
+}
+
+func containsAny(msg string, fragments []string) bool {
+	for _, fragment := range fragments {
+		if strings.Contains(msg, fragment) {
+			return true
+		}
+	}
+
+	return false
+}
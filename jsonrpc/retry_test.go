@@ -0,0 +1,193 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonrpc_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	client "github.com/attestantio/go-starknet-client"
+	"github.com/attestantio/go-starknet-client/api"
+	"github.com/attestantio/go-starknet-client/jsonrpc"
+	"github.com/attestantio/go-starknet-client/spec"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	policy := &jsonrpc.RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     10 * time.Millisecond,
+		Multiplier:     2,
+		Jitter:         0.5,
+	}
+
+	tests := []struct {
+		name        string
+		policy      *jsonrpc.RetryPolicy
+		status      int
+		failures    int32
+		attempts    int32
+		err         bool
+		unavailable bool
+	}{
+		{
+			name:        "NoPolicy",
+			status:      http.StatusServiceUnavailable,
+			failures:    1,
+			attempts:    1,
+			err:         true,
+			unavailable: true,
+		},
+		{
+			name:     "Recovered",
+			policy:   policy,
+			status:   http.StatusServiceUnavailable,
+			failures: 2,
+			attempts: 3,
+		},
+		{
+			name:     "RateLimited",
+			policy:   policy,
+			status:   http.StatusTooManyRequests,
+			failures: 1,
+			attempts: 2,
+		},
+		{
+			name:        "Exhausted",
+			policy:      policy,
+			status:      http.StatusBadGateway,
+			failures:    5,
+			attempts:    3,
+			err:         true,
+			unavailable: true,
+		},
+		{
+			name:     "NotRetryable",
+			policy:   policy,
+			status:   http.StatusBadRequest,
+			failures: 1,
+			attempts: 1,
+			err:      true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			attempts := atomic.Int32{}
+			server := newTestServer(t, func(request *testRequest) (int, string) {
+				if isStatic(request.Method) {
+					return 0, ""
+				}
+
+				if attempts.Add(1) <= test.failures {
+					return test.status, ""
+				}
+
+				return http.StatusOK, "12345"
+			})
+
+			params := []jsonrpc.Parameter{
+				jsonrpc.WithLogLevel(zerolog.Disabled),
+				jsonrpc.WithAddress(server.URL),
+				jsonrpc.WithTimeout(timeout),
+			}
+			if test.policy != nil {
+				params = append(params, jsonrpc.WithRetryPolicy(test.policy))
+			}
+			s, err := jsonrpc.New(ctx, params...)
+			require.NoError(t, err)
+
+			res, err := s.BlockNumber(ctx, &api.BlockNumberOpts{})
+			if test.err {
+				require.Error(t, err)
+				require.Equal(t, test.unavailable, errors.Is(err, client.ErrUnavailable))
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, uint32(12345), res.Data)
+			}
+			require.Equal(t, test.attempts, attempts.Load())
+		})
+	}
+}
+
+func TestRetryPolicySubmission(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tests := []struct {
+		name     string
+		status   int
+		attempts int32
+		err      error
+	}{
+		{
+			name:     "Unavailable",
+			status:   http.StatusServiceUnavailable,
+			attempts: 1,
+			err:      client.ErrOutcomeUnknown,
+		},
+		{
+			name:     "RateLimited",
+			status:   http.StatusTooManyRequests,
+			attempts: 4,
+			err:      client.ErrUnavailable,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			attempts := atomic.Int32{}
+			server := newTestServer(t, func(request *testRequest) (int, string) {
+				if request.Method != "starknet_addInvokeTransaction" {
+					return 0, ""
+				}
+				attempts.Add(1)
+
+				return test.status, ""
+			})
+
+			policy := jsonrpc.DefaultRetryPolicy()
+			policy.InitialBackoff = time.Millisecond
+			s, err := jsonrpc.New(ctx,
+				jsonrpc.WithLogLevel(zerolog.Disabled),
+				jsonrpc.WithAddress(server.URL),
+				jsonrpc.WithTimeout(timeout),
+				jsonrpc.WithRetryPolicy(policy),
+			)
+			require.NoError(t, err)
+
+			_, err = s.SubmitTransaction(ctx, &api.SubmitTransactionOpts{
+				Transaction: &spec.Transaction{
+					InvokeV1Transaction: &spec.InvokeV1Transaction{
+						Type:    spec.TransactionTypeInvoke,
+						Version: spec.TransactionVersion1,
+					},
+				},
+			})
+			require.ErrorIs(t, err, test.err)
+			// A submission is either known not to have been sent, or its outcome is unknown.
+			require.NotEqual(t, errors.Is(err, client.ErrUnavailable), errors.Is(err, client.ErrOutcomeUnknown))
+			require.Equal(t, test.attempts, attempts.Load())
+		})
+	}
+}
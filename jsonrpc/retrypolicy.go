@@ -0,0 +1,86 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonrpc
+
+import (
+	"errors"
+	"math"
+	"math/rand/v2"
+	"time"
+)
+
+// RetryPolicy defines how requests that fail with transient errors are retried.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts for a request, including the first.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry.
+	InitialBackoff time.Duration
+	// MaxBackoff is the maximum delay between attempts.
+	MaxBackoff time.Duration
+	// Multiplier is the factor by which the delay increases after each retry.
+	Multiplier float64
+	// Jitter is the fraction of each delay that is randomised, between 0 and 1.
+	Jitter float64
+}
+
+// DefaultRetryPolicy returns a retry policy suitable for most uses.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:    4,
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     2 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+	}
+}
+
+// check checks that the retry policy is valid.
+func (p *RetryPolicy) check() error {
+	if p.MaxAttempts < 1 {
+		return errors.New("retry policy max attempts must be at least 1")
+	}
+
+	if p.MaxAttempts > 1 {
+		if p.InitialBackoff <= 0 {
+			return errors.New("retry policy initial backoff must be positive")
+		}
+
+		if p.MaxBackoff < p.InitialBackoff {
+			return errors.New("retry policy max backoff must not be less than initial backoff")
+		}
+
+		if p.Multiplier < 1 {
+			return errors.New("retry policy multiplier must be at least 1")
+		}
+	}
+
+	if p.Jitter < 0 || p.Jitter > 1 {
+		return errors.New("retry policy jitter must be between 0 and 1")
+	}
+
+	return nil
+}
+
+// backoff returns the delay before the given retry, starting at 1.
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	delay := float64(p.InitialBackoff) * math.Pow(p.Multiplier, float64(retry-1))
+	delay = math.Min(delay, float64(p.MaxBackoff))
+
+	if p.Jitter > 0 {
+		//nolint:gosec
+		delay -= delay * p.Jitter * rand.Float64()
+	}
+
+	return time.Duration(delay)
+}
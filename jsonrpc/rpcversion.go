@@ -0,0 +1,118 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonrpc
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// RPCVersion is a version of the Starknet JSON-RPC specification.
+//
+//nolint:recvcheck
+type RPCVersion uint8
+
+const (
+	// RPCVersionUnknown is an unknown version.
+	RPCVersionUnknown RPCVersion = iota
+	// RPCVersion0_7 is version 0.7 of the specification.
+	RPCVersion0_7
+	// RPCVersion0_8 is version 0.8 of the specification.
+	RPCVersion0_8
+	// RPCVersion0_9 is version 0.9 of the specification.
+	RPCVersion0_9
+)
+
+// rpcVersionLatest is the latest supported RPC version, used for nodes whose
+// spec version is newer than those supported.
+const rpcVersionLatest = RPCVersion0_9
+
+var rpcVersionStrings = [...]string{
+	"unknown",
+	"0.7",
+	"0.8",
+	"0.9",
+}
+
+// ParseRPCVersion parses a specification version as returned by a node, for
+// example "0.8.1", in to its RPC version.
+func ParseRPCVersion(input string) (RPCVersion, error) {
+	parts := strings.Split(strings.TrimPrefix(input, "v"), ".")
+	if len(parts) < 2 {
+		return RPCVersionUnknown, fmt.Errorf("invalid spec version %q", input)
+	}
+
+	majorMinor := fmt.Sprintf("%s.%s", parts[0], parts[1])
+	for i := 1; i < len(rpcVersionStrings); i++ {
+		if rpcVersionStrings[i] == majorMinor {
+			return RPCVersion(i), nil
+		}
+	}
+
+	return RPCVersionUnknown, fmt.Errorf("unsupported spec version %q", input)
+}
+
+// predatesRPCVersions returns true if the specification version is older than
+// all supported RPC versions.
+func predatesRPCVersions(input string) bool {
+	parts := strings.Split(strings.TrimPrefix(input, "v"), ".")
+	if len(parts) < 2 {
+		return false
+	}
+
+	major, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return false
+	}
+	minor, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return false
+	}
+
+	// 0.7 is the earliest supported version.
+	return major == 0 && minor < 7
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v RPCVersion) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf("%q", v.String())), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *RPCVersion) UnmarshalJSON(input []byte) error {
+	var err error
+
+	switch string(input) {
+	case `"0.7"`:
+		*v = RPCVersion0_7
+	case `"0.8"`:
+		*v = RPCVersion0_8
+	case `"0.9"`:
+		*v = RPCVersion0_9
+	default:
+		err = fmt.Errorf("unrecognised RPC version %s", string(input))
+	}
+
+	return err
+}
+
+// String returns a string representation of the version.
+func (v RPCVersion) String() string {
+	if int(v) >= len(rpcVersionStrings) {
+		return rpcVersionStrings[0]
+	}
+
+	return rpcVersionStrings[v]
+}
@@ -0,0 +1,120 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonrpc_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-starknet-client/jsonrpc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRPCVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected jsonrpc.RPCVersion
+		err      string
+	}{
+		{
+			name:  "Empty",
+			input: "",
+			err:   `invalid spec version ""`,
+		},
+		{
+			name:  "Invalid",
+			input: "1",
+			err:   `invalid spec version "1"`,
+		},
+		{
+			name:  "Unsupported",
+			input: "0.6.0",
+			err:   `unsupported spec version "0.6.0"`,
+		},
+		{
+			name:     "0.7",
+			input:    "0.7.1",
+			expected: jsonrpc.RPCVersion0_7,
+		},
+		{
+			name:     "0.8",
+			input:    "0.8.1",
+			expected: jsonrpc.RPCVersion0_8,
+		},
+		{
+			name:     "0.9",
+			input:    "0.9.0-rc.2",
+			expected: jsonrpc.RPCVersion0_9,
+		},
+		{
+			name:     "Prefixed",
+			input:    "v0.8.0",
+			expected: jsonrpc.RPCVersion0_8,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := jsonrpc.ParseRPCVersion(test.input)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res)
+			}
+		})
+	}
+}
+
+func TestRPCVersionJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name:  "Unknown",
+			input: []byte(`"0.6"`),
+			err:   `unrecognised RPC version "0.6"`,
+		},
+		{
+			name:  "0.7",
+			input: []byte(`"0.7"`),
+		},
+		{
+			name:  "0.8",
+			input: []byte(`"0.8"`),
+		},
+		{
+			name:  "0.9",
+			input: []byte(`"0.9"`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res jsonrpc.RPCVersion
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(res)
+				require.NoError(t, err)
+				require.Equal(t, string(test.input), string(rt))
+			}
+		})
+	}
+}
@@ -14,19 +14,21 @@
 package jsonrpc
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"net"
 	"net/http"
 	"net/url"
-	"regexp"
 	"strings"
 	"sync"
 	"time"
 
 	client "github.com/attestantio/go-starknet-client"
 	"github.com/attestantio/go-starknet-client/api"
+	"github.com/attestantio/go-starknet-client/types"
+	"github.com/attestantio/go-starknet-client/util"
 	"github.com/rs/zerolog"
 	zerologger "github.com/rs/zerolog/log"
 	"github.com/ybbus/jsonrpc/v2"
@@ -39,8 +41,27 @@ type Service struct {
 	base             *url.URL
 	address          string
 	webSocketAddress string
-	client           jsonrpc.RPCClient
+	httpClient       *http.Client
+	customHeaders    map[string]string
 	timeout          time.Duration
+	retryPolicy      *RetryPolicy
+	maxBatchSize     int
+	expectedChainID  types.Data
+	pinnedRPCVersion RPCVersion
+	// Connection state.
+	syncTolerance       uint32
+	healthCheckInterval time.Duration
+	// Hooks.
+	activeHook   Hook
+	inactiveHook Hook
+	syncedHook   Hook
+	unsyncedHook Hook
+	// Static values.
+	staticValuesMu sync.RWMutex
+	chainID        types.Data
+	specVersion    string
+	rpcVersion     RPCVersion
+	implementation string
 	// Endpoint support.
 	pingSem          *semaphore.Weighted
 	connectionMu     sync.RWMutex
@@ -80,7 +101,7 @@ func New(ctx context.Context, params ...Parameter) (*Service, error) {
 		},
 	}
 
-	base, address, err := parseAddress(parameters.address)
+	base, address, err := util.ParseAddress(parameters.address)
 	if err != nil {
 		return nil, err
 	}
@@ -104,32 +125,43 @@ func New(ctx context.Context, params ...Parameter) (*Service, error) {
 		"User-Agent": "go-starknet-client/0.1.10",
 	}
 
-	rpcClient := jsonrpc.NewClientWithOpts(base.String(), &jsonrpc.RPCClientOpts{
-		HTTPClient:    httpClient,
-		CustomHeaders: extraHeaders,
-	})
-
 	s := &Service{
-		log:              log,
-		base:             base,
-		client:           rpcClient,
-		address:          address.String(),
-		webSocketAddress: webSocketAddress,
-		timeout:          parameters.timeout,
-		pingSem:          semaphore.NewWeighted(1),
+		log:                 log,
+		base:                base,
+		httpClient:          httpClient,
+		customHeaders:       extraHeaders,
+		address:             address.String(),
+		webSocketAddress:    webSocketAddress,
+		timeout:             parameters.timeout,
+		retryPolicy:         parameters.retryPolicy,
+		maxBatchSize:        parameters.maxBatchSize,
+		expectedChainID:     parameters.expectedChainID,
+		pinnedRPCVersion:    parameters.rpcVersion,
+		syncTolerance:       parameters.syncTolerance,
+		healthCheckInterval: parameters.healthCheckInterval,
+		activeHook:          parameters.activeHook,
+		inactiveHook:        parameters.inactiveHook,
+		syncedHook:          parameters.syncedHook,
+		unsyncedHook:        parameters.unsyncedHook,
+		pingSem:             semaphore.NewWeighted(1),
 	}
 
-	// Ping the client to see if it is ready to serve requests.
+	// Ping the client to see if it is ready to serve requests.  This also
+	// fetches static values, confirming that the connection is good.
 	s.CheckConnectionState(ctx)
 	active := s.IsActive()
 
-	if !active && !parameters.allowDelayedStart {
-		return nil, client.ErrNotActive
+	// Refuse to connect to the wrong network or an unsupported version,
+	// even if starting delayed.
+	if err := s.checkChainID(); err != nil {
+		return nil, err
+	}
+	if err := s.checkRPCVersion(); err != nil {
+		return nil, err
 	}
 
-	// Fetch static values to confirm the connection is good.
-	if err := s.fetchStaticValues(ctx); err != nil {
-		return nil, errors.Join(errors.New("failed to confirm node connection"), err)
+	if !active && !parameters.allowDelayedStart {
+		return nil, client.ErrNotActive
 	}
 
 	// Periodically ping the client for state updates.  We do this so that
@@ -153,9 +185,14 @@ func New(ctx context.Context, params ...Parameter) (*Service, error) {
 func (s *Service) CheckConnectionState(ctx context.Context) {
 	log := zerolog.Ctx(ctx)
 
+	if !s.pingSem.TryAcquire(1) {
+		// Means there is another check running, which will update the state.
+		return
+	}
+	defer s.pingSem.Release(1)
+
 	s.connectionMu.Lock()
 	wasActive := s.connectionActive
-	wasSynced := s.connectionSynced
 	s.connectionMu.Unlock()
 
 	var (
@@ -163,41 +200,32 @@ func (s *Service) CheckConnectionState(ctx context.Context) {
 		synced bool
 	)
 
-	acquired := s.pingSem.TryAcquire(1)
-	if !acquired {
-		// Means there is another ping running, just use current info.
-		active = wasActive
-		synced = wasSynced
+	response, err := s.Syncing(ctx, &api.SyncingOpts{})
+	if err != nil {
+		log.Debug().Err(err).Msg("Failed to obtain sync state from node")
 	} else {
-		response, err := s.Syncing(ctx, &api.SyncingOpts{})
-		if err != nil {
-			log.Debug().Err(err).Msg("Failed to obtain sync state from node")
+		active = true
+		synced = s.withinSyncTolerance(response.Data)
+	}
+
+	if !wasActive && active {
+		// Switched from not active to active; (re)fetch static values.
+		if err := s.fetchStaticValues(ctx); err != nil {
+			log.Debug().Err(err).Msg("Failed to obtain static values from node")
 
 			active = false
 			synced = false
-		} else {
-			active = true
-			synced = !response.Data.Syncing
 		}
-
-		s.pingSem.Release(1)
 	}
 
-	// if !wasActive && active {
-	// 	// Switched from not active to active.
-	// }
-
-	// if wasActive && !active {
-	// 	// Switched from active to not active.
-	// }
-
-	// if !wasSynced && synced {
-	// 	// Switched from not synced to synced.
-	// }
-
-	// if wasSynced && !synced {
-	// 	// Switched from synced to not synced.
-	// }
+	// Compare and update the state under a single lock, so that concurrent
+	// checks cannot both observe the same transition.
+	s.connectionMu.Lock()
+	wasActive = s.connectionActive
+	wasSynced := s.connectionSynced
+	s.connectionActive = active
+	s.connectionSynced = synced
+	s.connectionMu.Unlock()
 
 	if (wasActive != active) || (wasSynced != synced) {
 		log.Trace().
@@ -208,10 +236,28 @@ func (s *Service) CheckConnectionState(ctx context.Context) {
 			Msg("Updated connection state")
 	}
 
-	s.connectionMu.Lock()
-	s.connectionActive = active
-	s.connectionSynced = synced
-	s.connectionMu.Unlock()
+	// Hooks are called after the state is updated, so that they observe it.
+	// They run asynchronously, so must not be bound to the caller's context.
+	hookCtx := context.WithoutCancel(ctx)
+	if !wasActive && active {
+		// Switched from not active to active.
+		s.callHook(hookCtx, s.activeHook)
+	}
+
+	if wasActive && !active {
+		// Switched from active to not active.
+		s.callHook(hookCtx, s.inactiveHook)
+	}
+
+	if !wasSynced && synced {
+		// Switched from not synced to synced.
+		s.callHook(hookCtx, s.syncedHook)
+	}
+
+	if wasSynced && !synced {
+		// Switched from synced to not synced.
+		s.callHook(hookCtx, s.unsyncedHook)
+	}
 
 	switch {
 	case synced:
@@ -223,6 +269,20 @@ func (s *Service) CheckConnectionState(ctx context.Context) {
 	}
 }
 
+// withinSyncTolerance returns true if the node is synced, or is syncing but
+// no more than the sync tolerance behind the chain head.
+func (s *Service) withinSyncTolerance(state *api.SyncState) bool {
+	if !state.Syncing {
+		return true
+	}
+
+	if state.HighestBlockNum < state.CurrentBlockNum {
+		return true
+	}
+
+	return state.HighestBlockNum-state.CurrentBlockNum <= s.syncTolerance
+}
+
 // Name provides the name of the service.
 func (*Service) Name() string {
 	return "json-rpc"
@@ -254,8 +314,7 @@ func (s *Service) IsSynced() bool {
 // periodicUpdateConnectionState periodically pings the client to update its active and synced status.
 func (s *Service) periodicUpdateConnectionState(ctx context.Context) {
 	go func(s *Service, ctx context.Context) {
-		// Refresh every 30 seconds.
-		refreshTicker := time.NewTicker(30 * time.Second)
+		refreshTicker := time.NewTicker(s.healthCheckInterval)
 		defer refreshTicker.Stop()
 
 		for {
@@ -271,10 +330,158 @@ func (s *Service) periodicUpdateConnectionState(ctx context.Context) {
 
 // fetchStaticValues fetches values that never change.
 // This caches the values, avoiding future API calls.
-func (*Service) fetchStaticValues(_ context.Context) error {
+func (s *Service) fetchStaticValues(ctx context.Context) error {
+	var chainID types.Data
+	if err := s.callFor(ctx, 0, &chainID, "starknet_chainId"); err != nil {
+		return errors.Join(errors.New("failed to obtain chain ID"), err)
+	}
+
+	var specVersion string
+	if err := s.callFor(ctx, 0, &specVersion, "starknet_specVersion"); err != nil {
+		return errors.Join(errors.New("failed to obtain spec version"), err)
+	}
+
+	rpcVersion := s.pinnedRPCVersion
+	if rpcVersion == RPCVersionUnknown {
+		var err error
+		rpcVersion, err = ParseRPCVersion(specVersion)
+		if err != nil && !predatesRPCVersions(specVersion) {
+			// Assume that versions newer than those supported remain
+			// compatible with the latest supported version.
+			s.log.Warn().
+				Str("spec_version", specVersion).
+				Stringer("rpc_version", rpcVersionLatest).
+				Msg("Node spec version is not recognised; using latest supported RPC version")
+			rpcVersion = rpcVersionLatest
+		}
+		// An older version is reported by checkRPCVersion.
+	} else if detected, err := ParseRPCVersion(specVersion); err != nil || detected != rpcVersion {
+		s.log.Warn().
+			Str("spec_version", specVersion).
+			Stringer("pinned_version", rpcVersion).
+			Msg("Node spec version does not match pinned RPC version")
+	}
+
+	implementation := s.fetchImplementation(ctx)
+
+	s.staticValuesMu.Lock()
+	s.chainID = chainID
+	s.specVersion = specVersion
+	s.rpcVersion = rpcVersion
+	s.implementation = implementation
+	s.staticValuesMu.Unlock()
+
+	s.log.Trace().
+		Stringer("chain_id", &chainID).
+		Str("spec_version", specVersion).
+		Stringer("rpc_version", rpcVersion).
+		Str("implementation", implementation).
+		Msg("Fetched static values")
+
+	if err := s.checkChainID(); err != nil {
+		return err
+	}
+
+	return s.checkRPCVersion()
+}
+
+// fetchImplementation attempts to identify the node implementation.
+func (s *Service) fetchImplementation(ctx context.Context) string {
+	for _, implementation := range []string{"juno", "pathfinder"} {
+		var version string
+		if err := s.callFor(ctx, 0, &version, implementation+"_version"); err == nil {
+			return fmt.Sprintf("%s/%s", implementation, version)
+		}
+	}
+
+	return "unknown"
+}
+
+// checkChainID returns an error if the chain ID of the node is known and is
+// not that expected.
+func (s *Service) checkChainID() error {
+	if len(s.expectedChainID) == 0 {
+		return nil
+	}
+
+	chainID := s.cachedChainID()
+	if len(chainID) == 0 {
+		return nil
+	}
+
+	if !bytes.Equal(chainID, s.expectedChainID) {
+		return errors.Join(
+			fmt.Errorf("node chain ID %s does not match expected chain ID %s", chainID.String(), s.expectedChainID.String()),
+			client.ErrChainMismatch,
+		)
+	}
+
 	return nil
 }
 
+// checkRPCVersion returns an error if the spec version of the node is known
+// and predates the supported versions.
+func (s *Service) checkRPCVersion() error {
+	s.staticValuesMu.RLock()
+	specVersion := s.specVersion
+	rpcVersion := s.rpcVersion
+	s.staticValuesMu.RUnlock()
+
+	if specVersion == "" || rpcVersion != RPCVersionUnknown {
+		return nil
+	}
+
+	return errors.Join(fmt.Errorf("node spec version %s is not supported", specVersion), client.ErrUnsupportedVersion)
+}
+
+// RPCVersion returns the version of the RPC specification used to communicate
+// with the node, or RPCVersionUnknown if it is not yet known.
+func (s *Service) RPCVersion() RPCVersion {
+	s.staticValuesMu.RLock()
+	defer s.staticValuesMu.RUnlock()
+
+	return s.rpcVersion
+}
+
+// cachedChainID returns the cached chain ID of the node, if known.
+func (s *Service) cachedChainID() types.Data {
+	s.staticValuesMu.RLock()
+	defer s.staticValuesMu.RUnlock()
+
+	return s.chainID
+}
+
+// cachedSpecVersion returns the cached spec version of the node, if known.
+func (s *Service) cachedSpecVersion() string {
+	s.staticValuesMu.RLock()
+	defer s.staticValuesMu.RUnlock()
+
+	return s.specVersion
+}
+
+// Implementation returns the implementation of the node, for example
+// "juno/v0.15.7", or "unknown" if it cannot be identified.
+func (s *Service) Implementation() string {
+	s.staticValuesMu.RLock()
+	defer s.staticValuesMu.RUnlock()
+
+	if s.implementation == "" {
+		return "unknown"
+	}
+
+	return s.implementation
+}
+
+// rpcClient returns a JSON-RPC client whose requests are bound to the context.
+func (s *Service) rpcClient(ctx context.Context) jsonrpc.RPCClient {
+	return jsonrpc.NewClientWithOpts(s.base.String(), &jsonrpc.RPCClientOpts{
+		HTTPClient: &http.Client{
+			Transport: util.ContextTransport(ctx, s.httpClient.Transport),
+		},
+		CustomHeaders: s.customHeaders,
+	})
+}
+
 // close closes the service, freeing up resources.
 func (*Service) close() {
 }
@@ -295,7 +502,13 @@ func (s *Service) assertIsActive(ctx context.Context) error {
 	return nil
 }
 
-func (s *Service) assertIsSynced(ctx context.Context) error {
+// assertIsSynced returns an error if the node is not synced, or if the
+// common options allow an unsynced node and it is not active.
+func (s *Service) assertIsSynced(ctx context.Context, opts *api.CommonOpts) error {
+	if opts != nil && opts.AllowUnsynced {
+		return s.assertIsActive(ctx)
+	}
+
 	synced := s.IsSynced()
 	if synced {
 		return nil
@@ -315,38 +528,3 @@ func (s *Service) assertIsSynced(ctx context.Context) error {
 
 	return nil
 }
-
-//nolint:revive
-func parseAddress(address string) (*url.URL, *url.URL, error) {
-	if !strings.HasPrefix(address, "http") {
-		address = fmt.Sprintf("http://%s", address)
-	}
-
-	base, err := url.Parse(address)
-	if err != nil {
-		return nil, nil, errors.Join(errors.New("invalid URL"), err)
-	}
-	// Remove any trailing slash from the path.
-	base.Path = strings.TrimSuffix(base.Path, "/")
-
-	// Attempt to mask any sensitive information in the URL, for logging purposes.
-	baseAddress := *base
-	if _, pwExists := baseAddress.User.Password(); pwExists {
-		// Mask the password.
-		user := baseAddress.User.Username()
-		baseAddress.User = url.UserPassword(user, "xxxxx")
-	}
-
-	if baseAddress.Path != "" {
-		// Mask the path.
-		baseAddress.Path = "xxxxx"
-	}
-
-	if baseAddress.RawQuery != "" {
-		// Mask all query values.
-		sensitiveRegex := regexp.MustCompile("=([^&]*)(&)?")
-		baseAddress.RawQuery = sensitiveRegex.ReplaceAllString(baseAddress.RawQuery, "=xxxxx$2")
-	}
-
-	return base, &baseAddress, nil
-}
@@ -15,7 +15,6 @@ package jsonrpc_test
 
 import (
 	"context"
-	"os"
 	"testing"
 	"time"
 
@@ -29,6 +28,10 @@ import (
 func TestService(t *testing.T) {
 	ctx := context.Background()
 
+	server := newTestServer(t, func(*testRequest) (int, string) {
+		return 0, ""
+	})
+
 	tests := []struct {
 		name       string
 		parameters []jsonrpc.Parameter
@@ -51,11 +54,29 @@ func TestService(t *testing.T) {
 			name: "TimeoutZero",
 			parameters: []jsonrpc.Parameter{
 				jsonrpc.WithLogLevel(zerolog.Disabled),
-				jsonrpc.WithAddress(os.Getenv("JSONRPC_ADDRESS")),
+				jsonrpc.WithAddress(server.URL),
 				jsonrpc.WithTimeout(0),
 			},
 			err: "no timeout specified",
 		},
+		{
+			name: "RetryPolicyNil",
+			parameters: []jsonrpc.Parameter{
+				jsonrpc.WithLogLevel(zerolog.Disabled),
+				jsonrpc.WithAddress(server.URL),
+				jsonrpc.WithRetryPolicy(nil),
+			},
+			err: "no retry policy specified",
+		},
+		{
+			name: "RetryPolicyInvalid",
+			parameters: []jsonrpc.Parameter{
+				jsonrpc.WithLogLevel(zerolog.Disabled),
+				jsonrpc.WithAddress(server.URL),
+				jsonrpc.WithRetryPolicy(&jsonrpc.RetryPolicy{}),
+			},
+			err: "retry policy max attempts must be at least 1",
+		},
 		{
 			name: "AddressInvalid",
 			parameters: []jsonrpc.Parameter{
@@ -69,7 +90,7 @@ func TestService(t *testing.T) {
 			name: "Good",
 			parameters: []jsonrpc.Parameter{
 				jsonrpc.WithLogLevel(zerolog.Disabled),
-				jsonrpc.WithAddress(os.Getenv("JSONRPC_ADDRESS")),
+				jsonrpc.WithAddress(server.URL),
 				jsonrpc.WithTimeout(5 * time.Second),
 			},
 		},
@@ -89,9 +110,13 @@ func TestService(t *testing.T) {
 
 func TestInterfaces(t *testing.T) {
 	ctx := context.Background()
+
+	server := newTestServer(t, func(*testRequest) (int, string) {
+		return 0, ""
+	})
 	s, err := jsonrpc.New(ctx,
 		jsonrpc.WithLogLevel(zerolog.Disabled),
-		jsonrpc.WithAddress(os.Getenv("JSONRPC_ADDRESS")),
+		jsonrpc.WithAddress(server.URL),
 		jsonrpc.WithTimeout(5*time.Second),
 	)
 	require.NoError(t, err)
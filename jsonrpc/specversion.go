@@ -27,16 +27,23 @@ func (s *Service) SpecVersion(ctx context.Context,
 	*api.Response[string],
 	error,
 ) {
-	if err := s.assertIsActive(ctx); err != nil {
-		return nil, err
-	}
-
 	if opts == nil {
 		return nil, client.ErrNoOptions
 	}
 
+	if specVersion := s.cachedSpecVersion(); len(specVersion) > 0 {
+		return &api.Response[string]{
+			Data:     specVersion,
+			Metadata: map[string]any{},
+		}, nil
+	}
+
+	if err := s.assertIsActive(ctx); err != nil {
+		return nil, err
+	}
+
 	var data string
-	if err := s.client.CallFor(&data, "starknet_specVersion"); err != nil {
+	if err := s.callFor(ctx, opts.Common.Timeout, &data, "starknet_specVersion"); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,142 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonrpc_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	client "github.com/attestantio/go-starknet-client"
+	"github.com/attestantio/go-starknet-client/api"
+	"github.com/attestantio/go-starknet-client/jsonrpc"
+	"github.com/attestantio/go-starknet-client/spec"
+	"github.com/attestantio/go-starknet-client/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStaticValues(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	calls := atomic.Int32{}
+	server := newTestServer(t, func(request *testRequest) (int, string) {
+		if isStatic(request.Method) {
+			return 0, ""
+		}
+
+		calls.Add(1)
+
+		return http.StatusOK, `"0x1"`
+	})
+
+	s, err := jsonrpc.New(ctx,
+		jsonrpc.WithLogLevel(zerolog.Disabled),
+		jsonrpc.WithAddress(server.URL),
+		jsonrpc.WithTimeout(timeout),
+		jsonrpc.WithExpectedChainID(strToBytes(testChainID)),
+	)
+	require.NoError(t, err)
+	require.Equal(t, "juno/v0.15.7", s.Implementation())
+
+	chainID, err := s.ChainID(ctx, &api.ChainIDOpts{})
+	require.NoError(t, err)
+	require.Equal(t, types.Data(strToBytes(testChainID)), chainID.Data)
+
+	specVersion, err := s.SpecVersion(ctx, &api.SpecVersionOpts{})
+	require.NoError(t, err)
+	require.Equal(t, "0.8.1", specVersion.Data)
+
+	// Values are returned without calling the node.
+	require.Equal(t, int32(0), calls.Load())
+}
+
+func TestExpectedChainID(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	server := newTestServer(t, func(request *testRequest) (int, string) {
+		if isStatic(request.Method) {
+			return 0, ""
+		}
+
+		return http.StatusOK, `"0x1"`
+	})
+
+	tests := []struct {
+		name              string
+		allowDelayedStart bool
+	}{
+		{
+			name: "Immediate",
+		},
+		{
+			name:              "DelayedStart",
+			allowDelayedStart: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := jsonrpc.New(ctx,
+				jsonrpc.WithLogLevel(zerolog.Disabled),
+				jsonrpc.WithAddress(server.URL),
+				jsonrpc.WithTimeout(timeout),
+				jsonrpc.WithAllowDelayedStart(test.allowDelayedStart),
+				// SN_MAIN.
+				jsonrpc.WithExpectedChainID(strToBytes("0x534e5f4d41494e")),
+			)
+			require.EqualError(t, err, "node chain ID 0x534e5f5345504f4c4941 does not match expected chain ID 0x534e5f4d41494e\nchain ID mismatch")
+		})
+	}
+}
+
+func TestSubmitTransactionChainID(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	submissions := atomic.Int32{}
+	server := newTestServer(t, func(request *testRequest) (int, string) {
+		if isStatic(request.Method) {
+			return 0, ""
+		}
+
+		submissions.Add(1)
+
+		return http.StatusOK, `{"transaction_hash":"0x1"}`
+	})
+
+	s, err := jsonrpc.New(ctx,
+		jsonrpc.WithLogLevel(zerolog.Disabled),
+		jsonrpc.WithAddress(server.URL),
+		jsonrpc.WithTimeout(timeout),
+	)
+	require.NoError(t, err)
+
+	_, err = s.SubmitTransaction(ctx, &api.SubmitTransactionOpts{
+		Transaction: &spec.Transaction{
+			InvokeV1Transaction: &spec.InvokeV1Transaction{
+				Type:    spec.TransactionTypeInvoke,
+				Version: spec.TransactionVersion1,
+			},
+		},
+		// SN_MAIN.
+		ChainID: strToBytes("0x534e5f4d41494e"),
+	})
+	require.True(t, errors.Is(err, client.ErrChainMismatch))
+	require.Equal(t, int32(0), submissions.Load())
+}
@@ -0,0 +1,74 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonrpc
+
+import (
+	"context"
+	"errors"
+
+	client "github.com/attestantio/go-starknet-client"
+	"github.com/attestantio/go-starknet-client/api"
+	"github.com/attestantio/go-starknet-client/types"
+)
+
+// StorageAt returns the value of the given storage key of the given contract at the given block.
+func (s *Service) StorageAt(ctx context.Context,
+	opts *api.StorageAtOpts,
+) (
+	*api.Response[types.FieldElement],
+	error,
+) {
+	rpcOpts, err := storageAtParams(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.assertIsSynced(ctx, &opts.Common); err != nil {
+		return nil, err
+	}
+
+	rpcOpts["block_id"], err = s.blockID(opts.Block)
+	if err != nil {
+		return nil, err
+	}
+
+	var data types.FieldElement
+
+	err = s.callFor(ctx, opts.Common.Timeout, &data, "starknet_getStorageAt", rpcOpts)
+	if err != nil {
+		return nil, errors.Join(err, client.ErrRPCCallFailed)
+	}
+
+	return &api.Response[types.FieldElement]{
+		Data:     data,
+		Metadata: map[string]any{},
+	}, nil
+}
+
+// storageAtParams returns the request parameters for a storage value.
+func storageAtParams(opts *api.StorageAtOpts) (map[string]any, error) {
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	if opts.Block == "" {
+		return nil, errors.Join(errors.New("no block specified"), client.ErrInvalidOptions)
+	}
+
+	return map[string]any{
+		"contract_address": opts.Contract.String(),
+		"key":              opts.Key.String(),
+		"block_id":         opts.Block,
+	}, nil
+}
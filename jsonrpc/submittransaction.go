@@ -14,12 +14,14 @@
 package jsonrpc
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
 
 	client "github.com/attestantio/go-starknet-client"
 	"github.com/attestantio/go-starknet-client/api"
-	"github.com/attestantio/go-starknet-client/spec"
+	"github.com/attestantio/go-starknet-client/types"
 )
 
 // SubmitTransaction submits a transaction to the client.
@@ -29,7 +31,7 @@ func (s *Service) SubmitTransaction(ctx context.Context,
 	*api.Response[*api.SubmitTransactionResponse],
 	error,
 ) {
-	if err := s.assertIsSynced(ctx); err != nil {
+	if err := s.assertIsSynced(ctx, nil); err != nil {
 		return nil, err
 	}
 
@@ -41,6 +43,12 @@ func (s *Service) SubmitTransaction(ctx context.Context,
 		return nil, errors.Join(errors.New("no transaction specified"), client.ErrInvalidOptions)
 	}
 
+	if len(opts.ChainID) > 0 {
+		if err := s.checkSigningChainID(ctx, opts.ChainID); err != nil {
+			return nil, err
+		}
+	}
+
 	opts.Transaction = preFlightTransaction(ctx, opts.Transaction)
 
 	switch {
@@ -48,20 +56,27 @@ func (s *Service) SubmitTransaction(ctx context.Context,
 		return s.invokeV1Transaction(ctx, opts)
 	case opts.Transaction.InvokeV3Transaction != nil:
 		return s.invokeV3Transaction(ctx, opts)
+	case opts.Transaction.DeployAccountV3Transaction != nil:
+		return s.deployAccountV3Transaction(ctx, opts)
 	default:
 		return nil, errors.New("unhandled transaction type")
 	}
 }
 
-func (s *Service) invokeV1Transaction(_ context.Context,
+func (s *Service) invokeV1Transaction(ctx context.Context,
 	opts *api.SubmitTransactionOpts,
 ) (
 	*api.Response[*api.SubmitTransactionResponse],
 	error,
 ) {
+	tx, err := s.encodeTransaction(opts.Transaction)
+	if err != nil {
+		return nil, err
+	}
+
 	var data api.SubmitTransactionResponse
 
-	err := s.client.CallFor(&data, "starknet_addInvokeTransaction", []*spec.Transaction{opts.Transaction})
+	err = s.callFor(ctx, opts.Common.Timeout, &data, "starknet_addInvokeTransaction", []any{tx})
 	if err != nil {
 		return nil, errors.Join(errors.New("starknet_call failed"), err)
 	}
@@ -72,15 +87,20 @@ func (s *Service) invokeV1Transaction(_ context.Context,
 	}, nil
 }
 
-func (s *Service) invokeV3Transaction(_ context.Context,
+func (s *Service) invokeV3Transaction(ctx context.Context,
 	opts *api.SubmitTransactionOpts,
 ) (
 	*api.Response[*api.SubmitTransactionResponse],
 	error,
 ) {
+	tx, err := s.encodeTransaction(opts.Transaction)
+	if err != nil {
+		return nil, err
+	}
+
 	var data api.SubmitTransactionResponse
 
-	err := s.client.CallFor(&data, "starknet_addInvokeTransaction", []*spec.Transaction{opts.Transaction})
+	err = s.callFor(ctx, opts.Common.Timeout, &data, "starknet_addInvokeTransaction", []any{tx})
 	if err != nil {
 		return nil, errors.Join(errors.New("starknet_call failed"), err)
 	}
@@ -90,3 +110,45 @@ func (s *Service) invokeV3Transaction(_ context.Context,
 		Metadata: map[string]any{},
 	}, nil
 }
+
+func (s *Service) deployAccountV3Transaction(ctx context.Context,
+	opts *api.SubmitTransactionOpts,
+) (
+	*api.Response[*api.SubmitTransactionResponse],
+	error,
+) {
+	tx, err := s.encodeTransaction(opts.Transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	var data api.SubmitTransactionResponse
+
+	err = s.callFor(ctx, opts.Common.Timeout, &data, "starknet_addDeployAccountTransaction", []any{tx})
+	if err != nil {
+		return nil, errors.Join(errors.New("starknet_addDeployAccountTransaction failed"), err)
+	}
+
+	return &api.Response[*api.SubmitTransactionResponse]{
+		Data:     &data,
+		Metadata: map[string]any{},
+	}, nil
+}
+
+// checkSigningChainID returns an error if the chain with which a transaction
+// was signed is not that of the node.
+func (s *Service) checkSigningChainID(ctx context.Context, chainID types.Data) error {
+	response, err := s.ChainID(ctx, &api.ChainIDOpts{})
+	if err != nil {
+		return errors.Join(errors.New("failed to obtain chain ID"), err)
+	}
+
+	if !bytes.Equal(response.Data, chainID) {
+		return errors.Join(
+			fmt.Errorf("transaction signed for chain ID %s but node chain ID is %s", chainID.String(), response.Data.String()),
+			client.ErrChainMismatch,
+		)
+	}
+
+	return nil
+}
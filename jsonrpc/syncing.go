@@ -21,7 +21,7 @@ import (
 )
 
 // Syncing obtains information about the sync state of the node.
-func (s *Service) Syncing(_ context.Context,
+func (s *Service) Syncing(ctx context.Context,
 	opts *api.SyncingOpts,
 ) (
 	*api.Response[*api.SyncState],
@@ -33,7 +33,7 @@ func (s *Service) Syncing(_ context.Context,
 	}
 
 	var data api.SyncState
-	if err := s.client.CallFor(&data, "starknet_syncing"); err != nil {
+	if err := s.callFor(ctx, opts.Common.Timeout, &data, "starknet_syncing"); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,109 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonrpc_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	client "github.com/attestantio/go-starknet-client"
+	"github.com/attestantio/go-starknet-client/api"
+	"github.com/attestantio/go-starknet-client/jsonrpc"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeout(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	server := newTestServer(t, func(request *testRequest) (int, string) {
+		if isStatic(request.Method) {
+			return 0, ""
+		}
+
+		time.Sleep(500 * time.Millisecond)
+
+		return http.StatusOK, "12345"
+	})
+
+	tests := []struct {
+		name           string
+		serviceTimeout time.Duration
+		callTimeout    time.Duration
+		cancelAfter    time.Duration
+		err            error
+	}{
+		{
+			name:           "Completed",
+			serviceTimeout: 5 * time.Second,
+		},
+		{
+			name:           "ServiceTimeout",
+			serviceTimeout: 50 * time.Millisecond,
+			err:            client.ErrTimeout,
+		},
+		{
+			name:           "CallTimeout",
+			serviceTimeout: 5 * time.Second,
+			callTimeout:    50 * time.Millisecond,
+			err:            client.ErrTimeout,
+		},
+		{
+			name:           "CallTimeoutOverridesService",
+			serviceTimeout: 50 * time.Millisecond,
+			callTimeout:    5 * time.Second,
+		},
+		{
+			name:           "Cancelled",
+			serviceTimeout: 5 * time.Second,
+			cancelAfter:    50 * time.Millisecond,
+			err:            context.Canceled,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, err := jsonrpc.New(ctx,
+				jsonrpc.WithLogLevel(zerolog.Disabled),
+				jsonrpc.WithAddress(server.URL),
+				jsonrpc.WithTimeout(test.serviceTimeout),
+			)
+			require.NoError(t, err)
+
+			callCtx, callCancel := context.WithCancel(ctx)
+			defer callCancel()
+			if test.cancelAfter > 0 {
+				time.AfterFunc(test.cancelAfter, callCancel)
+			}
+
+			started := time.Now()
+			res, err := s.BlockNumber(callCtx, &api.BlockNumberOpts{
+				Common: api.CommonOpts{
+					Timeout: test.callTimeout,
+				},
+			})
+			if test.err != nil {
+				require.True(t, errors.Is(err, test.err), err)
+				require.Less(t, time.Since(started), 400*time.Millisecond)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, uint32(12345), res.Data)
+			}
+		})
+	}
+}
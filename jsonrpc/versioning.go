@@ -0,0 +1,143 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonrpc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	client "github.com/attestantio/go-starknet-client"
+	"github.com/attestantio/go-starknet-client/api"
+	"github.com/attestantio/go-starknet-client/spec"
+	"github.com/attestantio/go-starknet-client/types"
+)
+
+// methodVersions are the RPC versions that provide methods not available in
+// all supported versions.
+var methodVersions = map[string][]RPCVersion{
+	"starknet_getCompiledCasm":   {RPCVersion0_8, RPCVersion0_9},
+	"starknet_getMessagesStatus": {RPCVersion0_8, RPCVersion0_9},
+	"starknet_getStorageProof":   {RPCVersion0_8, RPCVersion0_9},
+}
+
+// blockTagVersions are the RPC versions that provide block tags not
+// available in all supported versions.
+var blockTagVersions = map[types.BlockID][]RPCVersion{
+	types.BlockIDL1Accepted: {RPCVersion0_9},
+}
+
+// checkMethod returns an error if the method is not available on the RPC
+// version of the node.
+func (s *Service) checkMethod(method string) error {
+	return checkAvailable(s.RPCVersion(), methodVersions[method], "method "+method)
+}
+
+// blockID returns the block ID to send to the node, returning an error if it
+// is invalid or not available on the RPC version of the node.
+// The pending block was replaced by the pre-confirmed block in 0.9, so each
+// tag is translated to the other as required.
+func (s *Service) blockID(block types.BlockID) (types.BlockID, error) {
+	if err := block.Validate(); err != nil {
+		return "", errors.Join(err, client.ErrInvalidOptions)
+	}
+
+	version := s.RPCVersion()
+	switch {
+	case block == types.BlockIDPending && version == RPCVersion0_9:
+		return types.BlockIDPreConfirmed, nil
+	case block == types.BlockIDPreConfirmed && (version == RPCVersion0_7 || version == RPCVersion0_8):
+		return types.BlockIDPending, nil
+	}
+
+	if err := checkAvailable(version, blockTagVersions[block], fmt.Sprintf("block tag %q", block)); err != nil {
+		return "", err
+	}
+
+	return block, nil
+}
+
+func checkAvailable(version RPCVersion, versions []RPCVersion, name string) error {
+	if version == RPCVersionUnknown || versions == nil || slices.Contains(versions, version) {
+		return nil
+	}
+
+	return errors.Join(fmt.Errorf("%s is not available on RPC version %s", name, version.String()), client.ErrUnsupportedVersion)
+}
+
+// encodeTransaction encodes a transaction for the RPC version of the node.
+func (s *Service) encodeTransaction(tx *spec.Transaction) (any, error) {
+	if s.RPCVersion() != RPCVersion0_7 {
+		return tx, nil
+	}
+
+	data, err := json.Marshal(tx)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to encode transaction"), err)
+	}
+
+	encoded := make(map[string]any)
+	if err := json.Unmarshal(data, &encoded); err != nil {
+		return nil, errors.Join(errors.New("failed to encode transaction"), err)
+	}
+
+	// L1 data gas bounds were introduced in 0.8.
+	if resourceBounds, exists := encoded["resource_bounds"].(map[string]any); exists {
+		delete(resourceBounds, "l1_data_gas")
+	}
+
+	return encoded, nil
+}
+
+// feeEstimateJSON0_7 is the representation of a fee estimate prior to RPC version 0.8.
+type feeEstimateJSON0_7 struct {
+	GasConsumed     types.Number `json:"gas_consumed"`
+	GasPrice        types.Number `json:"gas_price"`
+	DataGasConsumed types.Number `json:"data_gas_consumed"`
+	DataGasPrice    types.Number `json:"data_gas_price"`
+	OverallFee      types.Number `json:"overall_fee"`
+	Unit            spec.FeeUnit `json:"unit"`
+}
+
+// decodeFeeEstimates decodes fee estimates from the RPC version of the node.
+func (s *Service) decodeFeeEstimates(data json.RawMessage) ([]api.FeeEstimate, error) {
+	if s.RPCVersion() != RPCVersion0_7 {
+		var res []api.FeeEstimate
+		if err := json.Unmarshal(data, &res); err != nil {
+			return nil, errors.Join(errors.New("failed to decode fee estimates"), client.ErrUnsupportedFormat, err)
+		}
+
+		return res, nil
+	}
+
+	var estimates []*feeEstimateJSON0_7
+	if err := json.Unmarshal(data, &estimates); err != nil {
+		return nil, errors.Join(errors.New("failed to decode fee estimates"), client.ErrUnsupportedFormat, err)
+	}
+
+	res := make([]api.FeeEstimate, 0, len(estimates))
+	for _, estimate := range estimates {
+		res = append(res, api.FeeEstimate{
+			L1GasConsumed:     estimate.GasConsumed,
+			L1GasPrice:        estimate.GasPrice,
+			L1DataGasConsumed: estimate.DataGasConsumed,
+			L1DataGasPrice:    estimate.DataGasPrice,
+			OverallFee:        estimate.OverallFee,
+			Unit:              estimate.Unit,
+		})
+	}
+
+	return res, nil
+}
@@ -0,0 +1,314 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonrpc_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/attestantio/go-starknet-client/api"
+	"github.com/attestantio/go-starknet-client/jsonrpc"
+	"github.com/attestantio/go-starknet-client/spec"
+	"github.com/attestantio/go-starknet-client/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// feeEstimates are fee estimates as returned by nodes of each spec version.
+var feeEstimates = map[string]string{
+	"0.7": `[{"gas_consumed":"0x10","gas_price":"0x2","data_gas_consumed":"0x3","data_gas_price":"0x4","overall_fee":"0x2c","unit":"FRI"}]`,
+	"0.8": `[{"l1_gas_consumed":"0x10","l1_gas_price":"0x2","l2_gas_consumed":"0x5","l2_gas_price":"0x1","l1_data_gas_consumed":"0x3","l1_data_gas_price":"0x4","overall_fee":"0x31","unit":"FRI"}]`,
+}
+
+// newVersionedTestServer creates a server that reports the given spec
+// version, recording the parameters of fee estimation and nonce requests.
+func newVersionedTestServer(t *testing.T, specVersion string) (*httptest.Server, func() json.RawMessage) {
+	t.Helper()
+
+	var (
+		mu     sync.Mutex
+		params json.RawMessage
+	)
+
+	record := func(request *testRequest) {
+		mu.Lock()
+		params = request.Params
+		mu.Unlock()
+	}
+
+	server := newTestServer(t, func(request *testRequest) (int, string) {
+		switch request.Method {
+		case "starknet_specVersion":
+			return http.StatusOK, fmt.Sprintf("%q", specVersion)
+		case "starknet_estimateFee":
+			record(request)
+
+			return http.StatusOK, feeEstimates[specVersion[:3]]
+		case "starknet_getNonce":
+			record(request)
+
+			return http.StatusOK, `"0x1"`
+		default:
+			return 0, ""
+		}
+	})
+
+	return server, func() json.RawMessage {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return params
+	}
+}
+
+func TestRPCVersionDetection(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tests := []struct {
+		name        string
+		specVersion string
+		pinned      jsonrpc.RPCVersion
+		expected    jsonrpc.RPCVersion
+		err         string
+	}{
+		{
+			name:        "0.7",
+			specVersion: "0.7.1",
+			expected:    jsonrpc.RPCVersion0_7,
+		},
+		{
+			name:        "0.8",
+			specVersion: "0.8.1",
+			expected:    jsonrpc.RPCVersion0_8,
+		},
+		{
+			name:        "0.9",
+			specVersion: "0.9.0",
+			expected:    jsonrpc.RPCVersion0_9,
+		},
+		{
+			name:        "Newer",
+			specVersion: "0.10.0",
+			expected:    jsonrpc.RPCVersion0_9,
+		},
+		{
+			name:        "Unrecognised",
+			specVersion: "invalid",
+			expected:    jsonrpc.RPCVersion0_9,
+		},
+		{
+			name:        "Unsupported",
+			specVersion: "0.6.0",
+			err:         "node spec version 0.6.0 is not supported\nunsupported by node version",
+		},
+		{
+			name:        "Pinned",
+			specVersion: "0.6.0",
+			pinned:      jsonrpc.RPCVersion0_7,
+			expected:    jsonrpc.RPCVersion0_7,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server, _ := newVersionedTestServer(t, test.specVersion)
+
+			s, err := jsonrpc.New(ctx,
+				jsonrpc.WithLogLevel(zerolog.Disabled),
+				jsonrpc.WithAddress(server.URL),
+				jsonrpc.WithTimeout(timeout),
+				jsonrpc.WithRPCVersion(test.pinned),
+			)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expected, s.RPCVersion())
+		})
+	}
+}
+
+func TestRPCVersionBlockTags(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tests := []struct {
+		name        string
+		specVersion string
+		block       types.BlockID
+		sent        string
+		err         string
+	}{
+		{
+			name:        "Pending",
+			specVersion: "0.8.1",
+			block:       types.BlockIDPending,
+			sent:        `"pending"`,
+		},
+		{
+			name:        "PendingTranslated",
+			specVersion: "0.9.0",
+			block:       types.BlockIDPending,
+			sent:        `"pre_confirmed"`,
+		},
+		{
+			name:        "PreConfirmed",
+			specVersion: "0.9.0",
+			block:       types.BlockIDPreConfirmed,
+			sent:        `"pre_confirmed"`,
+		},
+		{
+			name:        "PreConfirmedTranslated",
+			specVersion: "0.7.1",
+			block:       types.BlockIDPreConfirmed,
+			sent:        `"pending"`,
+		},
+		{
+			name:        "L1Accepted",
+			specVersion: "0.9.0",
+			block:       types.BlockIDL1Accepted,
+			sent:        `"l1_accepted"`,
+		},
+		{
+			name:        "L1AcceptedUnavailable",
+			specVersion: "0.8.1",
+			block:       types.BlockIDL1Accepted,
+			err:         "block tag \"l1_accepted\" is not available on RPC version 0.8\nunsupported by node version",
+		},
+		{
+			name:        "Latest",
+			specVersion: "0.9.0",
+			block:       types.BlockIDLatest,
+			sent:        `"latest"`,
+		},
+		{
+			name:        "Invalid",
+			specVersion: "0.9.0",
+			block:       "safe",
+			err:         "invalid block ID \"safe\"\ninvalid options",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server, params := newVersionedTestServer(t, test.specVersion)
+
+			s, err := jsonrpc.New(ctx,
+				jsonrpc.WithLogLevel(zerolog.Disabled),
+				jsonrpc.WithAddress(server.URL),
+				jsonrpc.WithTimeout(timeout),
+			)
+			require.NoError(t, err)
+
+			_, err = s.Nonce(ctx, &api.NonceOpts{
+				Block: test.block,
+			})
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				var sent struct {
+					BlockID json.RawMessage `json:"block_id"`
+				}
+				require.NoError(t, json.Unmarshal(params(), &sent))
+				require.Equal(t, test.sent, string(sent.BlockID))
+			}
+		})
+	}
+}
+
+func TestRPCVersionEstimateFee(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tests := []struct {
+		name          string
+		specVersion   string
+		expected      api.FeeEstimate
+		l1DataGasSent bool
+	}{
+		{
+			name:        "0.7",
+			specVersion: "0.7.1",
+			expected: api.FeeEstimate{
+				L1GasConsumed:     0x10,
+				L1GasPrice:        0x2,
+				L1DataGasConsumed: 0x3,
+				L1DataGasPrice:    0x4,
+				OverallFee:        0x2c,
+				Unit:              spec.FeeUnitFri,
+			},
+		},
+		{
+			name:        "0.8",
+			specVersion: "0.8.1",
+			expected: api.FeeEstimate{
+				L1GasConsumed:     0x10,
+				L1GasPrice:        0x2,
+				L1DataGasConsumed: 0x3,
+				L1DataGasPrice:    0x4,
+				L2GasConsumed:     0x5,
+				L2GasPrice:        0x1,
+				OverallFee:        0x31,
+				Unit:              spec.FeeUnitFri,
+			},
+			l1DataGasSent: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server, params := newVersionedTestServer(t, test.specVersion)
+
+			s, err := jsonrpc.New(ctx,
+				jsonrpc.WithLogLevel(zerolog.Disabled),
+				jsonrpc.WithAddress(server.URL),
+				jsonrpc.WithTimeout(timeout),
+			)
+			require.NoError(t, err)
+
+			res, err := s.EstimateFee(ctx, &api.EstimateFeeOpts{
+				Block: "latest",
+				Transaction: &spec.Transaction{
+					InvokeV3Transaction: &spec.InvokeV3Transaction{
+						Type:    spec.TransactionTypeInvoke,
+						Version: spec.TransactionVersion3,
+						ResourceBounds: spec.ResourceBounds{
+							L1DataGas: &spec.ResourceBound{},
+						},
+					},
+				},
+			})
+			require.NoError(t, err)
+			require.Equal(t, []api.FeeEstimate{test.expected}, res.Data)
+
+			var sent []json.RawMessage
+			require.NoError(t, json.Unmarshal(params(), &sent))
+			var txs []struct {
+				ResourceBounds map[string]json.RawMessage `json:"resource_bounds"`
+			}
+			require.NoError(t, json.Unmarshal(sent[0], &txs))
+			_, exists := txs[0].ResourceBounds["l1_data_gas"]
+			require.Equal(t, test.l1DataGasSent, exists)
+		})
+	}
+}
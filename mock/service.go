@@ -85,6 +85,16 @@ func (*Service) ChainID(_ context.Context,
 	return &api.Response[types.Data]{}, nil
 }
 
+// EstimateFee estimates the fee for a transaction.
+func (*Service) EstimateFee(_ context.Context,
+	_ *api.EstimateFeeOpts,
+) (
+	*api.Response[[]api.FeeEstimate],
+	error,
+) {
+	return &api.Response[[]api.FeeEstimate]{}, nil
+}
+
 // Events returns the events matching the filter.
 func (*Service) Events(_ context.Context,
 	_ *api.EventsOpts,
@@ -134,3 +144,13 @@ func (*Service) Syncing(_ context.Context,
 ) {
 	return &api.Response[*api.SyncState]{}, nil
 }
+
+// SubmitTransaction submits a transaction to the client.
+func (*Service) SubmitTransaction(_ context.Context,
+	_ *api.SubmitTransactionOpts,
+) (
+	*api.Response[*api.SubmitTransactionResponse],
+	error,
+) {
+	return &api.Response[*api.SubmitTransactionResponse]{}, nil
+}
@@ -0,0 +1,48 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	client "github.com/attestantio/go-starknet-client"
+	"github.com/attestantio/go-starknet-client/api"
+	"github.com/attestantio/go-starknet-client/spec"
+)
+
+// Block returns the block as per the given parameters.
+//
+// If Block is a consensus method the result is only returned once a quorum
+// of clients agree on it.
+func (s *Service) Block(ctx context.Context,
+	opts *api.BlockOpts,
+) (
+	*api.Response[*spec.Block],
+	error,
+) {
+	call := func(ctx context.Context, c client.Service) (*api.Response[*spec.Block], error) {
+		provider, isProvider := c.(client.BlockProvider)
+		if !isProvider {
+			return nil, errNotProvider
+		}
+
+		return provider.Block(ctx, opts)
+	}
+
+	if s.consensusMethods["Block"] {
+		return doConsensusCall(ctx, s, "Block", call)
+	}
+
+	return doCall(ctx, s, "Block", call)
+}
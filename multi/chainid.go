@@ -0,0 +1,39 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	client "github.com/attestantio/go-starknet-client"
+	"github.com/attestantio/go-starknet-client/api"
+	"github.com/attestantio/go-starknet-client/types"
+)
+
+// ChainID returns the chain ID.
+func (s *Service) ChainID(ctx context.Context,
+	opts *api.ChainIDOpts,
+) (
+	*api.Response[types.Data],
+	error,
+) {
+	return doCall(ctx, s, "ChainID", func(ctx context.Context, c client.Service) (*api.Response[types.Data], error) {
+		provider, isProvider := c.(client.ChainIDProvider)
+		if !isProvider {
+			return nil, errNotProvider
+		}
+
+		return provider.ChainID(ctx, opts)
+	})
+}
@@ -0,0 +1,253 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	client "github.com/attestantio/go-starknet-client"
+	"github.com/attestantio/go-starknet-client/api"
+)
+
+// supportedConsensusMethods are the methods that support consensus reads.
+var supportedConsensusMethods = map[string]bool{
+	"Block":  true,
+	"Call":   true,
+	"Events": true,
+	"Nonce":  true,
+}
+
+// consensusResult is the result of a call to an individual client.
+type consensusResult[T any] struct {
+	// index is the index of the client in the service.
+	index  int
+	client client.Service
+	res    *api.Response[T]
+	err    error
+	// data is the encoded data returned by the client.
+	data json.RawMessage
+	// key identifies the result for comparison; it is empty if the client
+	// was unavailable.
+	key string
+}
+
+// doConsensusCall carries out a call against the consensus clients in
+// parallel, returning the result once a quorum of clients agree on it.  Deterministic
+// errors are treated as results, so a quorum of clients rejecting a request
+// returns that error.
+func doConsensusCall[T any](ctx context.Context,
+	s *Service,
+	method string,
+	call func(ctx context.Context, c client.Service) (*api.Response[T], error),
+) (
+	*api.Response[T],
+	error,
+) {
+	clients := s.callClients()
+	if len(clients) < s.quorum {
+		monitorConsensus(method, "failed")
+
+		return nil, errors.Join(fmt.Errorf("%d clients available for quorum of %d", len(clients), s.quorum), client.ErrNoConsensus)
+	}
+	if s.consensusClients > 0 && len(clients) > s.consensusClients {
+		clients = clients[:s.consensusClients]
+	}
+
+	resultsCh := make(chan *consensusResult[T], len(clients))
+	for _, index := range clients {
+		go func(index int) {
+			c := s.clients[index]
+			res, err := call(ctx, c)
+			resultsCh <- newConsensusResult(ctx, index, c, res, err)
+		}(index)
+	}
+
+	results := make([]*consensusResult[T], 0, len(clients))
+	groups := make(map[string]int)
+	largestGroup := 0
+	for len(results) < len(clients) {
+		var result *consensusResult[T]
+		select {
+		case result = <-resultsCh:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		results = append(results, result)
+
+		if result.key == "" {
+			s.clientFailed(result.index, method, result.err)
+
+			continue
+		}
+
+		groups[result.key]++
+		largestGroup = max(largestGroup, groups[result.key])
+		if groups[result.key] >= s.quorum {
+			return consensusReached(s, method, result, results, resultsCh, len(clients)-len(results))
+		}
+
+		if largestGroup+len(clients)-len(results) < s.quorum {
+			// Not enough clients remaining to reach a quorum.
+			break
+		}
+	}
+
+	report := consensusReport(s.quorum, "", results)
+	s.log.Warn().Str("method", method).Stringer("report", report).Msg("Clients failed to reach consensus")
+	monitorConsensus(method, "failed")
+
+	return nil, errors.Join(fmt.Errorf("%s failed to reach quorum of %d", method, s.quorum), client.ErrNoConsensus)
+}
+
+// consensusReached returns the agreed result, reporting any disagreements.
+func consensusReached[T any](s *Service,
+	method string,
+	agreed *consensusResult[T],
+	results []*consensusResult[T],
+	resultsCh chan *consensusResult[T],
+	outstanding int,
+) (
+	*api.Response[T],
+	error,
+) {
+	report := consensusReport(s.quorum, agreed.key, results)
+	if len(report.Disagreeing) > 0 {
+		s.log.Warn().Str("method", method).Stringer("report", report).Msg("Clients disagree on result")
+		monitorConsensus(method, "disagreed")
+	} else {
+		monitorConsensus(method, "agreed")
+	}
+
+	if outstanding > 0 {
+		// Report on results that arrive after consensus has been reached.
+		go reportLateResults(s, method, agreed.key, resultsCh, outstanding)
+	}
+
+	if agreed.err != nil {
+		return nil, agreed.err
+	}
+
+	res := agreed.res
+	if res.Metadata == nil {
+		res.Metadata = make(map[string]any)
+	}
+	res.Metadata["consensus"] = report
+
+	return res, nil
+}
+
+// reportLateResults reports disagreements from results that arrive after
+// consensus has been reached.
+func reportLateResults[T any](s *Service,
+	method string,
+	key string,
+	resultsCh chan *consensusResult[T],
+	outstanding int,
+) {
+	for range outstanding {
+		result := <-resultsCh
+		if result.key == key {
+			continue
+		}
+
+		if result.key == "" {
+			s.log.Debug().Str("method", method).Str("client", result.client.Address()).Err(result.err).Msg("Client failed after consensus reached")
+
+			continue
+		}
+
+		log := s.log.Warn().Str("method", method).Str("client", result.client.Address())
+		if result.err != nil {
+			log = log.Err(result.err)
+		} else {
+			log = log.RawJSON("data", result.data)
+		}
+		log.Msg("Client disagrees with consensus result")
+	}
+}
+
+// newConsensusResult creates a consensus result from the response of a client.
+func newConsensusResult[T any](ctx context.Context,
+	index int,
+	c client.Service,
+	res *api.Response[T],
+	err error,
+) *consensusResult[T] {
+	result := &consensusResult[T]{
+		index:  index,
+		client: c,
+		res:    res,
+		err:    err,
+	}
+
+	switch {
+	case err != nil:
+		if !shouldFailover(ctx, err) {
+			result.key = fmt.Sprintf("error:%s", err.Error())
+		}
+	case res == nil:
+		result.err = client.ErrInconsistentResult
+	default:
+		data, err := json.Marshal(res.Data)
+		if err != nil {
+			result.err = errors.Join(client.ErrUnsupportedFormat, err)
+
+			break
+		}
+		result.data = data
+		result.key = fmt.Sprintf("data:%s", string(data))
+	}
+
+	return result
+}
+
+// consensusReport creates a report from the results received.
+func consensusReport[T any](quorum int,
+	key string,
+	results []*consensusResult[T],
+) *ConsensusReport {
+	report := &ConsensusReport{
+		Quorum:   quorum,
+		Agreeing: make([]string, 0, quorum),
+	}
+
+	for _, result := range results {
+		switch {
+		case key != "" && result.key == key:
+			report.Agreeing = append(report.Agreeing, result.client.Address())
+		case result.key == "":
+			report.Unavailable = append(report.Unavailable, newNodeResult(result))
+		default:
+			report.Disagreeing = append(report.Disagreeing, newNodeResult(result))
+		}
+	}
+
+	return report
+}
+
+// newNodeResult creates a node result for a consensus report.
+func newNodeResult[T any](result *consensusResult[T]) *NodeResult {
+	res := &NodeResult{
+		Address: result.client.Address(),
+		Data:    result.data,
+	}
+	if result.err != nil {
+		res.Error = result.err.Error()
+	}
+
+	return res
+}
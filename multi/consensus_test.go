@@ -0,0 +1,220 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	client "github.com/attestantio/go-starknet-client"
+	"github.com/attestantio/go-starknet-client/api"
+	"github.com/attestantio/go-starknet-client/multi"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"github.com/ybbus/jsonrpc/v2"
+)
+
+func nonceClient(address string, nonce uint32, delay time.Duration, err error) *stubClient {
+	c := newStubClient(address, true, true, err)
+	c.nonce = nonce
+	c.delay = delay
+
+	return c
+}
+
+func TestConsensus(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		clients     []*stubClient
+		quorum      int
+		expected    uint32
+		agreeing    []string
+		disagreeing []string
+		unavailable []string
+		err         string
+	}{
+		{
+			name: "Agreed",
+			clients: []*stubClient{
+				nonceClient("1", 5, 0, nil),
+				nonceClient("2", 5, 0, nil),
+			},
+			quorum:   2,
+			expected: 5,
+			agreeing: []string{"1", "2"},
+		},
+		{
+			name: "Disagreement",
+			clients: []*stubClient{
+				nonceClient("1", 5, 20*time.Millisecond, nil),
+				nonceClient("2", 6, 0, nil),
+				nonceClient("3", 5, 40*time.Millisecond, nil),
+			},
+			quorum:      2,
+			expected:    5,
+			agreeing:    []string{"1", "3"},
+			disagreeing: []string{"2"},
+		},
+		{
+			name: "Unavailable",
+			clients: []*stubClient{
+				nonceClient("1", 5, 20*time.Millisecond, nil),
+				nonceClient("2", 5, 0, errNetwork),
+				nonceClient("3", 5, 40*time.Millisecond, nil),
+			},
+			quorum:      2,
+			expected:    5,
+			agreeing:    []string{"1", "3"},
+			unavailable: []string{"2"},
+		},
+		{
+			name: "AgreedError",
+			clients: []*stubClient{
+				nonceClient("1", 0, 0, &jsonrpc.RPCError{Code: 20, Message: "Contract not found"}),
+				nonceClient("2", 0, 0, &jsonrpc.RPCError{Code: 20, Message: "Contract not found"}),
+			},
+			quorum: 2,
+			err:    "20:Contract not found",
+		},
+		{
+			name: "NoConsensus",
+			clients: []*stubClient{
+				nonceClient("1", 5, 0, nil),
+				nonceClient("2", 6, 0, nil),
+				nonceClient("3", 7, 0, nil),
+			},
+			quorum: 2,
+			err:    "Nonce failed to reach quorum of 2\nno consensus",
+		},
+		{
+			name: "NotEnoughAvailable",
+			clients: []*stubClient{
+				nonceClient("1", 5, 0, nil),
+				nonceClient("2", 5, 0, errNetwork),
+			},
+			quorum: 2,
+			err:    "Nonce failed to reach quorum of 2\nno consensus",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			clients := make([]client.Service, 0, len(test.clients))
+			for _, c := range test.clients {
+				clients = append(clients, c)
+			}
+			s, err := multi.New(ctx,
+				multi.WithLogLevel(zerolog.Disabled),
+				multi.WithClients(clients),
+				multi.WithQuorum(test.quorum),
+				multi.WithConsensusMethods("Nonce"),
+			)
+			require.NoError(t, err)
+
+			res, err := s.Nonce(ctx, &api.NonceOpts{})
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expected, res.Data)
+
+			report, isReport := res.Metadata["consensus"].(*multi.ConsensusReport)
+			require.True(t, isReport)
+			require.Equal(t, test.quorum, report.Quorum)
+			require.ElementsMatch(t, test.agreeing, report.Agreeing)
+			disagreeing := make([]string, 0)
+			for _, result := range report.Disagreeing {
+				disagreeing = append(disagreeing, result.Address)
+			}
+			require.ElementsMatch(t, test.disagreeing, disagreeing)
+			unavailable := make([]string, 0)
+			for _, result := range report.Unavailable {
+				unavailable = append(unavailable, result.Address)
+			}
+			require.ElementsMatch(t, test.unavailable, unavailable)
+		})
+	}
+}
+
+func TestConsensusUnavailable(t *testing.T) {
+	ctx := context.Background()
+
+	s, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients([]client.Service{
+			nonceClient("1", 5, 0, nil),
+			newStubClient("2", false, false, nil),
+		}),
+		multi.WithQuorum(2),
+		multi.WithConsensusMethods("Nonce"),
+	)
+	require.NoError(t, err)
+
+	_, err = s.Nonce(ctx, &api.NonceOpts{})
+	require.EqualError(t, err, "1 clients available for quorum of 2\nno consensus")
+}
+
+func TestConsensusClients(t *testing.T) {
+	ctx := context.Background()
+
+	clients := []*stubClient{
+		nonceClient("1", 5, 0, nil),
+		nonceClient("2", 5, 0, nil),
+		nonceClient("3", 5, 0, nil),
+	}
+	s, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients([]client.Service{clients[0], clients[1], clients[2]}),
+		multi.WithQuorum(2),
+		multi.WithConsensusMethods("Nonce"),
+		multi.WithConsensusClients(2),
+	)
+	require.NoError(t, err)
+
+	res, err := s.Nonce(ctx, &api.NonceOpts{})
+	require.NoError(t, err)
+	require.Equal(t, uint32(5), res.Data)
+	require.Equal(t, []int32{1, 1, 0}, []int32{clients[0].calls.Load(), clients[1].calls.Load(), clients[2].calls.Load()})
+}
+
+func TestConsensusMethods(t *testing.T) {
+	ctx := context.Background()
+
+	clients := []*stubClient{
+		nonceClient("1", 5, 0, nil),
+		nonceClient("2", 5, 0, nil),
+	}
+	s, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients([]client.Service{clients[0], clients[1]}),
+		multi.WithQuorum(2),
+		multi.WithConsensusMethods("Nonce"),
+	)
+	require.NoError(t, err)
+
+	// BlockNumber is not a consensus method, so is sent to a single client.
+	_, err = s.BlockNumber(ctx, &api.BlockNumberOpts{})
+	require.NoError(t, err)
+	require.Equal(t, []int32{1, 0}, []int32{clients[0].calls.Load(), clients[1].calls.Load()})
+
+	res, err := s.Nonce(ctx, &api.NonceOpts{})
+	require.NoError(t, err)
+	require.Contains(t, res.Metadata, "consensus")
+	require.Equal(t, []int32{2, 1}, []int32{clients[0].calls.Load(), clients[1].calls.Load()})
+}
@@ -0,0 +1,52 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ConsensusReport details the results of a consensus read.  It is provided
+// in the response metadata under the key "consensus".
+type ConsensusReport struct {
+	// Quorum is the number of clients required to agree.
+	Quorum int `json:"quorum"`
+	// Agreeing are the addresses of the clients that returned the agreed result.
+	Agreeing []string `json:"agreeing"`
+	// Disagreeing are the clients that returned a different result.
+	Disagreeing []*NodeResult `json:"disagreeing,omitempty"`
+	// Unavailable are the clients that failed to return a result.
+	Unavailable []*NodeResult `json:"unavailable,omitempty"`
+}
+
+// NodeResult is the result returned by an individual client.
+type NodeResult struct {
+	// Address is the address of the client.
+	Address string `json:"address"`
+	// Data is the data returned by the client, if any.
+	Data json.RawMessage `json:"data,omitempty"`
+	// Error is the error returned by the client, if any.
+	Error string `json:"error,omitempty"`
+}
+
+// String returns a string version of the structure.
+func (r *ConsensusReport) String() string {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
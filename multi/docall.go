@@ -0,0 +1,132 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	client "github.com/attestantio/go-starknet-client"
+)
+
+// errNotProvider is returned when an underlying client does not provide the function called.
+var errNotProvider = errors.New("client does not provide function")
+
+// doCall carries out a call against the active clients in turn, moving on to
+// the next client if the call fails for a reason that another client may not
+// share.  Deterministic failures, such as errors returned by the node for the
+// request itself, are returned immediately.
+func doCall[T any](ctx context.Context,
+	s *Service,
+	method string,
+	call func(ctx context.Context, c client.Service) (T, error),
+) (
+	T,
+	error,
+) {
+	return doCallWithFailover(ctx, s, method, shouldFailover, call)
+}
+
+// doCallWithFailover carries out a call against the active clients in turn,
+// moving on to the next client if failover returns true for the error.
+func doCallWithFailover[T any](ctx context.Context,
+	s *Service,
+	method string,
+	failover func(ctx context.Context, err error) bool,
+	call func(ctx context.Context, c client.Service) (T, error),
+) (
+	T,
+	error,
+) {
+	var (
+		res T
+		err error
+	)
+
+	clients := s.callClients()
+	if len(clients) == 0 {
+		return res, client.ErrNotActive
+	}
+
+	for _, index := range clients {
+		c := s.clients[index]
+		res, err = call(ctx, c)
+		if err == nil {
+			return res, nil
+		}
+
+		if !failover(ctx, err) {
+			return res, err
+		}
+
+		s.log.Debug().Str("client", c.Address()).Str("method", method).Err(err).Msg("Call failed; failing over")
+		s.clientFailed(index, method, err)
+	}
+
+	return res, errors.Join(fmt.Errorf("%s failed on all clients", method), err)
+}
+
+// clientFailed handles a call to a client that failed for a reason that
+// another client may not share.
+func (s *Service) clientFailed(index int, method string, err error) {
+	monitorFailover(s.clients[index].Address(), method)
+
+	if isUnavailable(err) {
+		s.setActive(index, false)
+	}
+}
+
+// shouldFailover returns true if the error may not occur on another client.
+// Clients that are unavailable, not synced or do not provide the function are
+// failed over; all other errors are returned to the caller.
+func shouldFailover(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		// Our context is done; no point trying another client.
+		return false
+	}
+
+	return isUnavailable(err) ||
+		errors.Is(err, errNotProvider) ||
+		errors.Is(err, client.ErrNotSynced)
+}
+
+// shouldFailoverSubmission returns true if a submission failed before it
+// could have reached the node, and so can safely be sent to another client.
+// Submissions that fail or time out after being sent may have been accepted,
+// so are returned to the caller rather than risking a duplicate.
+func shouldFailoverSubmission(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		// Our context is done; no point trying another client.
+		return false
+	}
+
+	if errors.Is(err, client.ErrOutcomeUnknown) {
+		return false
+	}
+
+	return errors.Is(err, client.ErrNotActive) ||
+		errors.Is(err, client.ErrUnavailable) ||
+		errors.Is(err, errNotProvider) ||
+		errors.Is(err, client.ErrNotSynced)
+}
+
+// isUnavailable returns true if the error shows that the client could not
+// serve the request, due to a transport failure or timeout.
+func isUnavailable(err error) bool {
+	return errors.Is(err, client.ErrNotActive) ||
+		errors.Is(err, client.ErrUnavailable) ||
+		errors.Is(err, client.ErrTimeout) ||
+		errors.Is(err, context.DeadlineExceeded)
+}
@@ -0,0 +1,105 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/attestantio/go-starknet-client/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	clientsMetric   *prometheus.GaugeVec
+	failoversMetric *prometheus.CounterVec
+	consensusMetric *prometheus.CounterVec
+)
+
+func registerMetrics(ctx context.Context, monitor metrics.Service) error {
+	if clientsMetric != nil {
+		// Already registered.
+		return nil
+	}
+
+	if monitor == nil {
+		// No monitor.
+		return nil
+	}
+
+	if monitor.Presenter() == "prometheus" {
+		return registerPrometheusMetrics(ctx)
+	}
+
+	return nil
+}
+
+func registerPrometheusMetrics(_ context.Context) error {
+	clientsMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "starknetclient",
+		Subsystem: "multi",
+		Name:      "clients",
+		Help:      "The number of underlying clients by state (active/inactive)",
+	}, []string{"state"})
+	if err := prometheus.Register(clientsMetric); err != nil {
+		return errors.Join(errors.New("failed to register clients"), err)
+	}
+
+	failoversMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "starknetclient",
+		Subsystem: "multi",
+		Name:      "failovers_total",
+		Help:      "The number of times a call has failed over from a client",
+	}, []string{"server", "method"})
+	if err := prometheus.Register(failoversMetric); err != nil {
+		return errors.Join(errors.New("failed to register failovers"), err)
+	}
+
+	consensusMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "starknetclient",
+		Subsystem: "multi",
+		Name:      "consensus_reads_total",
+		Help:      "The number of consensus reads by result (agreed/disagreed/failed)",
+	}, []string{"method", "result"})
+	if err := prometheus.Register(consensusMetric); err != nil {
+		return errors.Join(errors.New("failed to register consensus reads"), err)
+	}
+
+	return nil
+}
+
+func monitorClients(active int, inactive int) {
+	if clientsMetric == nil {
+		return
+	}
+
+	clientsMetric.WithLabelValues("active").Set(float64(active))
+	clientsMetric.WithLabelValues("inactive").Set(float64(inactive))
+}
+
+func monitorFailover(server string, method string) {
+	if failoversMetric == nil {
+		return
+	}
+
+	failoversMetric.WithLabelValues(server, method).Inc()
+}
+
+func monitorConsensus(method string, result string) {
+	if consensusMetric == nil {
+		return
+	}
+
+	consensusMetric.WithLabelValues(method, result).Inc()
+}
@@ -0,0 +1,47 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	client "github.com/attestantio/go-starknet-client"
+	"github.com/attestantio/go-starknet-client/api"
+)
+
+// Nonce returns the nonce of the given contract at the given block.
+//
+// If Nonce is a consensus method the result is only returned once a quorum
+// of clients agree on it.
+func (s *Service) Nonce(ctx context.Context,
+	opts *api.NonceOpts,
+) (
+	*api.Response[uint32],
+	error,
+) {
+	call := func(ctx context.Context, c client.Service) (*api.Response[uint32], error) {
+		provider, isProvider := c.(client.NonceProvider)
+		if !isProvider {
+			return nil, errNotProvider
+		}
+
+		return provider.Nonce(ctx, opts)
+	}
+
+	if s.consensusMethods["Nonce"] {
+		return doConsensusCall(ctx, s, "Nonce", call)
+	}
+
+	return doCall(ctx, s, "Nonce", call)
+}
@@ -0,0 +1,158 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	client "github.com/attestantio/go-starknet-client"
+	"github.com/attestantio/go-starknet-client/metrics"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel         zerolog.Level
+	monitor          metrics.Service
+	clients          []client.Service
+	recoveryInterval time.Duration
+	quorum           int
+	consensusMethods []string
+	consensusClients int
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(p *parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithMonitor sets the monitor for the service.
+func WithMonitor(monitor metrics.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.monitor = monitor
+	})
+}
+
+// WithClients sets the underlying clients, in order of preference.
+func WithClients(clients []client.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.clients = clients
+	})
+}
+
+// WithRecoveryInterval sets the interval at which failed clients are checked for recovery.
+func WithRecoveryInterval(interval time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.recoveryInterval = interval
+	})
+}
+
+// WithQuorum sets the number of clients that must agree on the results of
+// consensus reads.
+func WithQuorum(quorum int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.quorum = quorum
+	})
+}
+
+// WithConsensusMethods sets the methods whose results require a quorum of
+// clients to agree.  Supported methods are Block, Call, Nonce and Events.
+func WithConsensusMethods(methods ...string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.consensusMethods = methods
+	})
+}
+
+// WithConsensusClients sets the number of clients to which consensus reads
+// are sent.  If not supplied consensus reads are sent to all active clients.
+func WithConsensusClients(clients int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.consensusClients = clients
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel:         zerolog.GlobalLevel(),
+		recoveryInterval: time.Minute,
+		quorum:           1,
+	}
+
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if len(parameters.clients) == 0 {
+		return nil, errors.New("no clients specified")
+	}
+
+	for _, c := range parameters.clients {
+		if c == nil {
+			return nil, errors.New("nil client specified")
+		}
+	}
+
+	if parameters.recoveryInterval <= 0 {
+		return nil, errors.New("no recovery interval specified")
+	}
+
+	if parameters.quorum < 1 {
+		return nil, errors.New("no quorum specified")
+	}
+
+	if parameters.quorum > len(parameters.clients) {
+		return nil, errors.New("quorum larger than number of clients")
+	}
+
+	for _, method := range parameters.consensusMethods {
+		if !supportedConsensusMethods[method] {
+			return nil, fmt.Errorf("unsupported consensus method %s", method)
+		}
+	}
+
+	if parameters.quorum > 1 && len(parameters.consensusMethods) == 0 {
+		return nil, errors.New("no consensus methods specified")
+	}
+
+	if parameters.consensusClients < 0 {
+		return nil, errors.New("invalid number of consensus clients")
+	}
+
+	if parameters.consensusClients != 0 && parameters.consensusClients < parameters.quorum {
+		return nil, errors.New("consensus clients fewer than quorum")
+	}
+
+	if parameters.consensusClients > len(parameters.clients) {
+		return nil, errors.New("consensus clients larger than number of clients")
+	}
+
+	return &parameters, nil
+}
@@ -0,0 +1,253 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package multi provides a client that delegates to multiple underlying
+// clients, failing over between them when nodes are unavailable.
+package multi
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+
+	client "github.com/attestantio/go-starknet-client"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// connectionStateProvider is implemented by clients that track the state of their connection.
+type connectionStateProvider interface {
+	// CheckConnectionState checks the connection state for the client.
+	CheckConnectionState(ctx context.Context)
+	// IsActive returns true if the client is active.
+	IsActive() bool
+	// IsSynced returns true if the client is synced.
+	IsSynced() bool
+}
+
+// Service is a client that delegates to multiple underlying clients.
+type Service struct {
+	log              zerolog.Logger
+	recoveryInterval time.Duration
+	quorum           int
+	// consensusMethods are the methods whose results require consensus.
+	consensusMethods map[string]bool
+	// consensusClients is the number of clients to which consensus reads
+	// are sent, or 0 for all active clients.
+	consensusClients int
+	// clients are the underlying clients, in order of preference.
+	clients []client.Service
+	// activeMu protects active.
+	activeMu sync.RWMutex
+	// active holds the active state of each client.
+	active []bool
+}
+
+// New creates a new multi-node client service.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "client").Str("impl", "multi").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	if parameters.monitor != nil {
+		if err := registerMetrics(ctx, parameters.monitor); err != nil {
+			return nil, errors.Join(errors.New("failed to register metrics"), err)
+		}
+	}
+
+	s := &Service{
+		log:              log,
+		recoveryInterval: parameters.recoveryInterval,
+		quorum:           parameters.quorum,
+		consensusMethods: make(map[string]bool, len(parameters.consensusMethods)),
+		consensusClients: parameters.consensusClients,
+		clients:          slices.Clone(parameters.clients),
+		active:           make([]bool, len(parameters.clients)),
+	}
+
+	for _, method := range parameters.consensusMethods {
+		s.consensusMethods[method] = true
+	}
+
+	for i, c := range s.clients {
+		s.active[i] = isActive(c)
+		if !s.active[i] {
+			log.Debug().Str("client", c.Address()).Msg("Client not active at start")
+		}
+	}
+	s.monitorClients()
+
+	if !s.IsActive() {
+		return nil, client.ErrNotActive
+	}
+
+	go s.recoverClients(ctx)
+
+	return s, nil
+}
+
+// Name provides the name of the service.
+func (*Service) Name() string {
+	return "multi"
+}
+
+// Address provides the address of the preferred active client.
+func (s *Service) Address() string {
+	clients := s.activeClients()
+	if len(clients) == 0 {
+		return "none"
+	}
+
+	return clients[0].Address()
+}
+
+// IsActive returns true if any underlying client is active.
+func (s *Service) IsActive() bool {
+	return len(s.activeClients()) > 0
+}
+
+// IsSynced returns true if any underlying client is active and synced.
+func (s *Service) IsSynced() bool {
+	return slices.ContainsFunc(s.activeClients(), isSynced)
+}
+
+// activeClients returns the active clients in order of preference.
+func (s *Service) activeClients() []client.Service {
+	s.activeMu.RLock()
+	defer s.activeMu.RUnlock()
+
+	res := make([]client.Service, 0, len(s.clients))
+	for i, c := range s.clients {
+		if s.active[i] {
+			res = append(res, c)
+		}
+	}
+
+	return res
+}
+
+// callClients returns the indices of the active clients to which calls are
+// routed, with synced clients ahead of those that are not synced.
+func (s *Service) callClients() []int {
+	s.activeMu.RLock()
+	indices := make([]int, 0, len(s.clients))
+	for i := range s.clients {
+		if s.active[i] {
+			indices = append(indices, i)
+		}
+	}
+	s.activeMu.RUnlock()
+
+	res := make([]int, 0, len(indices))
+	for _, i := range indices {
+		if isSynced(s.clients[i]) {
+			res = append(res, i)
+		}
+	}
+	for _, i := range indices {
+		if !isSynced(s.clients[i]) {
+			res = append(res, i)
+		}
+	}
+
+	return res
+}
+
+// setActive sets the active state of the client with the given index.
+func (s *Service) setActive(index int, active bool) {
+	s.activeMu.Lock()
+	changed := s.active[index] != active
+	s.active[index] = active
+	s.activeMu.Unlock()
+
+	if !changed {
+		return
+	}
+
+	if active {
+		s.log.Debug().Str("client", s.clients[index].Address()).Msg("Client reactivated")
+	} else {
+		s.log.Debug().Str("client", s.clients[index].Address()).Msg("Client deactivated")
+	}
+	s.monitorClients()
+}
+
+// recoverClients periodically checks inactive clients, reactivating them if
+// they have recovered.
+func (s *Service) recoverClients(ctx context.Context) {
+	ticker := time.NewTicker(s.recoveryInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.recoverInactiveClients(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// recoverInactiveClients checks inactive clients, reactivating those that have recovered.
+func (s *Service) recoverInactiveClients(ctx context.Context) {
+	s.activeMu.RLock()
+	inactive := make([]int, 0)
+	for i := range s.clients {
+		if !s.active[i] {
+			inactive = append(inactive, i)
+		}
+	}
+	s.activeMu.RUnlock()
+
+	for _, i := range inactive {
+		c := s.clients[i]
+		if provider, isProvider := c.(connectionStateProvider); isProvider {
+			provider.CheckConnectionState(ctx)
+		}
+
+		if isActive(c) {
+			s.setActive(i, true)
+		}
+	}
+}
+
+// monitorClients updates the client metrics.
+func (s *Service) monitorClients() {
+	active := len(s.activeClients())
+	monitorClients(active, len(s.clients)-active)
+}
+
+// isActive returns true if the client is active.  Clients that do not track
+// their connection state are assumed to be active.
+func isActive(c client.Service) bool {
+	provider, isProvider := c.(connectionStateProvider)
+
+	return !isProvider || provider.IsActive()
+}
+
+// isSynced returns true if the client is synced.  Clients that do not track
+// their connection state are assumed to be synced.
+func isSynced(c client.Service) bool {
+	provider, isProvider := c.(connectionStateProvider)
+
+	return !isProvider || provider.IsSynced()
+}
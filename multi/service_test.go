@@ -0,0 +1,540 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	client "github.com/attestantio/go-starknet-client"
+	"github.com/attestantio/go-starknet-client/api"
+	"github.com/attestantio/go-starknet-client/mock"
+	"github.com/attestantio/go-starknet-client/multi"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"github.com/ybbus/jsonrpc/v2"
+)
+
+// stubClient is a client with controllable connection state and errors.
+type stubClient struct {
+	*mock.Service
+	address string
+	active  atomic.Bool
+	synced  atomic.Bool
+	err     error
+	nonce   uint32
+	delay   time.Duration
+	calls   atomic.Int32
+}
+
+func newStubClient(address string, active bool, synced bool, err error) *stubClient {
+	c := &stubClient{
+		Service: &mock.Service{},
+		address: address,
+		err:     err,
+	}
+	c.active.Store(active)
+	c.synced.Store(synced)
+
+	return c
+}
+
+func (c *stubClient) Address() string { return c.address }
+
+func (*stubClient) CheckConnectionState(_ context.Context) {}
+
+func (c *stubClient) IsActive() bool { return c.active.Load() }
+
+func (c *stubClient) IsSynced() bool { return c.synced.Load() }
+
+func (c *stubClient) BlockNumber(_ context.Context,
+	_ *api.BlockNumberOpts,
+) (
+	*api.Response[uint32],
+	error,
+) {
+	c.calls.Add(1)
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	return &api.Response[uint32]{
+		Data:     uint32(len(c.address)),
+		Metadata: map[string]any{},
+	}, nil
+}
+
+func (c *stubClient) Nonce(_ context.Context,
+	_ *api.NonceOpts,
+) (
+	*api.Response[uint32],
+	error,
+) {
+	c.calls.Add(1)
+	time.Sleep(c.delay)
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	return &api.Response[uint32]{
+		Data:     c.nonce,
+		Metadata: map[string]any{},
+	}, nil
+}
+
+func (c *stubClient) SubmitTransaction(_ context.Context,
+	_ *api.SubmitTransactionOpts,
+) (
+	*api.Response[*api.SubmitTransactionResponse],
+	error,
+) {
+	c.calls.Add(1)
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	return &api.Response[*api.SubmitTransactionResponse]{
+		Data:     &api.SubmitTransactionResponse{},
+		Metadata: map[string]any{},
+	}, nil
+}
+
+// errNetwork is a transport failure, as returned by an unreachable client.
+var errNetwork = errors.Join(client.ErrUnavailable, errors.New("connection refused"))
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		parameters []multi.Parameter
+		err        string
+	}{
+		{
+			name: "Empty",
+			parameters: []multi.Parameter{
+				multi.WithLogLevel(zerolog.Disabled),
+			},
+			err: "no clients specified",
+		},
+		{
+			name: "ClientNil",
+			parameters: []multi.Parameter{
+				multi.WithLogLevel(zerolog.Disabled),
+				multi.WithClients([]client.Service{nil}),
+			},
+			err: "nil client specified",
+		},
+		{
+			name: "RecoveryIntervalZero",
+			parameters: []multi.Parameter{
+				multi.WithLogLevel(zerolog.Disabled),
+				multi.WithClients([]client.Service{newStubClient("1", true, true, nil)}),
+				multi.WithRecoveryInterval(0),
+			},
+			err: "no recovery interval specified",
+		},
+		{
+			name: "QuorumZero",
+			parameters: []multi.Parameter{
+				multi.WithLogLevel(zerolog.Disabled),
+				multi.WithClients([]client.Service{newStubClient("1", true, true, nil)}),
+				multi.WithQuorum(0),
+			},
+			err: "no quorum specified",
+		},
+		{
+			name: "QuorumTooLarge",
+			parameters: []multi.Parameter{
+				multi.WithLogLevel(zerolog.Disabled),
+				multi.WithClients([]client.Service{newStubClient("1", true, true, nil)}),
+				multi.WithQuorum(2),
+			},
+			err: "quorum larger than number of clients",
+		},
+		{
+			name: "ConsensusMethodUnsupported",
+			parameters: []multi.Parameter{
+				multi.WithLogLevel(zerolog.Disabled),
+				multi.WithClients([]client.Service{newStubClient("1", true, true, nil)}),
+				multi.WithConsensusMethods("SubmitTransaction"),
+			},
+			err: "unsupported consensus method SubmitTransaction",
+		},
+		{
+			name: "ConsensusMethodsMissing",
+			parameters: []multi.Parameter{
+				multi.WithLogLevel(zerolog.Disabled),
+				multi.WithClients([]client.Service{newStubClient("1", true, true, nil), newStubClient("2", true, true, nil)}),
+				multi.WithQuorum(2),
+			},
+			err: "no consensus methods specified",
+		},
+		{
+			name: "ConsensusClientsNegative",
+			parameters: []multi.Parameter{
+				multi.WithLogLevel(zerolog.Disabled),
+				multi.WithClients([]client.Service{newStubClient("1", true, true, nil)}),
+				multi.WithConsensusClients(-1),
+			},
+			err: "invalid number of consensus clients",
+		},
+		{
+			name: "ConsensusClientsFewerThanQuorum",
+			parameters: []multi.Parameter{
+				multi.WithLogLevel(zerolog.Disabled),
+				multi.WithClients([]client.Service{newStubClient("1", true, true, nil), newStubClient("2", true, true, nil)}),
+				multi.WithQuorum(2),
+				multi.WithConsensusMethods("Nonce"),
+				multi.WithConsensusClients(1),
+			},
+			err: "consensus clients fewer than quorum",
+		},
+		{
+			name: "ConsensusClientsTooLarge",
+			parameters: []multi.Parameter{
+				multi.WithLogLevel(zerolog.Disabled),
+				multi.WithClients([]client.Service{newStubClient("1", true, true, nil)}),
+				multi.WithConsensusClients(2),
+			},
+			err: "consensus clients larger than number of clients",
+		},
+		{
+			name: "NoneActive",
+			parameters: []multi.Parameter{
+				multi.WithLogLevel(zerolog.Disabled),
+				multi.WithClients([]client.Service{newStubClient("1", false, false, nil)}),
+			},
+			err: "client is not active",
+		},
+		{
+			name: "Good",
+			parameters: []multi.Parameter{
+				multi.WithLogLevel(zerolog.Disabled),
+				multi.WithClients([]client.Service{
+					newStubClient("1", false, false, nil),
+					newStubClient("2", true, true, nil),
+				}),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, err := multi.New(ctx, test.parameters...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, "multi", s.Name())
+				require.Equal(t, "2", s.Address())
+				require.True(t, s.IsActive())
+				require.True(t, s.IsSynced())
+			}
+		})
+	}
+}
+
+func TestBlockNumber(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		clients  []*stubClient
+		expected uint32
+		calls    []int32
+		err      string
+	}{
+		{
+			name: "First",
+			clients: []*stubClient{
+				newStubClient("1", true, true, nil),
+				newStubClient("22", true, true, nil),
+			},
+			expected: 1,
+			calls:    []int32{1, 0},
+		},
+		{
+			name: "PreferSynced",
+			clients: []*stubClient{
+				newStubClient("1", true, false, nil),
+				newStubClient("22", true, true, nil),
+			},
+			expected: 2,
+			calls:    []int32{0, 1},
+		},
+		{
+			name: "SkipInactive",
+			clients: []*stubClient{
+				newStubClient("1", false, false, nil),
+				newStubClient("22", true, true, nil),
+			},
+			expected: 2,
+			calls:    []int32{0, 1},
+		},
+		{
+			name: "Failover",
+			clients: []*stubClient{
+				newStubClient("1", true, true, errNetwork),
+				newStubClient("22", true, true, nil),
+			},
+			expected: 2,
+			calls:    []int32{1, 1},
+		},
+		{
+			name: "RPCError",
+			clients: []*stubClient{
+				newStubClient("1", true, true, &jsonrpc.RPCError{Code: 20, Message: "bad request"}),
+				newStubClient("22", true, true, nil),
+			},
+			calls: []int32{1, 0},
+			err:   "20:bad request",
+		},
+		{
+			name: "InvalidOptions",
+			clients: []*stubClient{
+				newStubClient("1", true, true, client.ErrInvalidOptions),
+				newStubClient("22", true, true, nil),
+			},
+			calls: []int32{1, 0},
+			err:   "invalid options",
+		},
+		{
+			name: "AllFailed",
+			clients: []*stubClient{
+				newStubClient("1", true, true, errNetwork),
+				newStubClient("22", true, true, errNetwork),
+			},
+			calls: []int32{1, 1},
+			err:   "BlockNumber failed on all clients\nclient is unavailable\nconnection refused",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			clients := make([]client.Service, 0, len(test.clients))
+			for _, c := range test.clients {
+				clients = append(clients, c)
+			}
+			s, err := multi.New(ctx,
+				multi.WithLogLevel(zerolog.Disabled),
+				multi.WithClients(clients),
+			)
+			require.NoError(t, err)
+
+			res, err := s.BlockNumber(ctx, &api.BlockNumberOpts{})
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res.Data)
+			}
+			for i, c := range test.clients {
+				require.Equal(t, test.calls[i], c.calls.Load(), c.address)
+			}
+		})
+	}
+}
+
+func TestFailoverState(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		err     error
+		calls   []int32
+		address string
+		callErr string
+	}{
+		{
+			name:    "Unavailable",
+			err:     errNetwork,
+			calls:   []int32{1, 1},
+			address: "22",
+		},
+		{
+			name:    "Timeout",
+			err:     errors.Join(client.ErrTimeout, errors.New("context deadline exceeded")),
+			calls:   []int32{1, 1},
+			address: "22",
+		},
+		{
+			name:    "NotActive",
+			err:     client.ErrNotActive,
+			calls:   []int32{1, 1},
+			address: "22",
+		},
+		{
+			name:    "InconsistentResult",
+			err:     client.ErrInconsistentResult,
+			calls:   []int32{1, 0},
+			address: "1",
+			callErr: "inconsistent result",
+		},
+		{
+			name:    "DecodeError",
+			err:     errors.New("json: cannot unmarshal string into Go value of type uint32"),
+			calls:   []int32{1, 0},
+			address: "1",
+			callErr: "json: cannot unmarshal string into Go value of type uint32",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			failing := newStubClient("1", true, true, test.err)
+			other := newStubClient("22", true, true, nil)
+			s, err := multi.New(ctx,
+				multi.WithLogLevel(zerolog.Disabled),
+				multi.WithClients([]client.Service{failing, other}),
+			)
+			require.NoError(t, err)
+
+			_, err = s.BlockNumber(ctx, &api.BlockNumberOpts{})
+			if test.callErr != "" {
+				require.EqualError(t, err, test.callErr)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, test.calls[0], failing.calls.Load())
+			require.Equal(t, test.calls[1], other.calls.Load())
+			require.Equal(t, test.address, s.Address())
+		})
+	}
+}
+
+// uncomparableClient is a client whose dynamic type cannot be compared.
+type uncomparableClient struct {
+	*stubClient
+	tags []string
+}
+
+func TestFailoverUncomparable(t *testing.T) {
+	ctx := context.Background()
+
+	failing := uncomparableClient{
+		stubClient: newStubClient("1", true, true, errNetwork),
+		tags:       []string{"failing"},
+	}
+	other := newStubClient("22", true, true, nil)
+	s, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients([]client.Service{failing, other}),
+	)
+	require.NoError(t, err)
+
+	res, err := s.BlockNumber(ctx, &api.BlockNumberOpts{})
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), res.Data)
+	require.Equal(t, "22", s.Address())
+}
+
+func TestSubmitTransactionRejected(t *testing.T) {
+	ctx := context.Background()
+
+	rejecting := newStubClient("1", true, true, &jsonrpc.RPCError{Code: 55, Message: "Account validation failed"})
+	other := newStubClient("2", true, true, nil)
+	s, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients([]client.Service{rejecting, other}),
+	)
+	require.NoError(t, err)
+
+	_, err = s.SubmitTransaction(ctx, &api.SubmitTransactionOpts{})
+	require.EqualError(t, err, "55:Account validation failed")
+	require.Equal(t, int32(0), other.calls.Load())
+}
+
+func TestSubmitTransactionFailover(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		err   error
+		calls []int32
+	}{
+		{
+			name:  "Unsent",
+			err:   errNetwork,
+			calls: []int32{1, 1},
+		},
+		{
+			name:  "NotActive",
+			err:   client.ErrNotActive,
+			calls: []int32{1, 1},
+		},
+		{
+			name:  "OutcomeUnknown",
+			err:   errors.Join(client.ErrOutcomeUnknown, errors.New("EOF")),
+			calls: []int32{1, 0},
+		},
+		{
+			name:  "Timeout",
+			err:   errors.Join(client.ErrTimeout, errors.New("context deadline exceeded")),
+			calls: []int32{1, 0},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			failing := newStubClient("1", true, true, test.err)
+			other := newStubClient("2", true, true, nil)
+			s, err := multi.New(ctx,
+				multi.WithLogLevel(zerolog.Disabled),
+				multi.WithClients([]client.Service{failing, other}),
+			)
+			require.NoError(t, err)
+
+			_, err = s.SubmitTransaction(ctx, &api.SubmitTransactionOpts{})
+			if test.calls[1] == 0 {
+				require.ErrorIs(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, test.calls[0], failing.calls.Load())
+			require.Equal(t, test.calls[1], other.calls.Load())
+		})
+	}
+}
+
+func TestRecovery(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	failing := newStubClient("1", true, true, errNetwork)
+	other := newStubClient("2", true, true, nil)
+	s, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients([]client.Service{failing, other}),
+		multi.WithRecoveryInterval(10*time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	// First call fails over, deactivating the failing client.
+	_, err = s.BlockNumber(ctx, &api.BlockNumberOpts{})
+	require.NoError(t, err)
+	require.Equal(t, "2", s.Address())
+
+	// Client reports itself as inactive, so remains unused.
+	failing.active.Store(false)
+	time.Sleep(50 * time.Millisecond)
+	require.Equal(t, "2", s.Address())
+
+	// Client recovers.
+	failing.active.Store(true)
+	require.Eventually(t, func() bool { return s.Address() == "1" }, time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,44 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	client "github.com/attestantio/go-starknet-client"
+	"github.com/attestantio/go-starknet-client/api"
+)
+
+// SubmitTransaction submits a transaction to the client.
+//
+// Submission is only retried on another client if the transaction cannot have
+// reached the first.  If the transaction may have reached a node but its
+// outcome is unknown, for example due to a dropped connection or timeout, an
+// error wrapping client.ErrOutcomeUnknown is returned and the caller should
+// check whether the transaction was accepted before resubmitting it.
+func (s *Service) SubmitTransaction(ctx context.Context,
+	opts *api.SubmitTransactionOpts,
+) (
+	*api.Response[*api.SubmitTransactionResponse],
+	error,
+) {
+	return doCallWithFailover(ctx, s, "SubmitTransaction", shouldFailoverSubmission, func(ctx context.Context, c client.Service) (*api.Response[*api.SubmitTransactionResponse], error) {
+		provider, isProvider := c.(client.TransactionSubmitter)
+		if !isProvider {
+			return nil, errNotProvider
+		}
+
+		return provider.SubmitTransaction(ctx, opts)
+	})
+}
@@ -0,0 +1,64 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package noncemanager
+
+import (
+	"context"
+	"errors"
+
+	"github.com/attestantio/go-starknet-client/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var resyncsMetric *prometheus.CounterVec
+
+func registerMetrics(ctx context.Context, monitor metrics.Service) error {
+	if resyncsMetric != nil {
+		// Already registered.
+		return nil
+	}
+
+	if monitor == nil {
+		// No monitor.
+		return nil
+	}
+
+	if monitor.Presenter() == "prometheus" {
+		return registerPrometheusMetrics(ctx)
+	}
+
+	return nil
+}
+
+func registerPrometheusMetrics(_ context.Context) error {
+	resyncsMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "starknetclient",
+		Subsystem: "noncemanager",
+		Name:      "resyncs_total",
+		Help:      "The number of times a nonce has been resynchronised from the chain",
+	}, []string{"reason"})
+	if err := prometheus.Register(resyncsMetric); err != nil {
+		return errors.Join(errors.New("failed to register resyncs"), err)
+	}
+
+	return nil
+}
+
+func monitorResync(reason string) {
+	if resyncsMetric == nil {
+		return
+	}
+
+	resyncsMetric.WithLabelValues(reason).Inc()
+}
@@ -0,0 +1,185 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package noncemanager hands out nonces for accounts that submit multiple
+// transactions concurrently, avoiding the collisions that occur when each
+// submission obtains its nonce from the chain.
+package noncemanager
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	client "github.com/attestantio/go-starknet-client"
+	"github.com/attestantio/go-starknet-client/api"
+	"github.com/attestantio/go-starknet-client/types"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Reasons for resynchronising a nonce, used in metrics.
+const (
+	resyncInitial  = "initial"
+	resyncFailure  = "failure"
+	resyncExplicit = "explicit"
+)
+
+// Service is a nonce manager.
+type Service struct {
+	log           zerolog.Logger
+	nonceProvider client.NonceProvider
+	accountsMu    sync.Mutex
+	accounts      map[types.Address]*accountNonce
+	resyncsMu     sync.Mutex
+	resyncs       uint64
+}
+
+// accountNonce is the nonce state for a single account.
+type accountNonce struct {
+	mu sync.Mutex
+	// next is the next nonce to hand out.
+	next types.Number
+	// synced is true if next is valid; if false it must be obtained from the chain.
+	synced bool
+	// reason is the reason for the next resync.
+	reason string
+}
+
+// New creates a new nonce manager.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "noncemanager").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	if parameters.monitor != nil {
+		if err := registerMetrics(ctx, parameters.monitor); err != nil {
+			return nil, errors.Join(errors.New("failed to register metrics"), err)
+		}
+	}
+
+	return &Service{
+		log:           log,
+		nonceProvider: parameters.nonceProvider,
+		accounts:      make(map[types.Address]*accountNonce),
+	}, nil
+}
+
+// Next returns the next nonce for the account.  Each call returns a value one
+// higher than the previous call for the same account, until the account is
+// resynchronised.
+func (s *Service) Next(ctx context.Context, address types.Address) (types.Number, error) {
+	account := s.account(address)
+
+	account.mu.Lock()
+	defer account.mu.Unlock()
+
+	if !account.synced {
+		if err := s.resync(ctx, address, account); err != nil {
+			return 0, err
+		}
+	}
+
+	nonce := account.next
+	account.next++
+
+	s.log.Trace().Stringer("address", address).Uint64("nonce", uint64(nonce)).Msg("Handed out nonce")
+
+	return nonce, nil
+}
+
+// Failed notes that a transaction using the given nonce was not accepted,
+// leaving a gap in the nonces handed out.  The account will be resynchronised
+// from the chain the next time a nonce is requested.
+func (s *Service) Failed(address types.Address, nonce types.Number) {
+	account := s.account(address)
+
+	account.mu.Lock()
+	defer account.mu.Unlock()
+
+	if !account.synced || nonce >= account.next {
+		// Either already resynchronising, or the nonce was not handed out by us.
+		return
+	}
+
+	s.log.Trace().Stringer("address", address).Uint64("nonce", uint64(nonce)).Msg("Nonce failed")
+	account.synced = false
+	account.reason = resyncFailure
+}
+
+// Resync resynchronises the nonce for the account from the chain immediately.
+func (s *Service) Resync(ctx context.Context, address types.Address) error {
+	account := s.account(address)
+
+	account.mu.Lock()
+	defer account.mu.Unlock()
+
+	account.reason = resyncExplicit
+
+	return s.resync(ctx, address, account)
+}
+
+// Resyncs returns the number of times that nonces have been resynchronised from the chain.
+func (s *Service) Resyncs() uint64 {
+	s.resyncsMu.Lock()
+	defer s.resyncsMu.Unlock()
+
+	return s.resyncs
+}
+
+// account returns the nonce state for the account, creating it if required.
+func (s *Service) account(address types.Address) *accountNonce {
+	s.accountsMu.Lock()
+	defer s.accountsMu.Unlock()
+
+	account, exists := s.accounts[address]
+	if !exists {
+		account = &accountNonce{
+			reason: resyncInitial,
+		}
+		s.accounts[address] = account
+	}
+
+	return account
+}
+
+// resync obtains the nonce for the account from the chain.
+// This assumes that the account lock is held.
+func (s *Service) resync(ctx context.Context, address types.Address, account *accountNonce) error {
+	response, err := s.nonceProvider.Nonce(ctx, &api.NonceOpts{
+		Block:    "pending",
+		Contract: address,
+	})
+	if err != nil {
+		return errors.Join(errors.New("failed to obtain nonce"), err)
+	}
+
+	s.log.Trace().Stringer("address", address).Uint32("nonce", response.Data).Str("reason", account.reason).Msg("Resynchronised nonce")
+
+	account.next = types.Number(response.Data)
+	account.synced = true
+
+	s.resyncsMu.Lock()
+	s.resyncs++
+	s.resyncsMu.Unlock()
+	monitorResync(account.reason)
+
+	return nil
+}
@@ -0,0 +1,191 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package noncemanager_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/attestantio/go-starknet-client/api"
+	"github.com/attestantio/go-starknet-client/noncemanager"
+	"github.com/attestantio/go-starknet-client/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// nonceProvider is a nonce provider with a settable nonce.
+type nonceProvider struct {
+	mu    sync.Mutex
+	nonce uint32
+	calls int
+	err   error
+}
+
+func (n *nonceProvider) Nonce(_ context.Context,
+	_ *api.NonceOpts,
+) (
+	*api.Response[uint32],
+	error,
+) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.calls++
+	if n.err != nil {
+		return nil, n.err
+	}
+
+	return &api.Response[uint32]{
+		Data: n.nonce,
+	}, nil
+}
+
+func TestNew(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := noncemanager.New(ctx, noncemanager.WithLogLevel(zerolog.Disabled))
+	require.EqualError(t, err, "no nonce provider specified")
+
+	_, err = noncemanager.New(ctx,
+		noncemanager.WithLogLevel(zerolog.Disabled),
+		noncemanager.WithNonceProvider(&nonceProvider{}),
+	)
+	require.NoError(t, err)
+}
+
+func TestNext(t *testing.T) {
+	ctx := context.Background()
+
+	provider := &nonceProvider{nonce: 5}
+	s, err := noncemanager.New(ctx,
+		noncemanager.WithLogLevel(zerolog.Disabled),
+		noncemanager.WithNonceProvider(provider),
+	)
+	require.NoError(t, err)
+
+	address1 := *new(types.Address).MustParse("0x1")
+	address2 := *new(types.Address).MustParse("0x2")
+
+	for i := range 3 {
+		nonce, err := s.Next(ctx, address1)
+		require.NoError(t, err)
+		require.Equal(t, types.Number(5+i), nonce)
+	}
+
+	nonce, err := s.Next(ctx, address2)
+	require.NoError(t, err)
+	require.Equal(t, types.Number(5), nonce)
+	require.Equal(t, 2, provider.calls)
+	require.Equal(t, uint64(2), s.Resyncs())
+}
+
+func TestNextConcurrent(t *testing.T) {
+	ctx := context.Background()
+
+	provider := &nonceProvider{nonce: 10}
+	s, err := noncemanager.New(ctx,
+		noncemanager.WithLogLevel(zerolog.Disabled),
+		noncemanager.WithNonceProvider(provider),
+	)
+	require.NoError(t, err)
+
+	address := *new(types.Address).MustParse("0x1")
+
+	var wg sync.WaitGroup
+	nonces := make([]types.Number, 100)
+	for i := range nonces {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			nonce, err := s.Next(ctx, address)
+			require.NoError(t, err)
+			nonces[i] = nonce
+		}(i)
+	}
+	wg.Wait()
+
+	seen := make(map[types.Number]bool)
+	for _, nonce := range nonces {
+		require.False(t, seen[nonce])
+		seen[nonce] = true
+		require.GreaterOrEqual(t, nonce, types.Number(10))
+		require.Less(t, nonce, types.Number(110))
+	}
+	require.Equal(t, 1, provider.calls)
+}
+
+func TestFailed(t *testing.T) {
+	ctx := context.Background()
+
+	provider := &nonceProvider{nonce: 1}
+	s, err := noncemanager.New(ctx,
+		noncemanager.WithLogLevel(zerolog.Disabled),
+		noncemanager.WithNonceProvider(provider),
+	)
+	require.NoError(t, err)
+
+	address := *new(types.Address).MustParse("0x1")
+
+	for range 3 {
+		_, err := s.Next(ctx, address)
+		require.NoError(t, err)
+	}
+
+	// Failure of a nonce not yet handed out is ignored.
+	s.Failed(address, 10)
+	nonce, err := s.Next(ctx, address)
+	require.NoError(t, err)
+	require.Equal(t, types.Number(4), nonce)
+
+	// Failure of a handed out nonce resyncs from the chain.
+	provider.nonce = 2
+	s.Failed(address, 2)
+	nonce, err = s.Next(ctx, address)
+	require.NoError(t, err)
+	require.Equal(t, types.Number(2), nonce)
+	require.Equal(t, uint64(2), s.Resyncs())
+}
+
+func TestResync(t *testing.T) {
+	ctx := context.Background()
+
+	provider := &nonceProvider{nonce: 1}
+	s, err := noncemanager.New(ctx,
+		noncemanager.WithLogLevel(zerolog.Disabled),
+		noncemanager.WithNonceProvider(provider),
+	)
+	require.NoError(t, err)
+
+	address := *new(types.Address).MustParse("0x1")
+
+	nonce, err := s.Next(ctx, address)
+	require.NoError(t, err)
+	require.Equal(t, types.Number(1), nonce)
+
+	provider.nonce = 7
+	require.NoError(t, s.Resync(ctx, address))
+	nonce, err = s.Next(ctx, address)
+	require.NoError(t, err)
+	require.Equal(t, types.Number(7), nonce)
+
+	provider.err = errors.New("mock")
+	require.EqualError(t, s.Resync(ctx, address), "failed to obtain nonce\nmock")
+
+	// A failed resync leaves the existing nonce in place.
+	nonce, err = s.Next(ctx, address)
+	require.NoError(t, err)
+	require.Equal(t, types.Number(8), nonce)
+}
@@ -0,0 +1,287 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package outsideexecution provides construction of SNIP-9 outside executions,
+// which allow a third party to submit calls on behalf of an account.
+package outsideexecution
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/attestantio/go-starknet-client/crypto"
+	"github.com/attestantio/go-starknet-client/spec"
+	"github.com/attestantio/go-starknet-client/typeddata"
+	"github.com/attestantio/go-starknet-client/types"
+)
+
+// Version is the version of SNIP-9 outside execution.
+type Version uint8
+
+const (
+	// VersionUnknown is an unknown version.
+	VersionUnknown Version = iota
+	// Version1 is version 1 of outside execution, hashed with SNIP-12 revision 0.
+	Version1
+	// Version2 is version 2 of outside execution, hashed with SNIP-12 revision 1.
+	Version2
+)
+
+var versionStrings = [...]string{
+	"unknown",
+	"1",
+	"2",
+}
+
+// String returns the string representation of the version.
+func (v Version) String() string {
+	if int(v) >= len(versionStrings) {
+		return versionStrings[0]
+	}
+
+	return versionStrings[v]
+}
+
+// AnyCaller is the caller that allows any account to submit the outside execution.
+var AnyCaller = types.Address(types.MustFieldElementFromShortString("ANY_CALLER"))
+
+// domainName is the name of the SNIP-12 domain for outside executions.
+const domainName = "Account.execute_from_outside"
+
+// OutsideExecution is a set of calls to be executed by an account on
+// submission by a third party.
+type OutsideExecution struct {
+	// Version is the version of outside execution supported by the account.
+	Version Version
+	// Caller is the address allowed to submit the outside execution, or AnyCaller.
+	Caller types.Address
+	// Nonce is a unique value to prevent replay of the outside execution.
+	Nonce types.FieldElement
+	// ExecuteAfter is the timestamp after which the outside execution is valid.
+	ExecuteAfter uint64
+	// ExecuteBefore is the timestamp before which the outside execution is valid.
+	ExecuteBefore uint64
+	// Calls are the calls to execute.
+	Calls []*spec.FunctionCall
+}
+
+// EntryPoint returns the account entry point for the outside execution.
+func (o *OutsideExecution) EntryPoint() (string, error) {
+	switch o.Version {
+	case Version1:
+		return "execute_from_outside", nil
+	case Version2:
+		return "execute_from_outside_v2", nil
+	default:
+		return "", fmt.Errorf("unsupported outside execution version %v", o.Version)
+	}
+}
+
+// TypedData returns the SNIP-12 typed data for the outside execution on the given chain.
+func (o *OutsideExecution) TypedData(chainID types.Data) (*typeddata.TypedData, error) {
+	chainName, err := types.ChainIDName(chainID)
+	if err != nil {
+		return nil, errors.Join(errors.New("invalid chain ID"), err)
+	}
+
+	switch o.Version {
+	case Version1:
+		return o.typedDataV1(chainName), nil
+	case Version2:
+		return o.typedDataV2(chainName), nil
+	default:
+		return nil, fmt.Errorf("unsupported outside execution version %v", o.Version)
+	}
+}
+
+// MessageHash returns the hash to be signed by the account that will execute the calls.
+func (o *OutsideExecution) MessageHash(chainID types.Data, account types.Address) (types.FieldElement, error) {
+	typedData, err := o.TypedData(chainID)
+	if err != nil {
+		return types.FieldElement{}, err
+	}
+
+	return typedData.MessageHash(account)
+}
+
+// Serialize returns the Cairo serialization of the outside execution.
+func (o *OutsideExecution) Serialize() []types.FieldElement {
+	res := []types.FieldElement{
+		types.FieldElement(o.Caller),
+		o.Nonce,
+		types.FieldElementFromUint64(o.ExecuteAfter),
+		types.FieldElementFromUint64(o.ExecuteBefore),
+	}
+
+	return append(res, spec.SerializeFunctionCalls(o.Calls)...)
+}
+
+// FunctionCall returns the call to the account that executes the outside
+// execution with the account's signature.
+func (o *OutsideExecution) FunctionCall(account types.Address, signature types.Signature) (*spec.FunctionCall, error) {
+	entryPoint, err := o.EntryPoint()
+	if err != nil {
+		return nil, err
+	}
+
+	calldata := o.Serialize()
+	calldata = append(calldata, types.FieldElementFromUint64(uint64(len(signature))))
+	calldata = append(calldata, signature...)
+
+	return &spec.FunctionCall{
+		ContractAddress:    account,
+		EntryPointSelector: crypto.SelectorFromName(entryPoint),
+		Calldata:           calldata,
+	}, nil
+}
+
+// InvokeTransaction returns the unsigned transaction for the relayer to send
+// in order to execute the outside execution on the account.
+// Resource bounds are left empty, to be set from a fee estimate before the
+// relayer signs the transaction.
+func (o *OutsideExecution) InvokeTransaction(relayer types.Address,
+	nonce types.Number,
+	account types.Address,
+	signature types.Signature,
+) (
+	*spec.InvokeV3Transaction,
+	error,
+) {
+	call, err := o.FunctionCall(account, signature)
+	if err != nil {
+		return nil, err
+	}
+
+	return &spec.InvokeV3Transaction{
+		Type:                      spec.TransactionTypeInvoke,
+		SenderAddress:             relayer,
+		Calldata:                  spec.SerializeFunctionCalls([]*spec.FunctionCall{call}),
+		Version:                   spec.TransactionVersion3,
+		Signature:                 types.Signature{},
+		Nonce:                     nonce,
+		PaymasterData:             []types.FieldElement{},
+		AccountDeploymentData:     []types.FieldElement{},
+		NonceDataAvailabilityMode: spec.TxDAModeL1,
+		FeeDataAvailabilityMode:   spec.TxDAModeL1,
+	}, nil
+}
+
+// typedDataV1 returns the version 1 typed data for the outside execution.
+func (o *OutsideExecution) typedDataV1(chainName string) *typeddata.TypedData {
+	calls := make([]any, 0, len(o.Calls))
+	for _, call := range o.Calls {
+		calls = append(calls, map[string]any{
+			"to":           types.FieldElement(call.ContractAddress),
+			"selector":     call.EntryPointSelector,
+			"calldata_len": uint64(len(call.Calldata)),
+			"calldata":     fieldElementsToValues(call.Calldata),
+		})
+	}
+
+	return &typeddata.TypedData{
+		Types: map[string][]*typeddata.Parameter{
+			"StarkNetDomain": {
+				{Name: "name", Type: "felt"},
+				{Name: "version", Type: "felt"},
+				{Name: "chainId", Type: "felt"},
+			},
+			"OutsideExecution": {
+				{Name: "caller", Type: "felt"},
+				{Name: "nonce", Type: "felt"},
+				{Name: "execute_after", Type: "felt"},
+				{Name: "execute_before", Type: "felt"},
+				{Name: "calls_len", Type: "felt"},
+				{Name: "calls", Type: "OutsideCall*"},
+			},
+			"OutsideCall": {
+				{Name: "to", Type: "felt"},
+				{Name: "selector", Type: "felt"},
+				{Name: "calldata_len", Type: "felt"},
+				{Name: "calldata", Type: "felt*"},
+			},
+		},
+		PrimaryType: "OutsideExecution",
+		Domain: map[string]any{
+			"name":    domainName,
+			"version": "1",
+			"chainId": chainName,
+		},
+		Message: map[string]any{
+			"caller":         types.FieldElement(o.Caller),
+			"nonce":          o.Nonce,
+			"execute_after":  o.ExecuteAfter,
+			"execute_before": o.ExecuteBefore,
+			"calls_len":      uint64(len(o.Calls)),
+			"calls":          calls,
+		},
+	}
+}
+
+// typedDataV2 returns the version 2 typed data for the outside execution.
+func (o *OutsideExecution) typedDataV2(chainName string) *typeddata.TypedData {
+	calls := make([]any, 0, len(o.Calls))
+	for _, call := range o.Calls {
+		calls = append(calls, map[string]any{
+			"To":       types.FieldElement(call.ContractAddress),
+			"Selector": call.EntryPointSelector.String(),
+			"Calldata": fieldElementsToValues(call.Calldata),
+		})
+	}
+
+	return &typeddata.TypedData{
+		Types: map[string][]*typeddata.Parameter{
+			"StarknetDomain": {
+				{Name: "name", Type: "shortstring"},
+				{Name: "version", Type: "shortstring"},
+				{Name: "chainId", Type: "shortstring"},
+				{Name: "revision", Type: "shortstring"},
+			},
+			"OutsideExecution": {
+				{Name: "Caller", Type: "ContractAddress"},
+				{Name: "Nonce", Type: "felt"},
+				{Name: "Execute After", Type: "u128"},
+				{Name: "Execute Before", Type: "u128"},
+				{Name: "Calls", Type: "Call*"},
+			},
+			"Call": {
+				{Name: "To", Type: "ContractAddress"},
+				{Name: "Selector", Type: "selector"},
+				{Name: "Calldata", Type: "felt*"},
+			},
+		},
+		PrimaryType: "OutsideExecution",
+		Domain: map[string]any{
+			"name":     domainName,
+			"version":  "2",
+			"chainId":  chainName,
+			"revision": "1",
+		},
+		Message: map[string]any{
+			"Caller":         types.FieldElement(o.Caller),
+			"Nonce":          o.Nonce,
+			"Execute After":  o.ExecuteAfter,
+			"Execute Before": o.ExecuteBefore,
+			"Calls":          calls,
+		},
+	}
+}
+
+// fieldElementsToValues converts field elements to typed data values.
+func fieldElementsToValues(input []types.FieldElement) []any {
+	res := make([]any, len(input))
+	for i := range input {
+		res[i] = input[i]
+	}
+
+	return res
+}
@@ -0,0 +1,167 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package outsideexecution_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-starknet-client/crypto"
+	"github.com/attestantio/go-starknet-client/outsideexecution"
+	"github.com/attestantio/go-starknet-client/spec"
+	"github.com/attestantio/go-starknet-client/types"
+	"github.com/stretchr/testify/require"
+)
+
+func strToAddress(input string) types.Address {
+	return *new(types.Address).MustParse(input)
+}
+
+func strToFieldElement(input string) types.FieldElement {
+	return *new(types.FieldElement).MustParse(input)
+}
+
+// testOutsideExecution returns a sample outside execution.
+func testOutsideExecution(version outsideexecution.Version) *outsideexecution.OutsideExecution {
+	return &outsideexecution.OutsideExecution{
+		Version:       version,
+		Caller:        strToAddress("0x75a180e18e56da1b1cae181c92a288f586f5fe22c18df21cf97886f1e4b316c"),
+		Nonce:         strToFieldElement("0x89ac3804c1e246bb124ae7c586a17cd8"),
+		ExecuteAfter:  1,
+		ExecuteBefore: 0x68d2eea3,
+		Calls: []*spec.FunctionCall{
+			{
+				ContractAddress:    strToAddress("0x669e24364ce0ae7ec2864fb03eedbe60cfbc9d1c74438d10fa4b86552907d54"),
+				EntryPointSelector: strToFieldElement("0x2f0b3c5710379609eb5495f1ecd348cb28167711b73609fe565a72734550354"),
+				Calldata: []types.FieldElement{
+					strToFieldElement("0x2710"),
+					strToFieldElement("0x0"),
+				},
+			},
+		},
+	}
+}
+
+func TestTypeHash(t *testing.T) {
+	tests := []struct {
+		name     string
+		version  outsideexecution.Version
+		expected string
+		err      string
+	}{
+		{
+			name:    "Unknown",
+			version: outsideexecution.VersionUnknown,
+			err:     "unsupported outside execution version unknown",
+		},
+		{
+			name:     "Version1",
+			version:  outsideexecution.Version1,
+			expected: "0x11ff76fe3f640fa6f3d60bbd94a3b9d47141a2c96f87fdcfbeb2af1d03f7050",
+		},
+		{
+			name:     "Version2",
+			version:  outsideexecution.Version2,
+			expected: "0x312b56c05a7965066ddbda31c016d8d05afc305071c0ca3cdc2192c3c2f1f0f",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			typedData, err := testOutsideExecution(test.version).TypedData(types.Data(types.ChainIDSepolia))
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				res, err := typedData.TypeHash("OutsideExecution")
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res.String())
+			}
+		})
+	}
+}
+
+func TestMessageHash(t *testing.T) {
+	tests := []struct {
+		name     string
+		version  outsideexecution.Version
+		chainID  types.Data
+		account  types.Address
+		expected string
+		err      string
+	}{
+		{
+			name:    "ChainIDMissing",
+			version: outsideexecution.Version2,
+			account: strToAddress("0x5c74db20fa8f151bfd3a7a462cf2e8d4578a88aa4bd7a1746955201c48d8e5e"),
+			err:     "invalid chain ID\nchain ID missing",
+		},
+		{
+			name:     "Version2",
+			version:  outsideexecution.Version2,
+			chainID:  types.Data(types.ChainIDSepolia),
+			account:  strToAddress("0x5c74db20fa8f151bfd3a7a462cf2e8d4578a88aa4bd7a1746955201c48d8e5e"),
+			expected: "0x523f153f2a3ca228a2ea222fb5114437a9191bdade099667ef0c113f5444fa0",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := testOutsideExecution(test.version).MessageHash(test.chainID, test.account)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res.String())
+			}
+		})
+	}
+}
+
+func TestInvokeTransaction(t *testing.T) {
+	relayer := strToAddress("0x1")
+	account := strToAddress("0x5c74db20fa8f151bfd3a7a462cf2e8d4578a88aa4bd7a1746955201c48d8e5e")
+	signature := types.Signature{strToFieldElement("0xa"), strToFieldElement("0xb")}
+
+	tx, err := testOutsideExecution(outsideexecution.Version2).InvokeTransaction(relayer, 5, account, signature)
+	require.NoError(t, err)
+	require.Equal(t, relayer, tx.SenderAddress)
+	require.Equal(t, types.Number(5), tx.Nonce)
+	require.Equal(t, spec.TransactionVersion3, tx.Version)
+	require.Equal(t, []types.FieldElement{
+		// Number of calls.
+		strToFieldElement("0x1"),
+		// Call to the account.
+		types.FieldElement(account),
+		crypto.SelectorFromName("execute_from_outside_v2"),
+		strToFieldElement("0xd"),
+		// Outside execution.
+		strToFieldElement("0x75a180e18e56da1b1cae181c92a288f586f5fe22c18df21cf97886f1e4b316c"),
+		strToFieldElement("0x89ac3804c1e246bb124ae7c586a17cd8"),
+		strToFieldElement("0x1"),
+		strToFieldElement("0x68d2eea3"),
+		strToFieldElement("0x1"),
+		strToFieldElement("0x669e24364ce0ae7ec2864fb03eedbe60cfbc9d1c74438d10fa4b86552907d54"),
+		strToFieldElement("0x2f0b3c5710379609eb5495f1ecd348cb28167711b73609fe565a72734550354"),
+		strToFieldElement("0x2"),
+		strToFieldElement("0x2710"),
+		strToFieldElement("0x0"),
+		// Signature.
+		strToFieldElement("0x2"),
+		strToFieldElement("0xa"),
+		strToFieldElement("0xb"),
+	}, tx.Calldata)
+
+	_, err = testOutsideExecution(outsideexecution.VersionUnknown).InvokeTransaction(relayer, 5, account, signature)
+	require.EqualError(t, err, "unsupported outside execution version unknown")
+}
@@ -0,0 +1,100 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package outsideexecution
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	client "github.com/attestantio/go-starknet-client"
+	"github.com/attestantio/go-starknet-client/api"
+	"github.com/attestantio/go-starknet-client/types"
+)
+
+// SRC-5 interface IDs for the versions of outside execution.
+var (
+	interfaceIDVersion1 = *new(types.FieldElement).MustParse("0x68cfd18b92d1907b8ba3cc324900277f5a3622099431ea85dd8089255e4181")
+	interfaceIDVersion2 = *new(types.FieldElement).MustParse("0x1d1144bb2138366ff28d8e9ab57456b1d332ac42196230c3a602003c89872")
+)
+
+// InterfaceID returns the SRC-5 interface ID for the version.
+func (v Version) InterfaceID() (types.FieldElement, error) {
+	switch v {
+	case Version1:
+		return interfaceIDVersion1, nil
+	case Version2:
+		return interfaceIDVersion2, nil
+	default:
+		return types.FieldElement{}, fmt.Errorf("unsupported outside execution version %v", v)
+	}
+}
+
+// Supports returns true if the account supports the given version of outside execution.
+func Supports(ctx context.Context,
+	provider client.CallProvider,
+	account types.Address,
+	version Version,
+) (
+	bool,
+	error,
+) {
+	interfaceID, err := version.InterfaceID()
+	if err != nil {
+		return false, err
+	}
+
+	response, err := provider.Call(ctx, &api.CallOpts{
+		Block:      "latest",
+		Contract:   account,
+		EntryPoint: "supports_interface",
+		Calldata:   []types.FieldElement{interfaceID},
+	})
+	if err != nil {
+		return false, errors.Join(errors.New("failed to call supports_interface"), err)
+	}
+
+	if len(response.Data) != 1 {
+		return false, errors.New("unexpected response from supports_interface")
+	}
+
+	supported, err := response.Data[0].Uint64()
+	if err != nil || supported > 1 {
+		return false, errors.New("unexpected response from supports_interface")
+	}
+
+	return supported == 1, nil
+}
+
+// SupportedVersion returns the highest version of outside execution supported
+// by the account, or VersionUnknown if it does not support outside execution.
+func SupportedVersion(ctx context.Context,
+	provider client.CallProvider,
+	account types.Address,
+) (
+	Version,
+	error,
+) {
+	for _, version := range []Version{Version2, Version1} {
+		supported, err := Supports(ctx, provider, account, version)
+		if err != nil {
+			return VersionUnknown, err
+		}
+		if supported {
+			return version, nil
+		}
+	}
+
+	return VersionUnknown, nil
+}
@@ -0,0 +1,102 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package outsideexecution_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/attestantio/go-starknet-client/api"
+	"github.com/attestantio/go-starknet-client/outsideexecution"
+	"github.com/attestantio/go-starknet-client/types"
+	"github.com/stretchr/testify/require"
+)
+
+// callProvider is a call provider that returns support for a fixed set of interfaces.
+type callProvider struct {
+	interfaces []types.FieldElement
+	err        error
+}
+
+func (c *callProvider) Call(_ context.Context,
+	opts *api.CallOpts,
+) (
+	*api.Response[[]types.FieldElement],
+	error,
+) {
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	res := types.FieldElementFromUint64(0)
+	for i := range c.interfaces {
+		if c.interfaces[i] == opts.Calldata[0] {
+			res = types.FieldElementFromUint64(1)
+		}
+	}
+
+	return &api.Response[[]types.FieldElement]{
+		Data: []types.FieldElement{res},
+	}, nil
+}
+
+func TestSupportedVersion(t *testing.T) {
+	ctx := context.Background()
+
+	interfaceIDV1, err := outsideexecution.Version1.InterfaceID()
+	require.NoError(t, err)
+	interfaceIDV2, err := outsideexecution.Version2.InterfaceID()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		provider *callProvider
+		expected outsideexecution.Version
+		err      string
+	}{
+		{
+			name:     "None",
+			provider: &callProvider{},
+			expected: outsideexecution.VersionUnknown,
+		},
+		{
+			name:     "Version1",
+			provider: &callProvider{interfaces: []types.FieldElement{interfaceIDV1}},
+			expected: outsideexecution.Version1,
+		},
+		{
+			name:     "Both",
+			provider: &callProvider{interfaces: []types.FieldElement{interfaceIDV1, interfaceIDV2}},
+			expected: outsideexecution.Version2,
+		},
+		{
+			name:     "Error",
+			provider: &callProvider{err: errors.New("entry point not found")},
+			err:      "failed to call supports_interface\nentry point not found",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := outsideexecution.SupportedVersion(ctx, test.provider, types.Address{})
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res)
+			}
+		})
+	}
+}
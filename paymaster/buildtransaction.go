@@ -0,0 +1,111 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package paymaster
+
+import (
+	"context"
+	"errors"
+
+	client "github.com/attestantio/go-starknet-client"
+	"github.com/attestantio/go-starknet-client/api"
+)
+
+// buildTransactionRequest is the request for paymaster_buildTransaction.
+type buildTransactionRequest struct {
+	Transaction *Transaction `json:"transaction"`
+	Parameters  *Parameters  `json:"parameters"`
+}
+
+// BuildTransaction builds a transaction, returning the data for the user to sign and the estimated fee.
+func (s *Service) BuildTransaction(ctx context.Context,
+	opts *BuildTransactionOpts,
+) (
+	*api.Response[*BuildTransactionResponse],
+	error,
+) {
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	if err := checkTransaction(opts.Transaction); err != nil {
+		return nil, err
+	}
+
+	parameters, err := checkParameters(opts.Parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	var data BuildTransactionResponse
+	if err := s.rpcClient(ctx).CallFor(&data, "paymaster_buildTransaction", &buildTransactionRequest{
+		Transaction: opts.Transaction,
+		Parameters:  parameters,
+	}); err != nil {
+		return nil, parseJSONRPCError(err)
+	}
+
+	if data.Type != opts.Transaction.Type {
+		return nil, errors.Join(errors.New("transaction type mismatch"), client.ErrInconsistentResult)
+	}
+
+	return &api.Response[*BuildTransactionResponse]{
+		Data:     &data,
+		Metadata: map[string]any{},
+	}, nil
+}
+
+// checkTransaction checks that a transaction to build is consistent with its type.
+func checkTransaction(tx *Transaction) error {
+	if tx == nil {
+		return errors.Join(errors.New("no transaction specified"), client.ErrInvalidOptions)
+	}
+
+	needsDeployment := tx.Type == TransactionTypeDeploy || tx.Type == TransactionTypeDeployAndInvoke
+	needsInvoke := tx.Type == TransactionTypeInvoke || tx.Type == TransactionTypeDeployAndInvoke
+
+	switch {
+	case !needsDeployment && !needsInvoke:
+		return errors.Join(errors.New("unknown transaction type"), client.ErrInvalidOptions)
+	case needsDeployment && tx.Deployment == nil:
+		return errors.Join(errors.New("no deployment specified"), client.ErrInvalidOptions)
+	case needsInvoke && tx.Invoke == nil:
+		return errors.Join(errors.New("no invoke specified"), client.ErrInvalidOptions)
+	}
+
+	return nil
+}
+
+// checkParameters checks user parameters, returning a copy with defaults applied.
+func checkParameters(parameters *Parameters) (*Parameters, error) {
+	if parameters == nil {
+		return nil, errors.Join(errors.New("no parameters specified"), client.ErrInvalidOptions)
+	}
+
+	res := *parameters
+	if res.Version == "" {
+		res.Version = ParametersVersion1
+	}
+
+	switch res.FeeMode.Mode {
+	case FeeModeTypeSponsored:
+	case FeeModeTypeDefault:
+		if res.FeeMode.GasToken == nil {
+			return nil, errors.Join(errors.New("no gas token specified"), client.ErrInvalidOptions)
+		}
+	default:
+		return nil, errors.Join(errors.New("unknown fee mode"), client.ErrInvalidOptions)
+	}
+
+	return &res, nil
+}
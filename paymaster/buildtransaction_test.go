@@ -0,0 +1,151 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package paymaster_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/attestantio/go-starknet-client/paymaster"
+	"github.com/attestantio/go-starknet-client/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func strToAddress(input string) types.Address {
+	return *new(types.Address).MustParse(input)
+}
+
+func strToFieldElement(input string) types.FieldElement {
+	return *new(types.FieldElement).MustParse(input)
+}
+
+// buildTransactionResult is a sample result for paymaster_buildTransaction.
+const buildTransactionResult = `"result":{"type":"invoke","typed_data":{"types":{"StarknetDomain":[{"name":"name","type":"shortstring"},{"name":"version","type":"shortstring"},{"name":"chainId","type":"shortstring"},{"name":"revision","type":"shortstring"}],"OutsideExecution":[{"name":"Caller","type":"ContractAddress"},{"name":"Nonce","type":"felt"},{"name":"Execute After","type":"u128"},{"name":"Execute Before","type":"u128"},{"name":"Calls","type":"Call*"}],"Call":[{"name":"To","type":"ContractAddress"},{"name":"Selector","type":"selector"},{"name":"Calldata","type":"felt*"}]},"domain":{"name":"Account.execute_from_outside","version":"2","chainId":"SN_SEPOLIA","revision":"1"},"primaryType":"OutsideExecution","message":{"Caller":"0x75a180e18e56da1b1cae181c92a288f586f5fe22c18df21cf97886f1e4b316c","Nonce":"0x89ac3804c1e246bb124ae7c586a17cd8","Execute After":"0x1","Execute Before":"0x68d2eea3","Calls":[{"To":"0x669e24364ce0ae7ec2864fb03eedbe60cfbc9d1c74438d10fa4b86552907d54","Selector":"0x2f0b3c5710379609eb5495f1ecd348cb28167711b73609fe565a72734550354","Calldata":["0x2710","0x0"]}]}},"parameters":{"version":"0x1","fee_mode":{"mode":"sponsored"},"time_bounds":null},"fee":{"gas_token_price_in_strk":"0xde0b6b3a7640000","estimated_fee_in_strk":"0x11f7922748a560","estimated_fee_in_gas_token":"0x11f7922748a560","suggested_max_fee_in_strk":"0x6bcd6cebb3e040","suggested_max_fee_in_gas_token":"0x6bcd6cebb3e040"}}`
+
+func TestBuildTransaction(t *testing.T) {
+	ctx := context.Background()
+
+	user := strToAddress("0x5c74db20fa8f151bfd3a7a462cf2e8d4578a88aa4bd7a1746955201c48d8e5e")
+	gasToken := strToAddress("0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d")
+	invoke := &paymaster.Transaction{
+		Type: paymaster.TransactionTypeInvoke,
+		Invoke: &paymaster.Invoke{
+			UserAddress: user,
+			Calls: []*paymaster.Call{
+				{
+					To:       strToAddress("0x669e24364ce0ae7ec2864fb03eedbe60cfbc9d1c74438d10fa4b86552907d54"),
+					Selector: strToFieldElement("0x2f0b3c5710379609eb5495f1ecd348cb28167711b73609fe565a72734550354"),
+					Calldata: []types.FieldElement{strToFieldElement("0x2710"), strToFieldElement("0x0")},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		opts     *paymaster.BuildTransactionOpts
+		response string
+		request  string
+		err      string
+	}{
+		{
+			name: "OptsNil",
+			err:  "no options specified",
+		},
+		{
+			name: "TransactionMissing",
+			opts: &paymaster.BuildTransactionOpts{
+				Parameters: &paymaster.Parameters{FeeMode: paymaster.FeeMode{Mode: paymaster.FeeModeTypeSponsored}},
+			},
+			err: "no transaction specified\ninvalid options",
+		},
+		{
+			name: "InvokeMissing",
+			opts: &paymaster.BuildTransactionOpts{
+				Transaction: &paymaster.Transaction{Type: paymaster.TransactionTypeDeployAndInvoke, Deployment: &paymaster.Deployment{}},
+				Parameters:  &paymaster.Parameters{FeeMode: paymaster.FeeMode{Mode: paymaster.FeeModeTypeSponsored}},
+			},
+			err: "no invoke specified\ninvalid options",
+		},
+		{
+			name: "ParametersMissing",
+			opts: &paymaster.BuildTransactionOpts{
+				Transaction: invoke,
+			},
+			err: "no parameters specified\ninvalid options",
+		},
+		{
+			name: "GasTokenMissing",
+			opts: &paymaster.BuildTransactionOpts{
+				Transaction: invoke,
+				Parameters:  &paymaster.Parameters{FeeMode: paymaster.FeeMode{Mode: paymaster.FeeModeTypeDefault}},
+			},
+			err: "no gas token specified\ninvalid options",
+		},
+		{
+			name: "TokenNotSupported",
+			opts: &paymaster.BuildTransactionOpts{
+				Transaction: invoke,
+				Parameters:  &paymaster.Parameters{FeeMode: paymaster.FeeMode{Mode: paymaster.FeeModeTypeDefault, GasToken: &gasToken}},
+			},
+			response: `"error":{"code":151,"message":"An error occurred (TOKEN_NOT_SUPPORTED)"}`,
+			err:      "151:An error occurred (TOKEN_NOT_SUPPORTED)\ntoken not supported",
+		},
+		{
+			name: "Good",
+			opts: &paymaster.BuildTransactionOpts{
+				Transaction: invoke,
+				Parameters: &paymaster.Parameters{
+					FeeMode: paymaster.FeeMode{
+						Mode:     paymaster.FeeModeTypeDefault,
+						GasToken: &gasToken,
+						Tip:      &paymaster.Tip{Custom: 1000},
+					},
+				},
+			},
+			response: buildTransactionResult,
+			request:  `{"transaction":{"type":"invoke","invoke":{"user_address":"0x5c74db20fa8f151bfd3a7a462cf2e8d4578a88aa4bd7a1746955201c48d8e5e","calls":[{"to":"0x669e24364ce0ae7ec2864fb03eedbe60cfbc9d1c74438d10fa4b86552907d54","selector":"0x2f0b3c5710379609eb5495f1ecd348cb28167711b73609fe565a72734550354","calldata":["0x2710","0x0"]}]}},"parameters":{"version":"0x1","fee_mode":{"mode":"default","gas_token":"0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","tip":{"custom":1000}}}}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := newTestServer(t, map[string]string{
+				"paymaster_buildTransaction": test.response,
+			})
+			s, err := paymaster.New(ctx,
+				paymaster.WithLogLevel(zerolog.Disabled),
+				paymaster.WithAddress(server.URL),
+			)
+			require.NoError(t, err)
+
+			response, err := s.BuildTransaction(ctx, test.opts)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.JSONEq(t, test.request, string(server.request("paymaster_buildTransaction")))
+			require.Equal(t, paymaster.TransactionTypeInvoke, response.Data.Type)
+			require.Equal(t, "0x6bcd6cebb3e040", response.Data.Fee.SuggestedMaxFeeInGasToken.String())
+
+			// The typed data is ready for the user to sign.
+			hash, err := response.Data.TypedData.MessageHash(user)
+			require.NoError(t, err)
+			require.Equal(t, "0x523f153f2a3ca228a2ea222fb5114437a9191bdade099667ef0c113f5444fa0", hash.String())
+		})
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package paymaster
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/attestantio/go-starknet-client/typeddata"
+)
+
+// BuildTransactionResponse is the response from BuildTransaction.
+type BuildTransactionResponse struct {
+	Type       TransactionType `json:"type"`
+	Deployment *Deployment     `json:"deployment,omitempty"`
+	Parameters *Parameters     `json:"parameters"`
+	// TypedData is the outside execution to be signed by the user, for invoke transactions.
+	TypedData *typeddata.TypedData `json:"typed_data,omitempty"`
+	Fee       *FeeEstimate         `json:"fee"`
+}
+
+// String returns a string version of the structure.
+func (b *BuildTransactionResponse) String() string {
+	data, err := json.Marshal(b)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
@@ -0,0 +1,51 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package paymaster
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/attestantio/go-starknet-client/spec"
+	"github.com/attestantio/go-starknet-client/types"
+)
+
+// Call is a call to be made by the account.
+type Call struct {
+	To       types.Address        `json:"to"`
+	Selector types.FieldElement   `json:"selector"`
+	Calldata []types.FieldElement `json:"calldata"`
+}
+
+// CallFromFunctionCall creates a paymaster call from a function call.
+func CallFromFunctionCall(call *spec.FunctionCall) *Call {
+	calldata := make([]types.FieldElement, len(call.Calldata))
+	copy(calldata, call.Calldata)
+
+	return &Call{
+		To:       call.ContractAddress,
+		Selector: call.EntryPointSelector,
+		Calldata: calldata,
+	}
+}
+
+// String returns a string version of the structure.
+func (c *Call) String() string {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
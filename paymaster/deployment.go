@@ -0,0 +1,43 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package paymaster
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/attestantio/go-starknet-client/types"
+)
+
+// Deployment is the data required for the paymaster to deploy an account.
+type Deployment struct {
+	Address   types.Address        `json:"address"`
+	ClassHash types.Hash           `json:"class_hash"`
+	Salt      types.FieldElement   `json:"salt"`
+	Calldata  []types.FieldElement `json:"calldata"`
+	// SignatureData is additional data for the account signature, if required.
+	SignatureData []types.FieldElement `json:"sigdata,omitempty"`
+	// Version is the Cairo version of the account class.
+	Version uint8 `json:"version"`
+}
+
+// String returns a string version of the structure.
+func (d *Deployment) String() string {
+	data, err := json.Marshal(d)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
@@ -0,0 +1,87 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package paymaster
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	client "github.com/attestantio/go-starknet-client"
+	"github.com/ybbus/jsonrpc/v2"
+)
+
+var (
+	// ErrInvalidAddress is returned when the paymaster rejects an address.
+	ErrInvalidAddress = errors.New("invalid address")
+	// ErrTokenNotSupported is returned when the paymaster does not support the gas token.
+	ErrTokenNotSupported = errors.New("token not supported")
+	// ErrInvalidSignature is returned when the paymaster rejects a signature.
+	ErrInvalidSignature = errors.New("invalid signature")
+	// ErrMaxAmountTooLow is returned when the maximum fee amount is too low.
+	ErrMaxAmountTooLow = errors.New("max amount too low")
+	// ErrClassHashNotSupported is returned when the paymaster does not support the account class.
+	ErrClassHashNotSupported = errors.New("class hash not supported")
+	// ErrTransactionExecutionError is returned when the transaction fails to execute.
+	ErrTransactionExecutionError = errors.New("transaction execution error")
+	// ErrInvalidTimeBounds is returned when the paymaster rejects the time bounds.
+	ErrInvalidTimeBounds = errors.New("invalid time bounds")
+	// ErrInvalidDeploymentData is returned when the paymaster rejects the deployment data.
+	ErrInvalidDeploymentData = errors.New("invalid deployment data")
+	// ErrInvalidClassHash is returned when the paymaster rejects a class hash.
+	ErrInvalidClassHash = errors.New("invalid class hash")
+	// ErrInvalidID is returned when the paymaster does not recognise a tracking ID.
+	ErrInvalidID = errors.New("invalid ID")
+	// ErrUnknownError is returned when the paymaster returns an unspecified error.
+	ErrUnknownError = errors.New("unknown error")
+)
+
+// rpcErrors maps SNIP-29 error codes to errors.
+var rpcErrors = map[int]error{
+	150: ErrInvalidAddress,
+	151: ErrTokenNotSupported,
+	153: ErrInvalidSignature,
+	154: ErrMaxAmountTooLow,
+	155: ErrClassHashNotSupported,
+	156: ErrTransactionExecutionError,
+	157: ErrInvalidTimeBounds,
+	158: ErrInvalidDeploymentData,
+	159: ErrInvalidClassHash,
+	160: ErrInvalidID,
+	163: ErrUnknownError,
+}
+
+// parseJSONRPCError converts a JSON-RPC error to include its paymaster error and additional data.
+func parseJSONRPCError(err error) error {
+	var jsonrpcErr *jsonrpc.RPCError
+	if !errors.As(err, &jsonrpcErr) {
+		return errors.Join(err, client.ErrRPCCallFailed)
+	}
+
+	if jsonrpcErr.Data != nil {
+		// Include the additional data in the error.
+		additional, marshalErr := json.Marshal(jsonrpcErr.Data)
+		if marshalErr != nil {
+			return errors.Join(err, client.ErrUnsupportedFormat)
+		}
+
+		err = fmt.Errorf("%s %s", err.Error(), string(additional))
+	}
+
+	if paymasterErr, exists := rpcErrors[jsonrpcErr.Code]; exists {
+		return errors.Join(err, paymasterErr)
+	}
+
+	return errors.Join(err, client.ErrRPCCallFailed)
+}
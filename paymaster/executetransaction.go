@@ -0,0 +1,87 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package paymaster
+
+import (
+	"context"
+	"errors"
+
+	client "github.com/attestantio/go-starknet-client"
+	"github.com/attestantio/go-starknet-client/api"
+)
+
+// executeTransactionRequest is the request for paymaster_executeTransaction.
+type executeTransactionRequest struct {
+	Transaction *ExecutableTransaction `json:"transaction"`
+	Parameters  *Parameters            `json:"parameters"`
+}
+
+// ExecuteTransaction sends a built and signed transaction for the paymaster to execute.
+func (s *Service) ExecuteTransaction(ctx context.Context,
+	opts *ExecuteTransactionOpts,
+) (
+	*api.Response[*ExecuteTransactionResponse],
+	error,
+) {
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	if err := checkExecutableTransaction(opts.Transaction); err != nil {
+		return nil, err
+	}
+
+	parameters, err := checkParameters(opts.Parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	var data ExecuteTransactionResponse
+	if err := s.rpcClient(ctx).CallFor(&data, "paymaster_executeTransaction", &executeTransactionRequest{
+		Transaction: opts.Transaction,
+		Parameters:  parameters,
+	}); err != nil {
+		return nil, parseJSONRPCError(err)
+	}
+
+	return &api.Response[*ExecuteTransactionResponse]{
+		Data:     &data,
+		Metadata: map[string]any{},
+	}, nil
+}
+
+// checkExecutableTransaction checks that a transaction to execute is consistent with its type.
+func checkExecutableTransaction(tx *ExecutableTransaction) error {
+	if tx == nil {
+		return errors.Join(errors.New("no transaction specified"), client.ErrInvalidOptions)
+	}
+
+	needsDeployment := tx.Type == TransactionTypeDeploy || tx.Type == TransactionTypeDeployAndInvoke
+	needsInvoke := tx.Type == TransactionTypeInvoke || tx.Type == TransactionTypeDeployAndInvoke
+
+	switch {
+	case !needsDeployment && !needsInvoke:
+		return errors.Join(errors.New("unknown transaction type"), client.ErrInvalidOptions)
+	case needsDeployment && tx.Deployment == nil:
+		return errors.Join(errors.New("no deployment specified"), client.ErrInvalidOptions)
+	case needsInvoke && tx.Invoke == nil:
+		return errors.Join(errors.New("no invoke specified"), client.ErrInvalidOptions)
+	case needsInvoke && tx.Invoke.TypedData == nil:
+		return errors.Join(errors.New("no typed data specified"), client.ErrInvalidOptions)
+	case needsInvoke && len(tx.Invoke.Signature) == 0:
+		return errors.Join(errors.New("no signature specified"), client.ErrInvalidOptions)
+	}
+
+	return nil
+}
@@ -0,0 +1,152 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package paymaster_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-starknet-client/paymaster"
+	"github.com/attestantio/go-starknet-client/typeddata"
+	"github.com/attestantio/go-starknet-client/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecuteTransaction(t *testing.T) {
+	ctx := context.Background()
+
+	var built paymaster.BuildTransactionResponse
+	require.NoError(t, json.Unmarshal([]byte(buildTransactionResult[len(`"result":`):]), &built))
+
+	user := strToAddress("0x5c74db20fa8f151bfd3a7a462cf2e8d4578a88aa4bd7a1746955201c48d8e5e")
+	signature := types.Signature{strToFieldElement("0x1"), strToFieldElement("0x2")}
+	sponsored := &paymaster.Parameters{FeeMode: paymaster.FeeMode{Mode: paymaster.FeeModeTypeSponsored}}
+
+	tests := []struct {
+		name     string
+		opts     *paymaster.ExecuteTransactionOpts
+		response string
+		err      string
+	}{
+		{
+			name: "OptsNil",
+			err:  "no options specified",
+		},
+		{
+			name: "TypeUnknown",
+			opts: &paymaster.ExecuteTransactionOpts{
+				Transaction: &paymaster.ExecutableTransaction{},
+				Parameters:  sponsored,
+			},
+			err: "unknown transaction type\ninvalid options",
+		},
+		{
+			name: "TypedDataMissing",
+			opts: &paymaster.ExecuteTransactionOpts{
+				Transaction: &paymaster.ExecutableTransaction{
+					Type: paymaster.TransactionTypeInvoke,
+					Invoke: &paymaster.ExecutableInvoke{
+						UserAddress: user,
+						Signature:   signature,
+					},
+				},
+				Parameters: sponsored,
+			},
+			err: "no typed data specified\ninvalid options",
+		},
+		{
+			name: "SignatureMissing",
+			opts: &paymaster.ExecuteTransactionOpts{
+				Transaction: &paymaster.ExecutableTransaction{
+					Type: paymaster.TransactionTypeInvoke,
+					Invoke: &paymaster.ExecutableInvoke{
+						UserAddress: user,
+						TypedData:   &typeddata.TypedData{},
+					},
+				},
+				Parameters: sponsored,
+			},
+			err: "no signature specified\ninvalid options",
+		},
+		{
+			name: "InvalidSignature",
+			opts: &paymaster.ExecuteTransactionOpts{
+				Transaction: &paymaster.ExecutableTransaction{
+					Type: paymaster.TransactionTypeInvoke,
+					Invoke: &paymaster.ExecutableInvoke{
+						UserAddress: user,
+						TypedData:   built.TypedData,
+						Signature:   signature,
+					},
+				},
+				Parameters: built.Parameters,
+			},
+			response: `"error":{"code":153,"message":"An error occurred (INVALID_SIGNATURE)"}`,
+			err:      "153:An error occurred (INVALID_SIGNATURE)\ninvalid signature",
+		},
+		{
+			name: "Good",
+			opts: &paymaster.ExecuteTransactionOpts{
+				Transaction: &paymaster.ExecutableTransaction{
+					Type: paymaster.TransactionTypeInvoke,
+					Invoke: &paymaster.ExecutableInvoke{
+						UserAddress: user,
+						TypedData:   built.TypedData,
+						Signature:   signature,
+					},
+				},
+				Parameters: built.Parameters,
+			},
+			response: `"result":{"tracking_id":"0x123","transaction_hash":"0x5bf2f5c3c0a08ea5fe4b8bd2a2c5c5db55d2a1b7e1d7b1fd52c5a2b70cd0bc1"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := newTestServer(t, map[string]string{
+				"paymaster_executeTransaction": test.response,
+			})
+			s, err := paymaster.New(ctx,
+				paymaster.WithLogLevel(zerolog.Disabled),
+				paymaster.WithAddress(server.URL),
+			)
+			require.NoError(t, err)
+
+			response, err := s.ExecuteTransaction(ctx, test.opts)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "0x123", response.Data.TrackingID.String())
+			require.Equal(t, "0x5bf2f5c3c0a08ea5fe4b8bd2a2c5c5db55d2a1b7e1d7b1fd52c5a2b70cd0bc1", response.Data.TransactionHash.String())
+
+			// Ensure the typed data is passed back to the paymaster unaltered.
+			var request struct {
+				Transaction struct {
+					Invoke struct {
+						TypedData *typeddata.TypedData `json:"typed_data"`
+					} `json:"invoke"`
+				} `json:"transaction"`
+			}
+			require.NoError(t, json.Unmarshal(server.request("paymaster_executeTransaction"), &request))
+			hash, err := request.Transaction.Invoke.TypedData.MessageHash(user)
+			require.NoError(t, err)
+			require.Equal(t, "0x523f153f2a3ca228a2ea222fb5114437a9191bdade099667ef0c113f5444fa0", hash.String())
+		})
+	}
+}
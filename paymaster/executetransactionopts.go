@@ -0,0 +1,27 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package paymaster
+
+import "github.com/attestantio/go-starknet-client/api"
+
+// ExecuteTransactionOpts are the options for executing a paymaster transaction.
+type ExecuteTransactionOpts struct {
+	Common api.CommonOpts
+
+	// Transaction is the signed transaction to execute.
+	Transaction *ExecutableTransaction
+
+	// Parameters are the user's parameters for the transaction, as used when building it.
+	Parameters *Parameters
+}
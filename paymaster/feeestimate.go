@@ -0,0 +1,40 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package paymaster
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/attestantio/go-starknet-client/types"
+)
+
+// FeeEstimate is the paymaster's estimate of the fee for a transaction.
+type FeeEstimate struct {
+	GasTokenPriceInStrk       types.Amount `json:"gas_token_price_in_strk"`
+	EstimatedFeeInStrk        types.Amount `json:"estimated_fee_in_strk"`
+	EstimatedFeeInGasToken    types.Amount `json:"estimated_fee_in_gas_token"`
+	SuggestedMaxFeeInStrk     types.Amount `json:"suggested_max_fee_in_strk"`
+	SuggestedMaxFeeInGasToken types.Amount `json:"suggested_max_fee_in_gas_token"`
+}
+
+// String returns a string version of the structure.
+func (f *FeeEstimate) String() string {
+	data, err := json.Marshal(f)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
@@ -0,0 +1,91 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package paymaster
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/attestantio/go-starknet-client/types"
+)
+
+// FeeModeType defines how the fee for a paymaster transaction is paid.
+//
+//nolint:recvcheck
+type FeeModeType uint8
+
+const (
+	// FeeModeTypeUnknown is an unknown fee mode.
+	FeeModeTypeUnknown FeeModeType = iota
+	// FeeModeTypeSponsored has the fee paid by the paymaster.
+	FeeModeTypeSponsored
+	// FeeModeTypeDefault has the fee paid by the account in the gas token.
+	FeeModeTypeDefault
+)
+
+var feeModeTypeStrings = [...]string{
+	"unknown",
+	"sponsored",
+	"default",
+}
+
+// MarshalJSON implements json.Marshaler.
+func (f FeeModeType) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf("%q", f.String())), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (f *FeeModeType) UnmarshalJSON(input []byte) error {
+	var err error
+
+	switch strings.ToLower(string(input)) {
+	case `"sponsored"`:
+		*f = FeeModeTypeSponsored
+	case `"default"`:
+		*f = FeeModeTypeDefault
+	default:
+		err = fmt.Errorf("unrecognised fee mode %s", string(input))
+	}
+
+	return err
+}
+
+// String returns a string representation of the fee mode type.
+func (f FeeModeType) String() string {
+	if int(f) >= len(feeModeTypeStrings) {
+		return feeModeTypeStrings[0]
+	}
+
+	return feeModeTypeStrings[f]
+}
+
+// FeeMode defines how the fee for a paymaster transaction is paid.
+type FeeMode struct {
+	Mode FeeModeType `json:"mode"`
+	// GasToken is the token in which the fee is paid, for the default fee mode.
+	GasToken *types.Address `json:"gas_token,omitempty"`
+	// Tip is the tip to pay, if any.
+	Tip *Tip `json:"tip,omitempty"`
+}
+
+// String returns a string version of the structure.
+func (f *FeeMode) String() string {
+	data, err := json.Marshal(f)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
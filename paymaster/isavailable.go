@@ -0,0 +1,43 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package paymaster
+
+import (
+	"context"
+
+	client "github.com/attestantio/go-starknet-client"
+	"github.com/attestantio/go-starknet-client/api"
+)
+
+// IsAvailable returns true if the paymaster is available to serve requests.
+func (s *Service) IsAvailable(ctx context.Context,
+	opts *IsAvailableOpts,
+) (
+	*api.Response[bool],
+	error,
+) {
+	if opts == nil {
+		return nil, client.ErrNoOptions
+	}
+
+	var data bool
+	if err := s.rpcClient(ctx).CallFor(&data, "paymaster_isAvailable"); err != nil {
+		return nil, parseJSONRPCError(err)
+	}
+
+	return &api.Response[bool]{
+		Data:     data,
+		Metadata: map[string]any{},
+	}, nil
+}
@@ -0,0 +1,126 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package paymaster_test
+
+import (
+	"context"
+	"testing"
+
+	client "github.com/attestantio/go-starknet-client"
+	"github.com/attestantio/go-starknet-client/paymaster"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsAvailable(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		opts     *paymaster.IsAvailableOpts
+		response string
+		expected bool
+		err      string
+	}{
+		{
+			name: "OptsNil",
+			err:  "no options specified",
+		},
+		{
+			name:     "Available",
+			opts:     &paymaster.IsAvailableOpts{},
+			response: `"result":true`,
+			expected: true,
+		},
+		{
+			name:     "Unavailable",
+			opts:     &paymaster.IsAvailableOpts{},
+			response: `"result":false`,
+			expected: false,
+		},
+		{
+			name:     "Error",
+			opts:     &paymaster.IsAvailableOpts{},
+			response: `"error":{"code":163,"message":"An error occurred (UNKNOWN_ERROR)","data":"down for maintenance"}`,
+			err:      "163:An error occurred (UNKNOWN_ERROR) \"down for maintenance\"\nunknown error",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := newTestServer(t, map[string]string{
+				"paymaster_isAvailable": test.response,
+			})
+			s, err := paymaster.New(ctx,
+				paymaster.WithLogLevel(zerolog.Disabled),
+				paymaster.WithAddress(server.URL),
+			)
+			require.NoError(t, err)
+
+			response, err := s.IsAvailable(ctx, test.opts)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, response.Data)
+			}
+		})
+	}
+}
+
+func TestIsAvailableErrors(t *testing.T) {
+	ctx := context.Background()
+
+	server := newTestServer(t, map[string]string{
+		"paymaster_isAvailable": `"error":{"code":163,"message":"An error occurred (UNKNOWN_ERROR)"}`,
+	})
+	s, err := paymaster.New(ctx,
+		paymaster.WithLogLevel(zerolog.Disabled),
+		paymaster.WithAddress(server.URL),
+	)
+	require.NoError(t, err)
+
+	_, err = s.IsAvailable(ctx, &paymaster.IsAvailableOpts{})
+	require.ErrorIs(t, err, paymaster.ErrUnknownError)
+
+	server = newTestServer(t, map[string]string{
+		"paymaster_isAvailable": `"error":{"code":-32000,"message":"Server error"}`,
+	})
+	s, err = paymaster.New(ctx,
+		paymaster.WithLogLevel(zerolog.Disabled),
+		paymaster.WithAddress(server.URL),
+	)
+	require.NoError(t, err)
+
+	_, err = s.IsAvailable(ctx, &paymaster.IsAvailableOpts{})
+	require.ErrorIs(t, err, client.ErrRPCCallFailed)
+}
+
+func TestIsAvailableContext(t *testing.T) {
+	ctx := context.Background()
+
+	server := newTestServer(t, map[string]string{
+		"paymaster_isAvailable": `"result":true`,
+	})
+	s, err := paymaster.New(ctx,
+		paymaster.WithLogLevel(zerolog.Disabled),
+		paymaster.WithAddress(server.URL),
+	)
+	require.NoError(t, err)
+
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = s.IsAvailable(cancelledCtx, &paymaster.IsAvailableOpts{})
+	require.ErrorContains(t, err, "context canceled")
+}
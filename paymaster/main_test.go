@@ -0,0 +1,101 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package paymaster_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+// rpcRequest is a JSON-RPC request received by the test server.
+type rpcRequest struct {
+	ID     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+}
+
+// testServer is a stand-in paymaster that returns canned responses by method.
+type testServer struct {
+	*httptest.Server
+
+	mu        sync.Mutex
+	responses map[string]string
+	requests  map[string]json.RawMessage
+	headers   http.Header
+}
+
+// newTestServer creates a test server with the given results or errors, keyed by method.
+func newTestServer(t *testing.T, responses map[string]string) *testServer {
+	t.Helper()
+
+	s := &testServer{
+		responses: responses,
+		requests:  make(map[string]json.RawMessage),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("failed to read request: %v", err)
+			w.WriteHeader(http.StatusInternalServerError)
+
+			return
+		}
+
+		var request rpcRequest
+		if err := json.Unmarshal(body, &request); err != nil {
+			t.Errorf("failed to unmarshal request: %v", err)
+			w.WriteHeader(http.StatusInternalServerError)
+
+			return
+		}
+
+		s.mu.Lock()
+		s.requests[request.Method] = request.Params
+		s.headers = r.Header.Clone()
+		response, exists := s.responses[request.Method]
+		s.mu.Unlock()
+
+		if !exists {
+			response = `"error":{"code":-32601,"message":"Method not found"}`
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"jsonrpc":"2.0","id":` + string(request.ID) + `,` + response + `}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// request returns the parameters of the last request for the method.
+func (s *testServer) request(method string) json.RawMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.requests[method]
+}
+
+// header returns the value of a header of the last request.
+func (s *testServer) header(name string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.headers.Get(name)
+}
@@ -0,0 +1,109 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package paymaster provides a client for SNIP-29 paymaster services, which
+// build and submit transactions on behalf of accounts with fees paid in
+// alternative tokens or sponsored by a third party.
+package paymaster
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/attestantio/go-starknet-client/util"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+	"github.com/ybbus/jsonrpc/v2"
+)
+
+// Service is a paymaster client service.
+type Service struct {
+	log           zerolog.Logger
+	base          *url.URL
+	address       string
+	httpClient    *http.Client
+	customHeaders map[string]string
+}
+
+// New creates a new paymaster client service, connecting with a standard HTTP.
+func New(_ context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "client").Str("impl", "paymaster").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	httpClient := &http.Client{
+		Timeout: parameters.timeout,
+		Transport: &http.Transport{
+			DialContext: (&net.Dialer{
+				Timeout:   30 * time.Second,
+				KeepAlive: 30 * time.Second,
+			}).DialContext,
+			MaxIdleConns:        64,
+			MaxIdleConnsPerHost: 64,
+			IdleConnTimeout:     384 * time.Second,
+		},
+	}
+
+	base, address, err := util.ParseAddress(parameters.address)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Trace().Stringer("address", address).Msg("Address configured")
+
+	extraHeaders := map[string]string{
+		"User-Agent": "go-starknet-client/0.1.10",
+	}
+	if parameters.apiKey != "" {
+		extraHeaders["x-paymaster-api-key"] = parameters.apiKey
+	}
+
+	return &Service{
+		log:           log,
+		base:          base,
+		address:       address.String(),
+		httpClient:    httpClient,
+		customHeaders: extraHeaders,
+	}, nil
+}
+
+// Name provides the name of the service.
+func (*Service) Name() string {
+	return "paymaster"
+}
+
+// Address provides the address for the connection.
+func (s *Service) Address() string {
+	return s.address
+}
+
+// rpcClient returns a JSON-RPC client whose requests are bound to the context.
+func (s *Service) rpcClient(ctx context.Context) jsonrpc.RPCClient {
+	return jsonrpc.NewClientWithOpts(s.base.String(), &jsonrpc.RPCClientOpts{
+		HTTPClient: &http.Client{
+			Timeout:   s.httpClient.Timeout,
+			Transport: util.ContextTransport(ctx, s.httpClient.Transport),
+		},
+		CustomHeaders: s.customHeaders,
+	})
+}
@@ -0,0 +1,92 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package paymaster_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-starknet-client/paymaster"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		params []paymaster.Parameter
+		err    string
+	}{
+		{
+			name: "AddressMissing",
+			params: []paymaster.Parameter{
+				paymaster.WithLogLevel(zerolog.Disabled),
+			},
+			err: "no address specified",
+		},
+		{
+			name: "TimeoutZero",
+			params: []paymaster.Parameter{
+				paymaster.WithLogLevel(zerolog.Disabled),
+				paymaster.WithAddress("http://localhost:12345"),
+				paymaster.WithTimeout(0),
+			},
+			err: "no timeout specified",
+		},
+		{
+			name: "Good",
+			params: []paymaster.Parameter{
+				paymaster.WithLogLevel(zerolog.Disabled),
+				paymaster.WithAddress("http://localhost:12345/"),
+				paymaster.WithTimeout(time.Second),
+				paymaster.WithAPIKey("secret"),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, err := paymaster.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, "paymaster", s.Name())
+				require.Equal(t, "http://localhost:12345", s.Address())
+			}
+		})
+	}
+}
+
+func TestAPIKey(t *testing.T) {
+	ctx := context.Background()
+
+	server := newTestServer(t, map[string]string{
+		"paymaster_isAvailable": `"result":true`,
+	})
+
+	s, err := paymaster.New(ctx,
+		paymaster.WithLogLevel(zerolog.Disabled),
+		paymaster.WithAddress(server.URL),
+		paymaster.WithAPIKey("secret"),
+	)
+	require.NoError(t, err)
+
+	_, err = s.IsAvailable(ctx, &paymaster.IsAvailableOpts{})
+	require.NoError(t, err)
+	require.Equal(t, "secret", server.header("x-paymaster-api-key"))
+}
@@ -0,0 +1,47 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package paymaster_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/attestantio/go-starknet-client/paymaster"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSupportedTokens(t *testing.T) {
+	ctx := context.Background()
+
+	server := newTestServer(t, map[string]string{
+		"paymaster_getSupportedTokens": `"result":[{"token_address":"0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","decimals":18,"price_in_strk":"0xde0b6b3a7640000"},{"token_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","decimals":6,"price_in_strk":"0x2e3bb5a4ba7d5f"}]`,
+	})
+	s, err := paymaster.New(ctx,
+		paymaster.WithLogLevel(zerolog.Disabled),
+		paymaster.WithAddress(server.URL),
+	)
+	require.NoError(t, err)
+
+	_, err = s.SupportedTokens(ctx, nil)
+	require.EqualError(t, err, "no options specified")
+
+	response, err := s.SupportedTokens(ctx, &paymaster.SupportedTokensOpts{})
+	require.NoError(t, err)
+	require.Len(t, response.Data, 2)
+	require.Equal(t, "0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d", response.Data[0].TokenAddress.String())
+	require.Equal(t, uint8(18), response.Data[0].Decimals)
+	require.Equal(t, "0xde0b6b3a7640000", response.Data[0].PriceInStrk.String())
+	require.Equal(t, uint8(6), response.Data[1].Decimals)
+}
@@ -0,0 +1,134 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package paymaster
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// TipPriority defines the priority of a tip calculated by the paymaster.
+//
+//nolint:recvcheck
+type TipPriority uint8
+
+const (
+	// TipPriorityUnknown is an unknown tip priority.
+	TipPriorityUnknown TipPriority = iota
+	// TipPrioritySlow is a low tip.
+	TipPrioritySlow
+	// TipPriorityNormal is a normal tip.
+	TipPriorityNormal
+	// TipPriorityFast is a high tip.
+	TipPriorityFast
+)
+
+var tipPriorityStrings = [...]string{
+	"unknown",
+	"slow",
+	"normal",
+	"fast",
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t TipPriority) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf("%q", t.String())), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (t *TipPriority) UnmarshalJSON(input []byte) error {
+	var err error
+
+	switch strings.ToLower(string(input)) {
+	case `"slow"`:
+		*t = TipPrioritySlow
+	case `"normal"`:
+		*t = TipPriorityNormal
+	case `"fast"`:
+		*t = TipPriorityFast
+	default:
+		err = fmt.Errorf("unrecognised tip priority %s", string(input))
+	}
+
+	return err
+}
+
+// String returns a string representation of the tip priority.
+func (t TipPriority) String() string {
+	if int(t) >= len(tipPriorityStrings) {
+		return tipPriorityStrings[0]
+	}
+
+	return tipPriorityStrings[t]
+}
+
+// Tip is the tip for a paymaster transaction, either a priority or a custom value.
+type Tip struct {
+	// Priority is the priority of the tip, if the paymaster is to calculate it.
+	Priority TipPriority
+	// Custom is a fixed tip.
+	Custom uint64
+}
+
+// tipCustomJSON is the JSON representation of a custom tip.
+type tipCustomJSON struct {
+	Custom uint64 `json:"custom"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t *Tip) MarshalJSON() ([]byte, error) {
+	if t.Priority != TipPriorityUnknown {
+		return json.Marshal(t.Priority)
+	}
+
+	return json.Marshal(&tipCustomJSON{
+		Custom: t.Custom,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (t *Tip) UnmarshalJSON(input []byte) error {
+	if bytes.HasPrefix(input, []byte{'"'}) {
+		var priority TipPriority
+		if err := json.Unmarshal(input, &priority); err != nil {
+			return err
+		}
+		t.Priority = priority
+		t.Custom = 0
+
+		return nil
+	}
+
+	var data tipCustomJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Join(errors.New("invalid JSON"), err)
+	}
+	t.Priority = TipPriorityUnknown
+	t.Custom = data.Custom
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (t *Tip) String() string {
+	data, err := json.Marshal(t)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
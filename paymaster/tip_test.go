@@ -0,0 +1,78 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package paymaster_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-starknet-client/paymaster"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTipJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name:  "Empty",
+			input: []byte{},
+			err:   "unexpected end of JSON input",
+		},
+		{
+			name:  "PriorityUnknown",
+			input: []byte(`"quick"`),
+			err:   "unrecognised tip priority \"quick\"",
+		},
+		{
+			name:  "CustomInvalid",
+			input: []byte(`{"custom":"1000"}`),
+			err:   "invalid JSON\njson: cannot unmarshal string into Go struct field tipCustomJSON.custom of type uint64",
+		},
+		{
+			name:  "Slow",
+			input: []byte(`"slow"`),
+		},
+		{
+			name:  "Normal",
+			input: []byte(`"normal"`),
+		},
+		{
+			name:  "Fast",
+			input: []byte(`"fast"`),
+		},
+		{
+			name:  "Custom",
+			input: []byte(`{"custom":1000}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res paymaster.Tip
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				require.Equal(t, string(test.input), string(rt))
+				require.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package paymaster
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/attestantio/go-starknet-client/typeddata"
+	"github.com/attestantio/go-starknet-client/types"
+)
+
+// Transaction is a transaction for the paymaster to build.
+type Transaction struct {
+	Type       TransactionType `json:"type"`
+	Deployment *Deployment     `json:"deployment,omitempty"`
+	Invoke     *Invoke         `json:"invoke,omitempty"`
+}
+
+// Invoke is a set of calls for the paymaster to build into a transaction.
+type Invoke struct {
+	UserAddress types.Address `json:"user_address"`
+	Calls       []*Call       `json:"calls"`
+}
+
+// ExecutableTransaction is a built and signed transaction for the paymaster to execute.
+type ExecutableTransaction struct {
+	Type       TransactionType   `json:"type"`
+	Deployment *Deployment       `json:"deployment,omitempty"`
+	Invoke     *ExecutableInvoke `json:"invoke,omitempty"`
+}
+
+// ExecutableInvoke is the typed data returned by the paymaster with the user's signature.
+type ExecutableInvoke struct {
+	UserAddress types.Address        `json:"user_address"`
+	TypedData   *typeddata.TypedData `json:"typed_data"`
+	Signature   types.Signature      `json:"signature"`
+}
+
+// String returns a string version of the structure.
+func (t *Transaction) String() string {
+	data, err := json.Marshal(t)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
+
+// String returns a string version of the structure.
+func (t *ExecutableTransaction) String() string {
+	data, err := json.Marshal(t)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
@@ -0,0 +1,74 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package paymaster
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TransactionType defines the type of a paymaster transaction.
+//
+//nolint:recvcheck
+type TransactionType uint8
+
+const (
+	// TransactionTypeUnknown is an unknown transaction type.
+	TransactionTypeUnknown TransactionType = iota
+	// TransactionTypeDeploy deploys an account.
+	TransactionTypeDeploy
+	// TransactionTypeInvoke invokes calls from an account.
+	TransactionTypeInvoke
+	// TransactionTypeDeployAndInvoke deploys an account and invokes calls from it.
+	TransactionTypeDeployAndInvoke
+)
+
+var transactionTypeStrings = [...]string{
+	"unknown",
+	"deploy",
+	"invoke",
+	"deploy_and_invoke",
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t TransactionType) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf("%q", t.String())), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (t *TransactionType) UnmarshalJSON(input []byte) error {
+	var err error
+
+	switch strings.ToLower(string(input)) {
+	case `"deploy"`:
+		*t = TransactionTypeDeploy
+	case `"invoke"`:
+		*t = TransactionTypeInvoke
+	case `"deploy_and_invoke"`:
+		*t = TransactionTypeDeployAndInvoke
+	default:
+		err = fmt.Errorf("unrecognised transaction type %s", string(input))
+	}
+
+	return err
+}
+
+// String returns a string representation of the transaction type.
+func (t TransactionType) String() string {
+	if int(t) >= len(transactionTypeStrings) {
+		return transactionTypeStrings[0]
+	}
+
+	return transactionTypeStrings[t]
+}
@@ -0,0 +1,67 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package paymaster_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-starknet-client/paymaster"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransactionTypeJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "Unknown",
+			input: []byte(`"declare"`),
+			err:   "unrecognised transaction type \"declare\"",
+		},
+		{
+			name:  "Deploy",
+			input: []byte(`"deploy"`),
+		},
+		{
+			name:  "Invoke",
+			input: []byte(`"invoke"`),
+		},
+		{
+			name:  "DeployAndInvoke",
+			input: []byte(`"deploy_and_invoke"`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res paymaster.TransactionType
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(res)
+				require.NoError(t, err)
+				require.Equal(t, string(test.input), string(rt))
+			}
+		})
+	}
+}
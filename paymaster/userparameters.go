@@ -0,0 +1,47 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package paymaster
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ParametersVersion1 is version 1 of the user parameters.
+const ParametersVersion1 = "0x1"
+
+// Parameters are the user's parameters for a paymaster transaction.
+type Parameters struct {
+	// Version is the version of the parameters; defaults to ParametersVersion1.
+	Version string  `json:"version"`
+	FeeMode FeeMode `json:"fee_mode"`
+	// TimeBounds are the times within which the transaction must execute, if any.
+	TimeBounds *TimeBounds `json:"time_bounds,omitempty"`
+}
+
+// TimeBounds are the times within which a transaction must execute.
+type TimeBounds struct {
+	ExecuteAfter  uint64 `json:"execute_after"`
+	ExecuteBefore uint64 `json:"execute_before"`
+}
+
+// String returns a string version of the structure.
+func (p *Parameters) String() string {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
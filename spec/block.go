@@ -21,16 +21,19 @@ import (
 )
 
 // Block contains a block.
+// Pending blocks have no hash or number, and pre-confirmed blocks have a number
+// but no hash or parent hash.
 type Block struct {
 	Status           *FinalityStatus          `json:"status,omitempty"`
 	BlockHash        *types.Hash              `json:"block_hash,omitempty"`
-	ParentHash       types.Hash               `json:"parent_hash"`
+	ParentHash       *types.Hash              `json:"parent_hash,omitempty"`
 	BlockNumber      *uint64                  `json:"block_number,omitempty"`
 	NewRoot          *types.Root              `json:"new_root,omitempty"`
 	Timestamp        uint64                   `json:"timestamp"`
 	SequencerAddress types.Address            `json:"sequencer_address"`
 	L1GasPrice       Price                    `json:"l1_gas_price"`
 	L1DataGasPrice   Price                    `json:"l1_data_gas_price"`
+	L2GasPrice       *Price                   `json:"l2_gas_price,omitempty"`
 	L1DAMode         BlockDAMode              `json:"l1_da_mode"`
 	StarknetVersion  string                   `json:"starknet_version"`
 	Transactions     []*TransactionAndReceipt `json:"transactions"`
@@ -51,6 +51,10 @@ func TestBlock(t *testing.T) {
 			name:  "AmountTest",
 			input: []byte(`{"block_hash":"0x2fd47100de969ca83d9bc3475999095765c1ee445ad62f5bfe2b10c07232bbb","block_number":1081035,"l1_da_mode":"BLOB","l1_data_gas_price":{"price_in_fri":"0x39c36","price_in_wei":"0x1e"},"l1_gas_price":{"price_in_fri":"0x220e23279857f","price_in_wei":"0x11554f5307"},"new_root":"0x7871cf616947c7917875623d0c10c703edd26388a15bf75f9a7bd12b5b29bfa","parent_hash":"0x7f92e113b5d330c3d26fb12091180b6a3c86e6e11c28efcf4ee1fd01a095fe4","sequencer_address":"0x1176a1bd84444c89232ec27754698e5d2e7e1a7f1539f12027f28b23ec9f3d8","starknet_version":"0.13.3","status":"ACCEPTED_ON_L2","timestamp":1737298117,"transactions":[{"receipt":{"actual_fee":{"amount":"0xa16a72b8793","unit":"WEI"},"events":[{"data":["0x678d10bc","0x48554f4249","0x505241474d41","0x4eaf4a731c","0x4554482f555344","0x924ccfd"],"from_address":"0x2a85bd616f912537c50a49a4076db02c00b29b2cdc8a197ce92ed1837fa875b","keys":["0x280bb2099800026f90c334a3a23888ffe718a2920ffbbf4f44c6d3d5efb613c"]},{"data":["0x678d10ba","0x4b55434f494e","0x505241474d41","0x4e8f8f9200","0x4554482f555344","0x0"],"from_address":"0x2a85bd616f912537c50a49a4076db02c00b29b2cdc8a197ce92ed1837fa875b","keys":["0x280bb2099800026f90c334a3a23888ffe718a2920ffbbf4f44c6d3d5efb613c"]},{"data":["0x678d10ba","0x44455853435245454e4552","0x505241474d41","0x4e15c9dd40","0x4554482f555344","0x0"],"from_address":"0x2a85bd616f912537c50a49a4076db02c00b29b2cdc8a197ce92ed1837fa875b","keys":["0x280bb2099800026f90c334a3a23888ffe718a2920ffbbf4f44c6d3d5efb613c"]},{"data":["0x678d10ba","0x4745434b4f5445524d494e414c","0x505241474d41","0x4e2e864300","0x4554482f555344","0x85c4bca0"],"from_address":"0x2a85bd616f912537c50a49a4076db02c00b29b2cdc8a197ce92ed1837fa875b","keys":["0x280bb2099800026f90c334a3a23888ffe718a2920ffbbf4f44c6d3d5efb613c"]},{"data":["0x1","0x0"],"from_address":"0x6707675cd7dd9256667eca8284e46f4546711ee0054bc2dd02f0ce572056cf4","keys":["0x1dcde06aabdbca2f80aa51392b345d7549d7757aa855f7e37f5d335ac8243b1","0x77601ebfab43a335452e0dd263753d8336ece0b0e4607dc21a007c75090db4d"]},{"data":["0x6707675cd7dd9256667eca8284e46f4546711ee0054bc2dd02f0ce572056cf4","0x1176a1bd84444c89232ec27754698e5d2e7e1a7f1539f12027f28b23ec9f3d8","0xa16a72b8793","0x0"],"from_address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]}],"execution_resources":{"bitwise_builtin_applications":12,"data_availability":{"l1_data_gas":448,"l1_gas":0},"ec_op_builtin_applications":3,"pedersen_builtin_applications":287,"poseidon_builtin_applications":8,"range_check_builtin_applications":1746,"steps":56294},"execution_status":"SUCCEEDED","finality_status":"ACCEPTED_ON_L2","messages_sent":[],"transaction_hash":"0x77601ebfab43a335452e0dd263753d8336ece0b0e4607dc21a007c75090db4d","type":"INVOKE"},"transaction":{"calldata":["0x1","0x2a85bd616f912537c50a49a4076db02c00b29b2cdc8a197ce92ed1837fa875b","0x3d0bcca55c118f88a08e0fcc06f43906c0c174feb52ebc83f0fa28a1f59ed67","0x1d","0x4","0x0","0x678d10bc","0x48554f4249","0x505241474d41","0x4eaf4a731c","0x4554482f555344","0x924ccfd","0x0","0x678d10ba","0x4b55434f494e","0x505241474d41","0x4e8f8f9200","0x4554482f555344","0x0","0x0","0x678d10ba","0x44455853435245454e4552","0x505241474d41","0x4e15c9dd40","0x4554482f555344","0x0","0x0","0x678d10ba","0x4745434b4f5445524d494e414c","0x505241474d41","0x4e2e864300","0x4554482f555344","0x85c4bca0"],"max_fee":"0x16345785d8a0000","nonce":"0x7b75e","sender_address":"0x6707675cd7dd9256667eca8284e46f4546711ee0054bc2dd02f0ce572056cf4","signature":["0x2ee8960269fea9fa9308dd0240be0fbb6af7bee5b0934bd33146d4cd0423ebe","0x35e9e0b5f34c2f9035777fc19d162c7a8a8e7bd6035e6794348b85e17f2ffef"],"transaction_hash":"0x77601ebfab43a335452e0dd263753d8336ece0b0e4607dc21a007c75090db4d","type":"INVOKE","version":"0x1"}},{"receipt":{"actual_fee":{"amount":"0x443e5473a46b9ff","unit":"FRI"},"events":[{"data":["0x91e997822ba21d2cee6b7d6eea9a76c7d07c32dc2262adca1a22dafadaaa30","0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0xbeec875","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x91e997822ba21d2cee6b7d6eea9a76c7d07c32dc2262adca1a22dafadaaa30","0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x8f988","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x91e997822ba21d2cee6b7d6eea9a76c7d07c32dc2262adca1a22dafadaaa30","0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0xbe5ceed","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x7a6f98c03379b9513ca84cca1373ff452a7462a3b61598f0af5bb27ad7f76d1","0xbe5ceed","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x7a6f98c03379b9513ca84cca1373ff452a7462a3b61598f0af5bb27ad7f76d1","0x0","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x23c72abdf49dffc85ae3ede714f2168ad384cc67d08524732acea90df325","0xbe5ceed","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x23c72abdf49dffc85ae3ede714f2168ad384cc67d08524732acea90df325","0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0xd2e8d0b45c3acc","0x0"],"from_address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x6729b98625b1165a3","0x5ce152effa"],"from_address":"0x23c72abdf49dffc85ae3ede714f2168ad384cc67d08524732acea90df325","keys":["0xe14a408baf7f453312eec68e9b7d728ec5337fbdf671f917ee8c80f3255232"]},{"data":["0x7a6f98c03379b9513ca84cca1373ff452a7462a3b61598f0af5bb27ad7f76d1","0x0","0x0","0xbe5ceed","0x0","0xd2e8d0b45c3acc","0x0","0x0","0x0","0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f"],"from_address":"0x23c72abdf49dffc85ae3ede714f2168ad384cc67d08524732acea90df325","keys":["0xe316f0d9d2a3affa97de1d99bb2aac0538e2666d0d8545545ead241ef0ccab"]},{"data":["0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","0x20c49ba5e353f80000000000000000","0x3e8","0x0","0xd2e8d0b45c3acc","0x0","0x1","0x2d984758ccba7be45a6546eec4cfe97","0x0","0x64","0x19fb326bb0b241d444","0x1","0xd2e8d0b45c3acc","0x0","0x2d94f07206ca47fcc1f6ba9501b45c0","0x0","0x8946a9","0x1","0x3f55f028ad1b2013f78"],"from_address":"0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","keys":["0x157717768aca88da4ac4279765f09f4d0151823d573537fbbeb950cdbd9a870"]},{"data":["0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0xd2e8d0b45c3acc","0x0"],"from_address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0x0","0x0"],"from_address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0xd2e8d0b45c3acc","0x0"],"from_address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x19fb326bb0b241d444","0x0"],"from_address":"0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x91e997822ba21d2cee6b7d6eea9a76c7d07c32dc2262adca1a22dafadaaa30","0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x2d0f4","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x91e997822ba21d2cee6b7d6eea9a76c7d07c32dc2262adca1a22dafadaaa30","0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x62894","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x7a6f98c03379b9513ca84cca1373ff452a7462a3b61598f0af5bb27ad7f76d1","0x62894","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x7a6f98c03379b9513ca84cca1373ff452a7462a3b61598f0af5bb27ad7f76d1","0x0","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x23c72abdf49dffc85ae3ede714f2168ad384cc67d08524732acea90df325","0x62894","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x23c72abdf49dffc85ae3ede714f2168ad384cc67d08524732acea90df325","0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x6d1dde9068d3","0x0"],"from_address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x6729b2b447c80fcd0","0x5ce159188e"],"from_address":"0x23c72abdf49dffc85ae3ede714f2168ad384cc67d08524732acea90df325","keys":["0xe14a408baf7f453312eec68e9b7d728ec5337fbdf671f917ee8c80f3255232"]},{"data":["0x7a6f98c03379b9513ca84cca1373ff452a7462a3b61598f0af5bb27ad7f76d1","0x0","0x0","0x62894","0x0","0x6d1dde9068d3","0x0","0x0","0x0","0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f"],"from_address":"0x23c72abdf49dffc85ae3ede714f2168ad384cc67d08524732acea90df325","keys":["0xe316f0d9d2a3affa97de1d99bb2aac0538e2666d0d8545545ead241ef0ccab"]},{"data":["0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","0x20c49ba5e353f80000000000000000","0x3e8","0x0","0x6d1dde9068d3","0x0","0x1","0x2d9b9ba76c96e7fcf7a87ca71f2d514","0x0","0x64","0xd7019073dfe5a33","0x1","0x6d1dde9068d3","0x0","0x2d94f22ad9c3e9255cf72519389493c","0x0","0x8946a7","0x1","0x3f55f028ad1b2013f78"],"from_address":"0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","keys":["0x157717768aca88da4ac4279765f09f4d0151823d573537fbbeb950cdbd9a870"]},{"data":["0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0x6d1dde9068d3","0x0"],"from_address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0x0","0x0"],"from_address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0x6d1dde9068d3","0x0"],"from_address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0xd7019073dfe5a33","0x0"],"from_address":"0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x91e997822ba21d2cee6b7d6eea9a76c7d07c32dc2262adca1a22dafadaaa30","0x1a05509375019758c7","0x0"],"from_address":"0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x360fb3a51bd291e5db0892b6249918a5689bc61760adcb350fe39cd725e1d22","0x351f142eea8d5b0","0x0"],"from_address":"0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x91e997822ba21d2cee6b7d6eea9a76c7d07c32dc2262adca1a22dafadaaa30","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0xbebf781","0x0","0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","0x1a05509375019758c7","0x0","0x91e997822ba21d2cee6b7d6eea9a76c7d07c32dc2262adca1a22dafadaaa30"],"from_address":"0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","keys":["0xe316f0d9d2a3affa97de1d99bb2aac0538e2666d0d8545545ead241ef0ccab"]},{"data":["0x91e997822ba21d2cee6b7d6eea9a76c7d07c32dc2262adca1a22dafadaaa30","0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x0","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x91e997822ba21d2cee6b7d6eea9a76c7d07c32dc2262adca1a22dafadaaa30","0x1176a1bd84444c89232ec27754698e5d2e7e1a7f1539f12027f28b23ec9f3d8","0x443e5473a46b9ff","0x0"],"from_address":"0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]}],"execution_resources":{"bitwise_builtin_applications":144,"data_availability":{"l1_data_gas":1472,"l1_gas":0},"ec_op_builtin_applications":3,"pedersen_builtin_applications":297,"poseidon_builtin_applications":24,"range_check_builtin_applications":10005,"steps":191362},"execution_status":"SUCCEEDED","finality_status":"ACCEPTED_ON_L2","messages_sent":[],"transaction_hash":"0x95280acfcefec838e570f1d25b6fb8108695fd7515d4a563c37947937bd88c","type":"INVOKE"},"transaction":{"account_deployment_data":[],"calldata":["0x3","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0x219209e083275171774dab1df80982e9df2096516f06319c5c6d71ae0a8480c","0x3","0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0xbeec875","0x0","0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0xe9f3b52dc560050c4c679481500c1b1e2ba7496b6a0831638c1acaedcbc6ac","0x1a","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0xbe5ceed","0x0","0xbeec875","0x0","0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","0x1a05509375019758c7","0x0","0x91e997822ba21d2cee6b7d6eea9a76c7d07c32dc2262adca1a22dafadaaa30","0x2","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","0x7a6f98c03379b9513ca84cca1373ff452a7462a3b61598f0af5bb27ad7f76d1","0xe8d4a51000","0x0","0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0xe8d4a51000","0x6","0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","0x20c49ba5e353f80000000000000000","0x3e8","0x0","0x6ab3565cca00035b1c2121d78f54","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0x219209e083275171774dab1df80982e9df2096516f06319c5c6d71ae0a8480c","0x3","0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x0","0x0"],"fee_data_availability_mode":"L1","nonce":"0x234e","nonce_data_availability_mode":"L1","paymaster_data":[],"resource_bounds":{"l1_gas":{"max_amount":"0x2e9","max_price_per_unit":"0x331534bb6483e"},"l2_gas":{"max_amount":"0x0","max_price_per_unit":"0x0"}},"sender_address":"0x91e997822ba21d2cee6b7d6eea9a76c7d07c32dc2262adca1a22dafadaaa30","signature":["0x34bdd84d259154d14af8897c27c7bcbc7f515a3d9db3a02f68a97d43e8f4dfb","0x2c52c36ced6f8e71fd28a4232505326f83c5570b8b5bdc30c619a39c566d6e1"],"tip":"0x0","transaction_hash":"0x95280acfcefec838e570f1d25b6fb8108695fd7515d4a563c37947937bd88c","type":"INVOKE","version":"0x3"}},{"receipt":{"actual_fee":{"amount":"0x2fc1d34db5e5099","unit":"FRI"},"events":[{"data":["0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0x0","0x437","0x0","0x1164d1","0x2e0af29598b407c8716b17f6d2795eca1b471413fa03fb145a5e33722184067","0x1b23613","0x1","0x1b231dc","0x1","0x0","0x1","0x0","0x1"],"from_address":"0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","keys":["0x96982abd597114bdaa4a60612f87fabfcc7206aa12d61c50e7ba1e6c291100"]},{"data":["0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0x0","0x437","0x0","0x1164d1","0x2e0af29598b407c8716b17f6d2795eca1b471413fa03fb145a5e33722184067","0x1b23613","0x1","0x1b231dc","0x1","0x0","0x1","0x0","0x1"],"from_address":"0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","keys":["0x5dacf59794364ad1555bb3c9b2346afa81e57e5c19bb6bae0d22721c96c4e5"]},{"data":["0x2e0af29598b407c8716b17f6d2795eca1b471413fa03fb145a5e33722184067","0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0x0","0x437","0x0","0x1164d1","0x1b23613","0x1","0x1b231dc","0x1","0x94d14b69e0f8f8d7b","0x1","0x1d9ba366f5c509c7101f","0x1","0x0","0x0"],"from_address":"0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","keys":["0x3a7adca3546c213ce791fabf3b04090c163e419c808c9830fb343a4a395946e"]},{"data":["0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0x207c098face5cbb03f3a3a17f765ac54f8706730dd573a8e73c7496722a84ce","0x1d9ba366f5c509c7101f","0x0"],"from_address":"0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x207c098face5cbb03f3a3a17f765ac54f8706730dd573a8e73c7496722a84ce","0x64691fcf3e0421406c8ab1a8028bc05affbb16aecba4f0352f8d3d7a3386212","0x1164d1","0x0"],"from_address":"0x7b696af58c967c1b14c9dde0ace001720635a660a8e90c565ea459345318b30","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x207c098face5cbb03f3a3a17f765ac54f8706730dd573a8e73c7496722a84ce","0x2e0af29598b407c8716b17f6d2795eca1b471413fa03fb145a5e33722184067","0x1d9ba366f5c509c7123e","0x0"],"from_address":"0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x0","0x207c098face5cbb03f3a3a17f765ac54f8706730dd573a8e73c7496722a84ce","0x1164d6","0x0"],"from_address":"0x7b696af58c967c1b14c9dde0ace001720635a660a8e90c565ea459345318b30","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x2e0af29598b407c8716b17f6d2795eca1b471413fa03fb145a5e33722184067","0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0x0","0x437","0x0","0x1164d6","0x1b2cdcf","0x1","0x1b2c998","0x1","0x91f4822793c6005fd","0x0","0x1d9ba366f5c509c70f53","0x0","0x0","0x0"],"from_address":"0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","keys":["0x3a7adca3546c213ce791fabf3b04090c163e419c808c9830fb343a4a395946e"]},{"data":["0x2e0af29598b407c8716b17f6d2795eca1b471413fa03fb145a5e33722184067","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0x1d9ba366f5c509c70f53","0x0"],"from_address":"0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x2e0af29598b407c8716b17f6d2795eca1b471413fa03fb145a5e33722184067","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0x0","0x0"],"from_address":"0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x2e0af29598b407c8716b17f6d2795eca1b471413fa03fb145a5e33722184067","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0x1d9ba366f5c509c70f53","0x0"],"from_address":"0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x2e0af29598b407c8716b17f6d2795eca1b471413fa03fb145a5e33722184067","0x207c098face5cbb03f3a3a17f765ac54f8706730dd573a8e73c7496722a84ce","0x2eb","0x0"],"from_address":"0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x2","0x0","0x0"],"from_address":"0x7461e8a41459e52d5ca62e6faee68c8149d3d8e974ca120ed8cb752192b3f5a","keys":["0x1dcde06aabdbca2f80aa51392b345d7549d7757aa855f7e37f5d335ac8243b1","0x6f9a4c63ba946deb7ef3fc33902b10ba15ca26033bae2f4dffdbeb6e9c5b476"]},{"data":["0x7461e8a41459e52d5ca62e6faee68c8149d3d8e974ca120ed8cb752192b3f5a","0x1176a1bd84444c89232ec27754698e5d2e7e1a7f1539f12027f28b23ec9f3d8","0x2fc1d34db5e5099","0x0"],"from_address":"0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]}],"execution_resources":{"bitwise_builtin_applications":270,"data_availability":{"l1_data_gas":1344,"l1_gas":0},"ec_op_builtin_applications":3,"pedersen_builtin_applications":377,"poseidon_builtin_applications":22,"range_check_builtin_applications":8349,"steps":135599},"execution_status":"SUCCEEDED","finality_status":"ACCEPTED_ON_L2","messages_sent":[],"transaction_hash":"0x6f9a4c63ba946deb7ef3fc33902b10ba15ca26033bae2f4dffdbeb6e9c5b476","type":"INVOKE"},"transaction":{"account_deployment_data":[],"calldata":["0x2","0x207c098face5cbb03f3a3a17f765ac54f8706730dd573a8e73c7496722a84ce","0x1a923de6533f2e453af43975ee396cc2d327f663f482e8cca3f6969216f0a8a","0xb","0x1164d1","0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0x0","0x437","0x0","0x1b23613","0x1","0x1b231dc","0x1","0xffffffffffffffffffffffffffffffff","0x207c098face5cbb03f3a3a17f765ac54f8706730dd573a8e73c7496722a84ce","0x3213dc28053a4493534881eee7eede9e8f195710086079f1113c8ba5556c9cb","0xe","0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","0xffffffffffffffffffffffffffffffff","0x0","0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0x0","0x437","0x0","0x1b2cdcf","0x1","0x1b2c998","0x1","0x1","0x1"],"fee_data_availability_mode":"L1","nonce":"0xc04d","nonce_data_availability_mode":"L1","paymaster_data":[],"resource_bounds":{"l1_gas":{"max_amount":"0x21d","max_price_per_unit":"0x331534bb6483e"},"l2_gas":{"max_amount":"0x0","max_price_per_unit":"0x0"}},"sender_address":"0x7461e8a41459e52d5ca62e6faee68c8149d3d8e974ca120ed8cb752192b3f5a","signature":["0x509b71b00c1ec7db24af0379c8aba76da31bd9f1e6ff7d222f7778ada809b0","0x6b52791e1a549bb79f36096be16205875b33b260377416ba117d3a7bd279f9a"],"tip":"0x0","transaction_hash":"0x6f9a4c63ba946deb7ef3fc33902b10ba15ca26033bae2f4dffdbeb6e9c5b476","type":"INVOKE","version":"0x3"}},{"receipt":{"actual_fee":{"amount":"0xcfffb7e9f40","unit":"WEI"},"events":[{"data":["0x2deb747fb7b448dad3ebdd2e7fb96f002b3e5e6613e57f012cbbbbe7d7f8d7d","0xf6f4cf62e3c010e0ac2451cc7807b5eec19a40b0faacd00cca3914280fdf5a","0x4bd969ed","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x2deb747fb7b448dad3ebdd2e7fb96f002b3e5e6613e57f012cbbbbe7d7f8d7d","0xf6f4cf62e3c010e0ac2451cc7807b5eec19a40b0faacd00cca3914280fdf5a","0x0","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x2deb747fb7b448dad3ebdd2e7fb96f002b3e5e6613e57f012cbbbbe7d7f8d7d","0xf6f4cf62e3c010e0ac2451cc7807b5eec19a40b0faacd00cca3914280fdf5a","0x4bd969ed","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0xf6f4cf62e3c010e0ac2451cc7807b5eec19a40b0faacd00cca3914280fdf5a","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0x68f5c6a61780768455de69077e07e89787839bf8166decfbf92b645209c0fb8","0x14f8b588e368f1000000000000000","0x14","0x0","0x4bd969ed","0x0","0x0","0x1a551840d1324a7e89225d60074c4","0x0","0xffffffff","0x4bd969ed","0x0","0x4bec84be","0x1","0x20ded9b1f7671f29c36d553208413d","0x1","0x3ea","0x0","0x8641f2284ec41"],"from_address":"0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","keys":["0x157717768aca88da4ac4279765f09f4d0151823d573537fbbeb950cdbd9a870"]},{"data":["0xf6f4cf62e3c010e0ac2451cc7807b5eec19a40b0faacd00cca3914280fdf5a","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0x4bd969ed","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0xf6f4cf62e3c010e0ac2451cc7807b5eec19a40b0faacd00cca3914280fdf5a","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0x0","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0xf6f4cf62e3c010e0ac2451cc7807b5eec19a40b0faacd00cca3914280fdf5a","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0x4bd969ed","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0xf6f4cf62e3c010e0ac2451cc7807b5eec19a40b0faacd00cca3914280fdf5a","0x4bec84be","0x0"],"from_address":"0x68f5c6a61780768455de69077e07e89787839bf8166decfbf92b645209c0fb8","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0xf6f4cf62e3c010e0ac2451cc7807b5eec19a40b0faacd00cca3914280fdf5a","0xf4b0cf2251884df86cef28a0690321bea3e3fd7d9c6911790f50bbc2b0ebc3","0x3e326","0x0"],"from_address":"0x68f5c6a61780768455de69077e07e89787839bf8166decfbf92b645209c0fb8","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0xf6f4cf62e3c010e0ac2451cc7807b5eec19a40b0faacd00cca3914280fdf5a","0x2deb747fb7b448dad3ebdd2e7fb96f002b3e5e6613e57f012cbbbbe7d7f8d7d","0x4be8a198","0x0"],"from_address":"0x68f5c6a61780768455de69077e07e89787839bf8166decfbf92b645209c0fb8","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x2deb747fb7b448dad3ebdd2e7fb96f002b3e5e6613e57f012cbbbbe7d7f8d7d","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0x68f5c6a61780768455de69077e07e89787839bf8166decfbf92b645209c0fb8","0x4bd969ed","0x0","0x4be8a198","0x0","0x2deb747fb7b448dad3ebdd2e7fb96f002b3e5e6613e57f012cbbbbe7d7f8d7d"],"from_address":"0xf6f4cf62e3c010e0ac2451cc7807b5eec19a40b0faacd00cca3914280fdf5a","keys":["0xe316f0d9d2a3affa97de1d99bb2aac0538e2666d0d8545545ead241ef0ccab"]},{"data":["0x2","0x1","0x1","0x0"],"from_address":"0x2deb747fb7b448dad3ebdd2e7fb96f002b3e5e6613e57f012cbbbbe7d7f8d7d","keys":["0x1dcde06aabdbca2f80aa51392b345d7549d7757aa855f7e37f5d335ac8243b1","0x7117979c1e3c15a312d493da37812ba37631c7309115a93fb2122909e4ddb6c"]},{"data":["0x2deb747fb7b448dad3ebdd2e7fb96f002b3e5e6613e57f012cbbbbe7d7f8d7d","0x1176a1bd84444c89232ec27754698e5d2e7e1a7f1539f12027f28b23ec9f3d8","0xcfffb7e9f40","0x0"],"from_address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]}],"execution_resources":{"bitwise_builtin_applications":56,"data_availability":{"l1_data_gas":768,"l1_gas":0},"ec_op_builtin_applications":3,"pedersen_builtin_applications":113,"poseidon_builtin_applications":13,"range_check_builtin_applications":3307,"steps":70111},"execution_status":"SUCCEEDED","finality_status":"ACCEPTED_ON_L2","messages_sent":[],"transaction_hash":"0x7117979c1e3c15a312d493da37812ba37631c7309115a93fb2122909e4ddb6c","type":"INVOKE"},"transaction":{"calldata":["0x2","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0x219209e083275171774dab1df80982e9df2096516f06319c5c6d71ae0a8480c","0x3","0xf6f4cf62e3c010e0ac2451cc7807b5eec19a40b0faacd00cca3914280fdf5a","0x4bd969ed","0x0","0xf6f4cf62e3c010e0ac2451cc7807b5eec19a40b0faacd00cca3914280fdf5a","0x15543c3708653cda9d418b4ccd3be11368e40636c10c44b18cfe756b6d88b29","0x15","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0x68f5c6a61780768455de69077e07e89787839bf8166decfbf92b645209c0fb8","0x4bd969ed","0x0","0x4b2a28b6","0x0","0x2deb747fb7b448dad3ebdd2e7fb96f002b3e5e6613e57f012cbbbbe7d7f8d7d","0x1","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0x68f5c6a61780768455de69077e07e89787839bf8166decfbf92b645209c0fb8","0xf4240","0x5","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0x7","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0x68f5c6a61780768455de69077e07e89787839bf8166decfbf92b645209c0fb8","0x14f8b588e368f1000000000000000","0x14","0x0","0x1a551840d1324a7e89225d60074c4","0x0"],"max_fee":"0x262ff1d60df5","nonce":"0x39","sender_address":"0x2deb747fb7b448dad3ebdd2e7fb96f002b3e5e6613e57f012cbbbbe7d7f8d7d","signature":["0x1","0x0","0x142617fcfdcb59023d5951fdcfdda8d86de45b270f2ddfe6aed658bd2336991","0x3b6d08c94973f16f2959796440eabebd332394fe45a62a7c84ba529b39e8436","0x48eca281d413b7634f3067ff90afdfc111b66222670eaf67ca1556155fa2423"],"transaction_hash":"0x7117979c1e3c15a312d493da37812ba37631c7309115a93fb2122909e4ddb6c","type":"INVOKE","version":"0x1"}},{"receipt":{"actual_fee":{"amount":"0x44c68cffec27efb","unit":"FRI"},"events":[{"data":["0x7643558ff4a63a108831ba22dbe5303c0b8dc506666ad991fb85008dcc4a3f","0x199741822c2dc722f6f605204f35e56dbc23bceed54818168c4c49e4fb8737e","0xbebc200","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x199741822c2dc722f6f605204f35e56dbc23bceed54818168c4c49e4fb8737e","0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0x20c49ba5e353f80000000000000000","0x3e8","0x0","0xa6e49c0","0x0","0x1","0xc46cf722ef8e1c822f8b3fd83b6","0x0","0x0","0x16c338a88e4f0f109e","0x1","0xa6e49c0","0x0","0xad422577b616ab63f4c63e5123d","0x0","0x1b2fa41","0x1","0x37f5b4bbee17d9ba"],"from_address":"0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","keys":["0x157717768aca88da4ac4279765f09f4d0151823d573537fbbeb950cdbd9a870"]},{"data":["0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0x199741822c2dc722f6f605204f35e56dbc23bceed54818168c4c49e4fb8737e","0x16c338a88e4f0f109e","0x0"],"from_address":"0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x199741822c2dc722f6f605204f35e56dbc23bceed54818168c4c49e4fb8737e","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0xa6e49c0","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x199741822c2dc722f6f605204f35e56dbc23bceed54818168c4c49e4fb8737e","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0x0","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x199741822c2dc722f6f605204f35e56dbc23bceed54818168c4c49e4fb8737e","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0xa6e49c0","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x199741822c2dc722f6f605204f35e56dbc23bceed54818168c4c49e4fb8737e","0xda114221cb83fa859dbdb4c44beeaa0bb37c7537ad5ae66fe5e0efd20e6eb3","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0xc49ba5e353f7d00000000000000000","0x175e","0x0","0xbebc20","0x0","0x1","0x234dc84268f9e7803148e2490455","0x0","0x0","0xaf72fab226b853cc","0x1","0xbebc20","0x0","0x10a9fc4263a5da7aca9d3c931b97","0x0","0x1a5d2a1","0x1","0x38289003010578"],"from_address":"0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","keys":["0x157717768aca88da4ac4279765f09f4d0151823d573537fbbeb950cdbd9a870"]},{"data":["0x199741822c2dc722f6f605204f35e56dbc23bceed54818168c4c49e4fb8737e","0xda114221cb83fa859dbdb4c44beeaa0bb37c7537ad5ae66fe5e0efd20e6eb3","0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","0x20c49ba5e353f80000000000000000","0x3e8","0x0","0xaf72fab226b853cc","0x0","0x0","0x3e0e5d80794f646740e50c61a7c780b","0x0","0x4","0xaf72fab226b853cc","0x0","0xd45eb9af5c627","0x1","0x465a9cb426ba5315a8fef3c4f2882ab","0x0","0x7c07cb","0x1","0x56e03359f4d0fe3b4"],"from_address":"0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","keys":["0x157717768aca88da4ac4279765f09f4d0151823d573537fbbeb950cdbd9a870"]},{"data":["0x199741822c2dc722f6f605204f35e56dbc23bceed54818168c4c49e4fb8737e","0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","0x20c49ba5e353f80000000000000000","0x3e8","0x0","0xd45eb9af5c627","0x0","0x1","0x33a9b74b31bfdec21e2e77adcc85423","0x0","0x0","0x1a27382d577b10da5","0x1","0xd45eb9af5c627","0x0","0x2d9527ca390e6a8fcdca8cbca947326","0x0","0x894684","0x1","0x3f55f028ad1b2013f78"],"from_address":"0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","keys":["0x157717768aca88da4ac4279765f09f4d0151823d573537fbbeb950cdbd9a870"]},{"data":["0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0x199741822c2dc722f6f605204f35e56dbc23bceed54818168c4c49e4fb8737e","0x1a27382d577b10da5","0x0"],"from_address":"0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x199741822c2dc722f6f605204f35e56dbc23bceed54818168c4c49e4fb8737e","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0xbebc20","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x199741822c2dc722f6f605204f35e56dbc23bceed54818168c4c49e4fb8737e","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0x0","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x199741822c2dc722f6f605204f35e56dbc23bceed54818168c4c49e4fb8737e","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0xbebc20","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x199741822c2dc722f6f605204f35e56dbc23bceed54818168c4c49e4fb8737e","0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0x68db8bac710cb4000000000000000","0xc8","0x0","0xbebc20","0x0","0x1","0xb1e51fabd8fee861e7876552f15","0x0","0x1d","0x1a0804be42d844049","0x1","0xbebc20","0x0","0xad7c44baaf28ac6fc45bb2a3673","0x0","0x1b2f006","0x1","0x176d01545cbd22"],"from_address":"0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","keys":["0x157717768aca88da4ac4279765f09f4d0151823d573537fbbeb950cdbd9a870"]},{"data":["0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0x199741822c2dc722f6f605204f35e56dbc23bceed54818168c4c49e4fb8737e","0x1a0804be42d844049","0x0"],"from_address":"0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x199741822c2dc722f6f605204f35e56dbc23bceed54818168c4c49e4fb8737e","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0xbebc20","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x199741822c2dc722f6f605204f35e56dbc23bceed54818168c4c49e4fb8737e","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0x0","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x199741822c2dc722f6f605204f35e56dbc23bceed54818168c4c49e4fb8737e","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0xbebc20","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x199741822c2dc722f6f605204f35e56dbc23bceed54818168c4c49e4fb8737e","0x7643558ff4a63a108831ba22dbe5303c0b8dc506666ad991fb85008dcc4a3f","0x1a062c7747f4445e8c","0x0"],"from_address":"0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x7643558ff4a63a108831ba22dbe5303c0b8dc506666ad991fb85008dcc4a3f","0x1176a1bd84444c89232ec27754698e5d2e7e1a7f1539f12027f28b23ec9f3d8","0x44c68cffec27efb","0x0"],"from_address":"0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]}],"execution_resources":{"bitwise_builtin_applications":195,"data_availability":{"l1_data_gas":1088,"l1_gas":0},"ec_op_builtin_applications":3,"pedersen_builtin_applications":283,"poseidon_builtin_applications":18,"range_check_builtin_applications":12052,"steps":162645},"execution_status":"SUCCEEDED","finality_status":"ACCEPTED_ON_L2","messages_sent":[],"transaction_hash":"0x50c02747fdb0bde131fddf2f20955f74899bc8b27821a9b233a0ca6cc9f862e","type":"INVOKE"},"transaction":{"account_deployment_data":[],"calldata":["0x3","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0x83afd3f4caedc6eebf44246fe54e38c95e3179a5ec9ea81740eca5b482d12e","0x3","0x199741822c2dc722f6f605204f35e56dbc23bceed54818168c4c49e4fb8737e","0xbebc200","0x0","0x199741822c2dc722f6f605204f35e56dbc23bceed54818168c4c49e4fb8737e","0x33076126f032617037f0b2fe939518f69cc8b9e9689b6ab065bb612662c53fc","0x35","0x3","0x1","0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0x20c49ba5e353f80000000000000000","0x3e8","0x0","0xc46cf722ef8e1c822f8b3fd83b6","0x0","0x0","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0xa6e49c0","0x0","0x3","0xda114221cb83fa859dbdb4c44beeaa0bb37c7537ad5ae66fe5e0efd20e6eb3","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0xc49ba5e353f7d00000000000000000","0x175e","0x0","0x234dc84268f9e7803148e2490455","0x0","0x0","0xda114221cb83fa859dbdb4c44beeaa0bb37c7537ad5ae66fe5e0efd20e6eb3","0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","0x20c49ba5e353f80000000000000000","0x3e8","0x0","0x3e0e5d80794f646740e50c61a7c780b","0x0","0x4","0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","0x20c49ba5e353f80000000000000000","0x3e8","0x0","0x33a9b74b31bfdec21e2e77adcc85423","0x0","0x0","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0xbebc20","0x0","0x1","0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0x68db8bac710cb4000000000000000","0xc8","0x0","0xb1e51fabd8fee861e7876552f15","0x0","0x1d","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0xbebc20","0x0","0x199741822c2dc722f6f605204f35e56dbc23bceed54818168c4c49e4fb8737e","0x2e1d93dafae32660a4a76a0fd6f31550f3ddfd6a51c29ef2e055b80afbbd011","0x3","0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","0x1a051bcd89483fa65c","0x0"],"fee_data_availability_mode":"L1","nonce":"0x3f48","nonce_data_availability_mode":"L1","paymaster_data":[],"resource_bounds":{"l1_gas":{"max_amount":"0x2ef","max_price_per_unit":"0x331534bb6483e"},"l2_gas":{"max_amount":"0x0","max_price_per_unit":"0x0"}},"sender_address":"0x7643558ff4a63a108831ba22dbe5303c0b8dc506666ad991fb85008dcc4a3f","signature":["0x207163308ca74d0f425107cb2d1050124eec597fc4a581eeccf323b004a3f0f","0x40c1f4dee42e81cbd9a310ff21e6b7116c1fb579f26cce1b65fbad7de4a2805"],"tip":"0x0","transaction_hash":"0x50c02747fdb0bde131fddf2f20955f74899bc8b27821a9b233a0ca6cc9f862e","type":"INVOKE","version":"0x3"}},{"receipt":{"actual_fee":{"amount":"0x1e554ad2b44","unit":"WEI"},"events":[{"data":["0x32986f8a2778b5210c0710ff1828f32ad6abec19c1dae0759816e6ec7b98391","0x1bf2561496c7b0318977731103be4aed56b570b292168bbb20032c5d6cb4b35","0x12e97cf635ca84","0x0"],"from_address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x32986f8a2778b5210c0710ff1828f32ad6abec19c1dae0759816e6ec7b98391","0x1176a1bd84444c89232ec27754698e5d2e7e1a7f1539f12027f28b23ec9f3d8","0x1e554ad2b44","0x0"],"from_address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]}],"execution_resources":{"data_availability":{"l1_data_gas":192,"l1_gas":0},"ec_op_builtin_applications":3,"pedersen_builtin_applications":25,"poseidon_builtin_applications":4,"range_check_builtin_applications":303,"steps":10658},"execution_status":"SUCCEEDED","finality_status":"ACCEPTED_ON_L2","messages_sent":[],"transaction_hash":"0x157b16c30c984133dd0965e2d27bd334f68f775c7e9192a1ffa5bf7f9d055ee","type":"INVOKE"},"transaction":{"calldata":["0x1","0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","0x83afd3f4caedc6eebf44246fe54e38c95e3179a5ec9ea81740eca5b482d12e","0x3","0x1bf2561496c7b0318977731103be4aed56b570b292168bbb20032c5d6cb4b35","0x12e97cf635ca84","0x0"],"max_fee":"0x2bdff0ca29b","nonce":"0x10","sender_address":"0x32986f8a2778b5210c0710ff1828f32ad6abec19c1dae0759816e6ec7b98391","signature":["0x1","0x3b17a893d144872bc6dcc124dace1e5fc416b23305845beb6e0251d32a5c22c","0x68674917ae0dd80d40dc6e42fb2643acbc3563e0d28bfdb15f57b44b71b7a9b"],"transaction_hash":"0x157b16c30c984133dd0965e2d27bd334f68f775c7e9192a1ffa5bf7f9d055ee","type":"INVOKE","version":"0x1"}},{"receipt":{"actual_fee":{"amount":"0xb1aa6d1a87c","unit":"WEI"},"events":[{"data":["0x5c3b2945bde13eb9f8905dc12788f0723fb718f5b13b7ccb9947f234fb295dd","0x2e0af29598b407c8716b17f6d2795eca1b471413fa03fb145a5e33722184067","0x9e53289000","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x0","0x5c3b2945bde13eb9f8905dc12788f0723fb718f5b13b7ccb9947f234fb295dd","0x1164d7","0x0"],"from_address":"0x7b696af58c967c1b14c9dde0ace001720635a660a8e90c565ea459345318b30","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x2e0af29598b407c8716b17f6d2795eca1b471413fa03fb145a5e33722184067","0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0x0","0x404","0x0","0x1164d7","0x1b34190","0x1","0x1b33d8c","0x1","0x701ad8721f9ccb4e55","0x0","0x0","0x0","0x9e53289000","0x0"],"from_address":"0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","keys":["0x3a7adca3546c213ce791fabf3b04090c163e419c808c9830fb343a4a395946e"]},{"data":["0x2e0af29598b407c8716b17f6d2795eca1b471413fa03fb145a5e33722184067","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0x9e53289000","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x2e0af29598b407c8716b17f6d2795eca1b471413fa03fb145a5e33722184067","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0x0","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x2e0af29598b407c8716b17f6d2795eca1b471413fa03fb145a5e33722184067","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0x9e53289000","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x3","0x1","0x1","0x2","0x1164d7","0x701ad8721f9ccb4e55","0x2","0x0","0x0"],"from_address":"0x5c3b2945bde13eb9f8905dc12788f0723fb718f5b13b7ccb9947f234fb295dd","keys":["0x1dcde06aabdbca2f80aa51392b345d7549d7757aa855f7e37f5d335ac8243b1","0x5057d74fc20c125d9ee312cab3e04f19d43cca93ec1bfb1ab16bcfab450b7b9"]},{"data":["0x5c3b2945bde13eb9f8905dc12788f0723fb718f5b13b7ccb9947f234fb295dd","0x1176a1bd84444c89232ec27754698e5d2e7e1a7f1539f12027f28b23ec9f3d8","0xb1aa6d1a87c","0x0"],"from_address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]}],"execution_resources":{"bitwise_builtin_applications":108,"data_availability":{"l1_data_gas":1024,"l1_gas":0},"ec_op_builtin_applications":3,"pedersen_builtin_applications":169,"poseidon_builtin_applications":17,"range_check_builtin_applications":3417,"steps":61577},"execution_status":"SUCCEEDED","finality_status":"ACCEPTED_ON_L2","messages_sent":[],"transaction_hash":"0x5057d74fc20c125d9ee312cab3e04f19d43cca93ec1bfb1ab16bcfab450b7b9","type":"INVOKE"},"transaction":{"calldata":["0x3","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0x83afd3f4caedc6eebf44246fe54e38c95e3179a5ec9ea81740eca5b482d12e","0x3","0x2e0af29598b407c8716b17f6d2795eca1b471413fa03fb145a5e33722184067","0x9e53289000","0x0","0x2e0af29598b407c8716b17f6d2795eca1b471413fa03fb145a5e33722184067","0x38c3244e92da3bec5e017783c62779e3fd5d13827570dc093ab2a55f16d41b9","0xa","0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0x0","0x404","0x0","0x1b34190","0x1","0x1b33d8c","0x1","0x1","0x2e0af29598b407c8716b17f6d2795eca1b471413fa03fb145a5e33722184067","0x292f3f4df7749c2ae1fdc3379303c2e6caa9bbc3033ee67709fde5b77f65836","0x1","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8"],"max_fee":"0x1059fa55871a","nonce":"0x8528","sender_address":"0x5c3b2945bde13eb9f8905dc12788f0723fb718f5b13b7ccb9947f234fb295dd","signature":["0x276267d603a2dd8075d39a837976382fccddfa20d8598cc9de33f1c8f3c54c7","0x6010df17c45233d3027e3695a99d317dfc9cd548b5ee427d078295df2179a87"],"transaction_hash":"0x5057d74fc20c125d9ee312cab3e04f19d43cca93ec1bfb1ab16bcfab450b7b9","type":"INVOKE","version":"0x1"}},{"receipt":{"actual_fee":{"amount":"0x7d9421a68507c5","unit":"FRI"},"events":[{"data":["0x387f3eb1d98632fbe3440a9f1385aec9d87b6172491d3dd81f1c35a7c61048f","0x38a75430e3ff80d92c7f56180c778ba1540aac0c2650d4e394f5adfff5a550e","0x16b0fba884defa0","0x0"],"from_address":"0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x38a75430e3ff80d92c7f56180c778ba1540aac0c2650d4e394f5adfff5a550e","0xc23aff6fc82b1fc"],"from_address":"0x387f3eb1d98632fbe3440a9f1385aec9d87b6172491d3dd81f1c35a7c61048f","keys":["0x35cc0235f835cc84da50813dc84eb10a75e24a21d74d6d86278c0f037cb7429"]},{"data":["0x1","0x0"],"from_address":"0x38a75430e3ff80d92c7f56180c778ba1540aac0c2650d4e394f5adfff5a550e","keys":["0x1dcde06aabdbca2f80aa51392b345d7549d7757aa855f7e37f5d335ac8243b1","0x2889a2888186f99d45d5663dc8ca0afb82a34cdfc8a545409f6fdbe43c7f895"]},{"data":["0x38a75430e3ff80d92c7f56180c778ba1540aac0c2650d4e394f5adfff5a550e","0x1176a1bd84444c89232ec27754698e5d2e7e1a7f1539f12027f28b23ec9f3d8","0x7d9421a68507c5","0x0"],"from_address":"0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]}],"execution_resources":{"data_availability":{"l1_data_gas":320,"l1_gas":0},"ec_op_builtin_applications":6,"pedersen_builtin_applications":85,"poseidon_builtin_applications":7,"range_check_builtin_applications":819,"steps":21409},"execution_status":"SUCCEEDED","finality_status":"ACCEPTED_ON_L2","messages_sent":[],"transaction_hash":"0x2889a2888186f99d45d5663dc8ca0afb82a34cdfc8a545409f6fdbe43c7f895","type":"INVOKE"},"transaction":{"account_deployment_data":[],"calldata":["0x1","0x387f3eb1d98632fbe3440a9f1385aec9d87b6172491d3dd81f1c35a7c61048f","0xb758361d5e84380ef1e632f89d8e76a8677dbc3f4b93a4f9d75d2a6048f312","0xf","0xc23aff6fc82b1fc","0xd","0x508d24726d3a7bb5e3c474e7329b7a6466fed263d7d02df6f196e5b6ad0349f","0x628d395bfaafe339439800eaeaf8073bf59c8d54a715bd61c41845a73c94bf6","0x5ab7142c4a5027144756977c600867ff8a3ef1f3a0c2969611304347033e16f","0x1c09e933766c71c15e9bfc2968f51ed8145f4da5d76da481cf6d48fd59a602d","0x1ac03f92721912bc42f265abc3d540d43a984615f11a198b86efc3d93f515e4","0x2ce28a1ec8dcc9a523a6b6f1564949fd93edbe0272a4b59540624a029f0db0e","0x18d76537abbbbf7e1e89bb2ccbd814e778595bd10deb5967ccd31399512e169","0x6c6d779965d2411e76f9820051352a4ef8baaaf83baa3bc55c906cd91a6d99a","0x72ee984f148bcba4afcea3049175e3b49736a15e703f307f4f1e8d86fa990b0","0x6716e60e978f1cc7e26b8765a28320c2bcbe27b46f8848a76adc62f669542f","0x666561bd8df8f6b14b8157e3fb134281d19d0ab762aa59b640690f2b995202d","0x6357c3a8269d9ad5cf606bcb257a3379315cb07057305ceea0f246ee1d17526","0x36c5a93721b8add385aca8a1badbabcfcaa683dad789c203e2a6bef03756a7a"],"fee_data_availability_mode":"L1","nonce":"0x6d","nonce_data_availability_mode":"L1","paymaster_data":[],"resource_bounds":{"l1_gas":{"max_amount":"0xa1","max_price_per_unit":"0x331534bb513ca"},"l2_gas":{"max_amount":"0x0","max_price_per_unit":"0x0"}},"sender_address":"0x38a75430e3ff80d92c7f56180c778ba1540aac0c2650d4e394f5adfff5a550e","signature":["0x2","0x0","0x1e1fc5b2e51f4a49f496e429edcc17584addef06f20094e1ac76ecd2e051368","0x2a66af8d2f6e7dba9307cedb8ce014a2e087201a9e22b47af07452b0746cfd5","0xd9300f009c3bf0c576164ccd4cca2e00befc325b7e25f9f0d45e88019b7c46","0x0","0x17339db94034a8c23c553d897d6213eb6016b508243a1efecddb326ecb5d958","0x4c0ae31a68b7c8734c7e9388d5d06a9e8282dace2a7c2cac07e3195501998a3","0x34df2adf45afd4dde1056df50fec6d8f6b7106fc0876f3bbfe895cbae8800c7"],"tip":"0x0","transaction_hash":"0x2889a2888186f99d45d5663dc8ca0afb82a34cdfc8a545409f6fdbe43c7f895","type":"INVOKE","version":"0x3"}},{"receipt":{"actual_fee":{"amount":"0x61e8a519f57652","unit":"FRI"},"events":[{"data":["0x27c1fd4","0x678d10c3","0x108060103050007020904000000000000000000000000000000000000000000","0xa","0x27a38a4","0x27b2c3c","0x27bff40","0x27bff40","0x27bff40","0x27c1fd4","0x27c1fd4","0x27c2535","0x27c2535","0x27c8b0b","0x0","0x220e23279857f","0x40c03b6f80be73e1125f79855b79add29dbfc41d69bc79d8bb8b8e12dffc7","0x1f43b05","0x0"],"from_address":"0x1b14326182638866e10d804d7a9e9fd51a522c8ac59ab9b1b11975d21fae9c7","keys":["0x19e22f866f4c5aead2809bf160d2b29e921e335d899979732101c6f3c38ff81","0x2b842","0x3a47e23f7a9bf0fb7345e9efaec5cd1e0c1dde13188f7fed6d4db801c8d6451"]},{"data":["0x3a47e23f7a9bf0fb7345e9efaec5cd1e0c1dde13188f7fed6d4db801c8d6451","0x1176a1bd84444c89232ec27754698e5d2e7e1a7f1539f12027f28b23ec9f3d8","0x61e8a519f57652","0x0"],"from_address":"0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]}],"execution_resources":{"bitwise_builtin_applications":4,"data_availability":{"l1_data_gas":576,"l1_gas":0},"ec_op_builtin_applications":15,"pedersen_builtin_applications":75,"poseidon_builtin_applications":10,"range_check_builtin_applications":924,"steps":14656},"execution_status":"SUCCEEDED","finality_status":"ACCEPTED_ON_L2","messages_sent":[],"transaction_hash":"0x41f465f8fbcfd7bf5a1016bc73884fd20430d57487da88879264804c3d51b59","type":"INVOKE"},"transaction":{"account_deployment_data":[],"calldata":["0x1","0x1b14326182638866e10d804d7a9e9fd51a522c8ac59ab9b1b11975d21fae9c7","0xc844fd57777b0cd7e75c8ea68deec0adf964a6308da7a58de32364b7131cc8","0x1f","0x40c03b6f80be73e1125f79855b79add29dbfc41d69bc79d8bb8b8e12dffc7","0x1f43b05","0x3ed45b496f6fe49556bded26720c8b354404713b82c953135610b484b774a","0x678d10c3","0x108060103050007020904000000000000000000000000000000000000000000","0xa","0x27a38a4","0x27b2c3c","0x27bff40","0x27bff40","0x27bff40","0x27c1fd4","0x27c1fd4","0x27c2535","0x27c2535","0x27c8b0b","0x0","0x220e23279857f","0x4","0x1a423a4758de13cf22f7373c814bc393efde362b7b82a42666e8bb80465e80e","0x1834eb53e9c650c6333066fb9c69c37c34f4c83cb53cca36a167ca920ed0f66","0x57c434cdce662d0a7cd18d954b9d78079877f77b5f53d0d4e8a739b4b907d9c","0x1862764b0b761166a86ed7701ee24a80c9a620d9ec224136afc1e5d5e000e87","0x2211a5d8bf127b6b9231670070401994807f014e116595b4d0057e72d1cc4e6","0x1990de710d1759dd2f54eb3b9bf781c57cb0d62de582b03c221e4b0dc005462","0x1a2d704edc1a1c98969ee5950007856e6f6c1297c4e873371725cf5c2ac3b5e","0x3398f6127266cc37f2ab637a0e2bb1145a708eb2dcaaa97bef56606afa8653a","0x114f59449ca6fa7ec806ed937680ea9f2f3a4f9281a4df2c8cc56b46e01a8e2","0x20b22950917f4f411174328a398f66fad9bd30d60d38727fe1bb42629f5e6cc","0x3017bb5ad390d704ff1325677fdbb34749c4113833791b9c18cbea2eff35dc7","0x2182032255f72942833448a8ac3c57ca289c2dfd33328e93a2fed3e8831d842"],"fee_data_availability_mode":"L1","nonce":"0x1d066","nonce_data_availability_mode":"L1","paymaster_data":[],"resource_bounds":{"l1_gas":{"max_amount":"0x42","max_price_per_unit":"0x331534bb6483e"},"l2_gas":{"max_amount":"0x0","max_price_per_unit":"0x0"}},"sender_address":"0x3a47e23f7a9bf0fb7345e9efaec5cd1e0c1dde13188f7fed6d4db801c8d6451","signature":["0x3fa6f893bad5d64134153f7e1acf20b8c0deecc04f6e1bed352791d9f816ac9","0x2c224d86e5c483747c2e563e8ef763c77e818f46ebadbb35f95c27c357c2b78"],"tip":"0x0","transaction_hash":"0x41f465f8fbcfd7bf5a1016bc73884fd20430d57487da88879264804c3d51b59","type":"INVOKE","version":"0x3"}},{"receipt":{"actual_fee":{"amount":"0xfc6a5336adf","unit":"WEI"},"events":[{"data":["0x9035556d1ee136e7722ae4e78f92828553a45eed3bc9b2aba90788ec2ca112","0xca1702e64c81d9a07b86bd2c540188d92a2c73cf5cc0e508d949015e7e84a7","0x1367f00e0b7ea1cc6a","0x0"],"from_address":"0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0xca1702e64c81d9a07b86bd2c540188d92a2c73cf5cc0e508d949015e7e84a7","0x2cb02c72e8a0975e69e88298443e984d965a49eab38f5bdde1f5072daa09cfe","0x1367f00e0b7ea1cc6a","0x0"],"from_address":"0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x1367f00e0b7ea1cc6a"],"from_address":"0xca1702e64c81d9a07b86bd2c540188d92a2c73cf5cc0e508d949015e7e84a7","keys":["0x99f25da3a1ac61dd57efe764c2d59fe1c7a46f1af83f02cacb02788604e458","0xd3b910d8c528bf0216866053c3821ac6c97983dc096bff642e9a3549210ee7","0x2cb02c72e8a0975e69e88298443e984d965a49eab38f5bdde1f5072daa09cfe"]},{"data":["0x0","0x410ddf425143124f51"],"from_address":"0xca1702e64c81d9a07b86bd2c540188d92a2c73cf5cc0e508d949015e7e84a7","keys":["0x5e613d40c10afb6d99af7640e6135b427f0032f62c06b2a7d7d222743f9794","0xd3b910d8c528bf0216866053c3821ac6c97983dc096bff642e9a3549210ee7","0x2cb02c72e8a0975e69e88298443e984d965a49eab38f5bdde1f5072daa09cfe","0x54222f8775e4b5d21470f8ef4806db16f7bfcdceddc4c9efa2e6e377b348878"]},{"data":["0x175a7be10a64c27440000","0x22ad4e266d38ae6800dd68","0x175a7be10a64c27440000","0x22ad0d188df65d24ee8e17"],"from_address":"0xca1702e64c81d9a07b86bd2c540188d92a2c73cf5cc0e508d949015e7e84a7","keys":["0xf50c8912e3b0f3100f29764ee3f9d7bf03d10292b5f44832090fa7666b9ab9","0xd3b910d8c528bf0216866053c3821ac6c97983dc096bff642e9a3549210ee7"]},{"data":["0x67a8c045","0x410ddf425143124f51"],"from_address":"0x2cb02c72e8a0975e69e88298443e984d965a49eab38f5bdde1f5072daa09cfe","keys":["0x2e309d31f742f40d496b60a19924887d14030b501c6e3cbeee4527ed7afb66","0x54222f8775e4b5d21470f8ef4806db16f7bfcdceddc4c9efa2e6e377b348878"]},{"data":["0x410ddf425143124f51","0x0"],"from_address":"0x2cb02c72e8a0975e69e88298443e984d965a49eab38f5bdde1f5072daa09cfe","keys":["0x3b0fee275c2f63e42b158a5cc9b25763eae5e381838169c0d71894c17dd28f7","0x54222f8775e4b5d21470f8ef4806db16f7bfcdceddc4c9efa2e6e377b348878"]},{"data":["0x2cb02c72e8a0975e69e88298443e984d965a49eab38f5bdde1f5072daa09cfe","0x54222f8775e4b5d21470f8ef4806db16f7bfcdceddc4c9efa2e6e377b348878","0x311a75ef4c12760c","0x0"],"from_address":"0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x311a75ef4c12760c"],"from_address":"0x2cb02c72e8a0975e69e88298443e984d965a49eab38f5bdde1f5072daa09cfe","keys":["0xc4a5eb3afec3e38cbe8f43f66c46bb0ca74ae6f10bfbd7c7f0f461d5cdb9f4","0x54222f8775e4b5d21470f8ef4806db16f7bfcdceddc4c9efa2e6e377b348878","0x54222f8775e4b5d21470f8ef4806db16f7bfcdceddc4c9efa2e6e377b348878"]},{"data":["0x54222f8775e4b5d21470f8ef4806db16f7bfcdceddc4c9efa2e6e377b348878"],"from_address":"0x2cb02c72e8a0975e69e88298443e984d965a49eab38f5bdde1f5072daa09cfe","keys":["0x79af28f3063daeac2460243a72f25ed5d967133a3fb0077e045687076c531c","0x54222f8775e4b5d21470f8ef4806db16f7bfcdceddc4c9efa2e6e377b348878"]},{"data":["0x9035556d1ee136e7722ae4e78f92828553a45eed3bc9b2aba90788ec2ca112","0xca1702e64c81d9a07b86bd2c540188d92a2c73cf5cc0e508d949015e7e84a7","0x24d7db345aa02e0a1","0x0"],"from_address":"0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0xca1702e64c81d9a07b86bd2c540188d92a2c73cf5cc0e508d949015e7e84a7","0x3bd73e92891c26838a68379966fb0f23837aed7369d620f0fcc5d36ac44bd60","0x24d7db345aa02e0a1","0x0"],"from_address":"0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x24d7db345aa02e0a1"],"from_address":"0xca1702e64c81d9a07b86bd2c540188d92a2c73cf5cc0e508d949015e7e84a7","keys":["0x99f25da3a1ac61dd57efe764c2d59fe1c7a46f1af83f02cacb02788604e458","0x64204710398bbd2a3af22b9335f4d75234f16e5badb5210b686232f2333d9f1","0x3bd73e92891c26838a68379966fb0f23837aed7369d620f0fcc5d36ac44bd60"]},{"data":["0x0","0x410ddf425143124f51"],"from_address":"0x3bd73e92891c26838a68379966fb0f23837aed7369d620f0fcc5d36ac44bd60","keys":["0x3b0fee275c2f63e42b158a5cc9b25763eae5e381838169c0d71894c17dd28f7","0x54222f8775e4b5d21470f8ef4806db16f7bfcdceddc4c9efa2e6e377b348878"]},{"data":["0x4b0c0fffc4536580000","0x454cfe5b77b28adc7e839","0x4b0c0fffc4536580000","0x45510f396bd79f0da378a"],"from_address":"0xca1702e64c81d9a07b86bd2c540188d92a2c73cf5cc0e508d949015e7e84a7","keys":["0xf50c8912e3b0f3100f29764ee3f9d7bf03d10292b5f44832090fa7666b9ab9","0x64204710398bbd2a3af22b9335f4d75234f16e5badb5210b686232f2333d9f1"]},{"data":["0x410ddf425143124f51","0x0"],"from_address":"0xca1702e64c81d9a07b86bd2c540188d92a2c73cf5cc0e508d949015e7e84a7","keys":["0x180e22390470d861f6323121cbc051206aa4dd5ddcf6334dfe7b0ee0ddb8d38","0x2cb02c72e8a0975e69e88298443e984d965a49eab38f5bdde1f5072daa09cfe","0x54222f8775e4b5d21470f8ef4806db16f7bfcdceddc4c9efa2e6e377b348878"]},{"data":["0x410ddf425143124f51"],"from_address":"0x2cb02c72e8a0975e69e88298443e984d965a49eab38f5bdde1f5072daa09cfe","keys":["0x19089fae3b8f99c2b140d1ead731331b19fb248d09b6ee5becb097b10409306","0x54222f8775e4b5d21470f8ef4806db16f7bfcdceddc4c9efa2e6e377b348878","0x3bd73e92891c26838a68379966fb0f23837aed7369d620f0fcc5d36ac44bd60"]},{"data":["0x2","0x0","0x1","0x0"],"from_address":"0x54222f8775e4b5d21470f8ef4806db16f7bfcdceddc4c9efa2e6e377b348878","keys":["0x1dcde06aabdbca2f80aa51392b345d7549d7757aa855f7e37f5d335ac8243b1","0x7760c7814433a55fc46ea4aabed4bf2f7de1e81c3b448284088b65d62eb1c03"]},{"data":["0x54222f8775e4b5d21470f8ef4806db16f7bfcdceddc4c9efa2e6e377b348878","0x1176a1bd84444c89232ec27754698e5d2e7e1a7f1539f12027f28b23ec9f3d8","0xfc6a5336adf","0x0"],"from_address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]}],"execution_resources":{"data_availability":{"l1_data_gas":1856,"l1_gas":0},"ec_op_builtin_applications":3,"pedersen_builtin_applications":80,"poseidon_builtin_applications":30,"range_check_builtin_applications":2817,"steps":86702},"execution_status":"SUCCEEDED","finality_status":"ACCEPTED_ON_L2","messages_sent":[],"transaction_hash":"0x7760c7814433a55fc46ea4aabed4bf2f7de1e81c3b448284088b65d62eb1c03","type":"INVOKE"},"transaction":{"calldata":["0x2","0x2cb02c72e8a0975e69e88298443e984d965a49eab38f5bdde1f5072daa09cfe","0x3a8373903fa7946f74e89e626260495125e0bc54dcb43e90c895f69f69b00af","0x1","0x410ddf425143124f51","0x2cb02c72e8a0975e69e88298443e984d965a49eab38f5bdde1f5072daa09cfe","0xf6c64d1968e8a2fda72fc38a898e15653b6ed651d25142219ad011c1a7337c","0x3","0x64204710398bbd2a3af22b9335f4d75234f16e5badb5210b686232f2333d9f1","0x3bd73e92891c26838a68379966fb0f23837aed7369d620f0fcc5d36ac44bd60","0x410ddf425143124f51"],"max_fee":"0x2e1bef7ba029","nonce":"0x3d","sender_address":"0x54222f8775e4b5d21470f8ef4806db16f7bfcdceddc4c9efa2e6e377b348878","signature":["0x381f5fb590d1b5bdec5c7128573327894f479fe3d841dfe5c5203c5e5841357","0x48b8168e71512271e6159d780dec35b07ae27e04a560aa41397a3e986be89f","0x253631f88178c2d9389fd6aedd0d996db20f37d7bbbc90d429d0d0d81b0fcfd"],"transaction_hash":"0x7760c7814433a55fc46ea4aabed4bf2f7de1e81c3b448284088b65d62eb1c03","type":"INVOKE","version":"0x1"}},{"receipt":{"actual_fee":{"amount":"0x66fbdc4c5b97","unit":"WEI"},"events":[{"data":["0x1ae80d66cc58dc4250a95a019a8c6dcb11f2bd0053ae6e9136c03b01be59a88","0x1176a1bd84444c89232ec27754698e5d2e7e1a7f1539f12027f28b23ec9f3d8","0x66fbdc4c5b97","0x0"],"from_address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]}],"execution_resources":{"data_availability":{"l1_data_gas":128,"l1_gas":0},"ec_op_builtin_applications":3,"pedersen_builtin_applications":42,"poseidon_builtin_applications":3,"range_check_builtin_applications":187,"steps":606905},"execution_status":"REVERTED","finality_status":"ACCEPTED_ON_L2","messages_sent":[],"revert_reason":"Transaction execution has failed:\n0: Error in the called contract (contract address: 0x01ae80d66cc58dc4250a95a019a8c6dcb11f2bd0053ae6e9136c03b01be59a88, class hash: 0x01a736d6ed154502257f02b1ccdf4d9d1089f80811cd6acad48e6b6a9d1f2003, selector: 0x015d40a3d6ca2ac30f4031e42be28da9b056fef9bb7357ac5e85627ee876e5ad):\nError at pc=0:15647:\nCairo traceback (most recent call last):\nUnknown location (pc=0:233)\nUnknown location (pc=0:5191)\nUnknown location (pc=0:11307)\nUnknown location (pc=0:15665)\n\n1: Error in the called contract (contract address: 0x04270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f, class hash: 0x05ee939756c1a60b029c594da00e637bf5923bf04a86ff163e877e899c0840eb, selector: 0x01171593aa5bdadda4d6b0efde6cc94ee7649c3163d5efeb19da6c16d63a2a63):\nError at pc=0:10:\nCairo traceback (most recent call last):\nUnknown location (pc=0:430)\nUnknown location (pc=0:416)\n\n2: Error in a library call (contract address: 0x04270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f, class hash: 0x074340dcef0aaf445dd937f783836c1611f5a76d44cd05a67bdf5b5f47fd48fa, selector: 0x01171593aa5bdadda4d6b0efde6cc94ee7649c3163d5efeb19da6c16d63a2a63):\nExecution failed. Failure reason: 0x496e73756666696369656e7420746f6b656e73207265636569766564 ('Insufficient tokens received').\n","transaction_hash":"0x4bfacc8b724de06a6a4c53dcd2cbef43a18f87afdbebba95853bd6ed1e80e93","type":"INVOKE"},"transaction":{"calldata":["0x2","0x3fe2b97c1fd336e750087d68b9b867997fd64a2661ff3ca5a7c771641e8e7ac","0x219209e083275171774dab1df80982e9df2096516f06319c5c6d71ae0a8480c","0x3","0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0xd4029","0x0","0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x1171593aa5bdadda4d6b0efde6cc94ee7649c3163d5efeb19da6c16d63a2a63","0x12","0x3fe2b97c1fd336e750087d68b9b867997fd64a2661ff3ca5a7c771641e8e7ac","0xd4029","0x0","0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","0x3bf2f945cd08fc0","0x0","0x3bf2f945cd08fc0","0x0","0x1ae80d66cc58dc4250a95a019a8c6dcb11f2bd0053ae6e9136c03b01be59a88","0x0","0x0","0x1","0x3fe2b97c1fd336e750087d68b9b867997fd64a2661ff3ca5a7c771641e8e7ac","0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","0x73cc79b07a02fe5dcd714903d62f9f3081e15aeb34e3725f44e495ecd88a5a1","0xe8d4a51000","0x1","0x1617ae3f60d9ce6e32ec0eaa56e043e4594a78684037415a2e80f25e74941b4"],"max_fee":"0xe15db1df8b83","nonce":"0xc083","sender_address":"0x1ae80d66cc58dc4250a95a019a8c6dcb11f2bd0053ae6e9136c03b01be59a88","signature":["0x5a5e1ba21292c24c64285c870decfe3823cc682ac4b1648eb8b757f5545b994","0x26e5226e1e0ef47087b70243a7b1e81e3289b9cf05f0e7057a62ea55f66665"],"transaction_hash":"0x4bfacc8b724de06a6a4c53dcd2cbef43a18f87afdbebba95853bd6ed1e80e93","type":"INVOKE","version":"0x1"}},{"receipt":{"actual_fee":{"amount":"0xb4ea6bf9291","unit":"WEI"},"events":[{"data":["0x3b7402062f077974b2743315b40aed42b77cfc0aaf5d34e303245133f5868c4","0x2e0af29598b407c8716b17f6d2795eca1b471413fa03fb145a5e33722184067","0x7c58508723800400","0x0"],"from_address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x0","0x3b7402062f077974b2743315b40aed42b77cfc0aaf5d34e303245133f5868c4","0x1164d8","0x0"],"from_address":"0x7b696af58c967c1b14c9dde0ace001720635a660a8e90c565ea459345318b30","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x2e0af29598b407c8716b17f6d2795eca1b471413fa03fb145a5e33722184067","0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0x0","0x3eb","0x0","0x1164d8","0x1296dce","0x1","0x12969e3","0x1","0xe836db2fc87fe7c","0x0","0x7c585087238003fb","0x0","0x0","0x0"],"from_address":"0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","keys":["0x3a7adca3546c213ce791fabf3b04090c163e419c808c9830fb343a4a395946e"]},{"data":["0x2e0af29598b407c8716b17f6d2795eca1b471413fa03fb145a5e33722184067","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0x7c585087238003fb","0x0"],"from_address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x2e0af29598b407c8716b17f6d2795eca1b471413fa03fb145a5e33722184067","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0x0","0x0"],"from_address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x2e0af29598b407c8716b17f6d2795eca1b471413fa03fb145a5e33722184067","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0x7c585087238003fb","0x0"],"from_address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x2e0af29598b407c8716b17f6d2795eca1b471413fa03fb145a5e33722184067","0x3b7402062f077974b2743315b40aed42b77cfc0aaf5d34e303245133f5868c4","0x5","0x0"],"from_address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x3b7402062f077974b2743315b40aed42b77cfc0aaf5d34e303245133f5868c4","0x1176a1bd84444c89232ec27754698e5d2e7e1a7f1539f12027f28b23ec9f3d8","0xb4ea6bf9291","0x0"],"from_address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]}],"execution_resources":{"bitwise_builtin_applications":108,"data_availability":{"l1_data_gas":896,"l1_gas":0},"ec_op_builtin_applications":3,"pedersen_builtin_applications":173,"poseidon_builtin_applications":15,"range_check_builtin_applications":3690,"steps":62552},"execution_status":"SUCCEEDED","finality_status":"ACCEPTED_ON_L2","messages_sent":[],"transaction_hash":"0xcedd449dab013129ae0f06ab587b57528a42474e8243d28058d20cd1d0c128","type":"INVOKE"},"transaction":{"calldata":["0x3","0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","0x83afd3f4caedc6eebf44246fe54e38c95e3179a5ec9ea81740eca5b482d12e","0x3","0x2e0af29598b407c8716b17f6d2795eca1b471413fa03fb145a5e33722184067","0x7c58508723800400","0x0","0x2e0af29598b407c8716b17f6d2795eca1b471413fa03fb145a5e33722184067","0x38c3244e92da3bec5e017783c62779e3fd5d13827570dc093ab2a55f16d41b9","0xa","0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0x0","0x3eb","0x0","0x1296dce","0x1","0x12969e3","0x1","0xe5e4633f83e2c80","0x2e0af29598b407c8716b17f6d2795eca1b471413fa03fb145a5e33722184067","0x292f3f4df7749c2ae1fdc3379303c2e6caa9bbc3033ee67709fde5b77f65836","0x1","0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7"],"max_fee":"0x1c6bf52634000","nonce":"0x124a","sender_address":"0x3b7402062f077974b2743315b40aed42b77cfc0aaf5d34e303245133f5868c4","signature":["0x2ccb3cc4a02545dd5a623dfbbe9a78589f03f10b29e67928e6ba1f58a68bc17","0x6b7f63cdb731c19382fc165dd302137afe0d96f08200c48ce577685d00125fa"],"transaction_hash":"0xcedd449dab013129ae0f06ab587b57528a42474e8243d28058d20cd1d0c128","type":"INVOKE","version":"0x1"}},{"receipt":{"actual_fee":{"amount":"0x2a5146a9ff17","unit":"WEI"},"events":[{"data":["0xd529ae9e860000","0x0"],"from_address":"0x269b6a4501f60b6012dd659cdaec077cca07b073aa0f6ef5cf710ae804b68f7","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9","0x23f63ecaeb91d32523cf8c5d1535edcd8e2bca123708da8f51e0b360c1fc2aa","0x338b5f3b2796eed7093c304a4e07918965c052580caf38c90dbb8ee443a71d1"]},{"data":["0x29a2241af62c0000","0x0"],"from_address":"0x269b6a4501f60b6012dd659cdaec077cca07b073aa0f6ef5cf710ae804b68f7","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9","0x23f63ecaeb91d32523cf8c5d1535edcd8e2bca123708da8f51e0b360c1fc2aa","0x7c8ea8adfc607eef5c4cfe840cf0393189f684dff12f68748b81ae69f16c76b"]},{"data":["0x7c8ea8adfc607eef5c4cfe840cf0393189f684dff12f68748b81ae69f16c76b","0x29a2241af62c0000","0x0","0x363738643130636162343530383762393462643333303633","0x1","0x338b5f3b2796eed7093c304a4e07918965c052580caf38c90dbb8ee443a71d1","0xd529ae9e860000","0x0"],"from_address":"0x23f63ecaeb91d32523cf8c5d1535edcd8e2bca123708da8f51e0b360c1fc2aa","keys":["0x12401fe39f465ce67b760f03b5e26d762b45d2cff1972e6e92f2cab2ccd0032"]},{"data":["0x1","0x0"],"from_address":"0x7c8ea8adfc607eef5c4cfe840cf0393189f684dff12f68748b81ae69f16c76b","keys":["0x1dcde06aabdbca2f80aa51392b345d7549d7757aa855f7e37f5d335ac8243b1","0x6cdbcdcd040bf1b99f4d91af4771f3a24ae28039f20829dc62299f56a613324"]},{"data":["0x1","0x1","0x1"],"from_address":"0x2952155b01ce465a4814d48d40769429ae5f7656714a1d278569c5ec0eb77be","keys":["0x1dcde06aabdbca2f80aa51392b345d7549d7757aa855f7e37f5d335ac8243b1","0x6232edbcfe0babd91915275db7df5481de52115ffdb38e44a032142e4193d46"]},{"data":["0x2952155b01ce465a4814d48d40769429ae5f7656714a1d278569c5ec0eb77be","0x1176a1bd84444c89232ec27754698e5d2e7e1a7f1539f12027f28b23ec9f3d8","0x2a5146a9ff17","0x0"],"from_address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]}],"execution_resources":{"bitwise_builtin_applications":30,"data_availability":{"l1_data_gas":640,"l1_gas":0},"ec_op_builtin_applications":6,"keccak_builtin_applications":1,"pedersen_builtin_applications":99,"poseidon_builtin_applications":14,"range_check_builtin_applications":15424,"steps":183425},"execution_status":"SUCCEEDED","finality_status":"ACCEPTED_ON_L2","messages_sent":[],"transaction_hash":"0x6232edbcfe0babd91915275db7df5481de52115ffdb38e44a032142e4193d46","type":"INVOKE"},"transaction":{"calldata":["0x1","0x127021a1b5a52d3174c2ab077c2b043c80369250d29428cee956d76ee51584f","0x2dd871e79e84c455513b00b5f8158b7e8e6b1e8b27b499a9c4ba3c9d10b32d9","0x1a","0x7c8ea8adfc607eef5c4cfe840cf0393189f684dff12f68748b81ae69f16c76b","0x7ec457cd7ed1630225a8328f826a29a327b19486f6b2882b4176545ebdbe3d","0x17","0x127021a1b5a52d3174c2ab077c2b043c80369250d29428cee956d76ee51584f","0x3b1e840262fdb4474cf5ff1e17b6365e1b888cecbde796a1e93e2cf26817cc7","0x1","0x1947f408c4a","0x1","0x23f63ecaeb91d32523cf8c5d1535edcd8e2bca123708da8f51e0b360c1fc2aa","0x2076ff343d09a3a73d72267791f1b44ef5dc8b245573948eaa85370a2ced899","0xc","0x29a2241af62c0000","0x0","0x1","0x338b5f3b2796eed7093c304a4e07918965c052580caf38c90dbb8ee443a71d1","0xd529ae9e860000","0x0","0x363738643130636162343530383762393462643333303633","0x2ddaa968a318bff1bafb1a419f5d5775","0x93b54d2d4552bdc744b12c481f4425de","0x4bfa3029435ebd61daac58c90e541f85","0x6f8e80df78e4aab6142549f7c441519f","0x1b","0x2","0xd0a54ec5d7375ec7eef1e59574b3360fab6cc44f987fdfb8019113c48a014d","0x2de83f0b05667bb0701f3aaf6e5540859be38dd6dcdc8fbe82c1702d2e79679"],"max_fee":"0x54a28d53fe2e","nonce":"0xb918","sender_address":"0x2952155b01ce465a4814d48d40769429ae5f7656714a1d278569c5ec0eb77be","signature":["0xd4bbdfce3ed1646f094bb4edd65d284fbe0e8aa19c0a8da10615e9d3dda24","0x1a9f077e2596a63ee4c081bb2dceb928addf465ac246f0f51024efebda275fc"],"transaction_hash":"0x6232edbcfe0babd91915275db7df5481de52115ffdb38e44a032142e4193d46","type":"INVOKE","version":"0x1"}},{"receipt":{"actual_fee":{"amount":"0x18581b226046e49","unit":"FRI"},"events":[{"data":["0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","0x68db8bac710cb4000000000000000","0xc8","0x0","0x1163e5","0x2e0af29598b407c8716b17f6d2795eca1b471413fa03fb145a5e33722184067","0x893a78","0x1","0x8939b0","0x1","0x14db4dee576c7c0ed","0x1","0x9a901ff269","0x1"],"from_address":"0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","keys":["0x96982abd597114bdaa4a60612f87fabfcc7206aa12d61c50e7ba1e6c291100"]},{"data":["0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0x656e6ee40f3486f760502a1a3ec3891bc2350eb6122eb055bbb6a3301c3a542","0x14db4dee576c7c0ed","0x0"],"from_address":"0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0x656e6ee40f3486f760502a1a3ec3891bc2350eb6122eb055bbb6a3301c3a542","0x9a901ff269","0x0"],"from_address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","0x68db8bac710cb4000000000000000","0xc8","0x0","0x1163e5","0x2e0af29598b407c8716b17f6d2795eca1b471413fa03fb145a5e33722184067","0x893a78","0x1","0x8939b0","0x1","0x14d995846dd3e7745","0x1","0xd3a3a5757","0x1"],"from_address":"0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","keys":["0x5dacf59794364ad1555bb3c9b2346afa81e57e5c19bb6bae0d22721c96c4e5"]},{"data":["0x2e0af29598b407c8716b17f6d2795eca1b471413fa03fb145a5e33722184067","0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","0x68db8bac710cb4000000000000000","0xc8","0x0","0x1163e5","0x893a78","0x1","0x8939b0","0x1","0x1629ee20345281b41159f0","0x1","0x32e5e86ef7db7a24ada4","0x1","0x204a54cb95d72","0x1"],"from_address":"0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","keys":["0x3a7adca3546c213ce791fabf3b04090c163e419c808c9830fb343a4a395946e"]},{"data":["0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0x656e6ee40f3486f760502a1a3ec3891bc2350eb6122eb055bbb6a3301c3a542","0x32e5e86ef7db7a24ada4","0x0"],"from_address":"0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0x656e6ee40f3486f760502a1a3ec3891bc2350eb6122eb055bbb6a3301c3a542","0x204a54cb95d72","0x0"],"from_address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x761a5d53b8133d70140845fbc522f63adf80f3b9ed979d2eb7f772f76c1b206","0x1176a1bd84444c89232ec27754698e5d2e7e1a7f1539f12027f28b23ec9f3d8","0x18581b226046e49","0x0"],"from_address":"0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]}],"execution_resources":{"bitwise_builtin_applications":54,"data_availability":{"l1_data_gas":1216,"l1_gas":0},"ec_op_builtin_applications":6,"pedersen_builtin_applications":229,"poseidon_builtin_applications":42,"range_check_builtin_applications":3389,"steps":67771},"execution_status":"SUCCEEDED","finality_status":"ACCEPTED_ON_L2","messages_sent":[],"transaction_hash":"0x1d7bd9ff4e5e083490e3f3e1f87abba275ec423232712d1f140adf218988a40","type":"INVOKE"},"transaction":{"account_deployment_data":[],"calldata":["0x1","0x656e6ee40f3486f760502a1a3ec3891bc2350eb6122eb055bbb6a3301c3a542","0x34cc13b274446654ca3233ed2c1620d4c5d1d32fd20b47146a3371064bdc57d","0x1a","0x414e595f43414c4c4552","0x1947f0987ba","0x678c2666","0x678dfb26","0x1","0x2e0af29598b407c8716b17f6d2795eca1b471413fa03fb145a5e33722184067","0x15511cc3694f64379908437d6d64458dc76d02482052bfb8a5b33a72c054c77","0xe","0x1163e5","0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","0x68db8bac710cb4000000000000000","0xc8","0x0","0x893a78","0x1","0x8939b0","0x1","0x1629ee20345281b41159f0","0x0","0x0","0x1","0x3","0x1","0x2195ad36c84a457dd9f2bf852083d8950a1a7f6e027905a6e790553db319737","0x2167474761422df9f5ff1b2fdf6aa9005a48b6df17312959d7fe39068c618e1"],"fee_data_availability_mode":"L1","nonce":"0x39fd","nonce_data_availability_mode":"L1","paymaster_data":[],"resource_bounds":{"l1_gas":{"max_amount":"0x867","max_price_per_unit":"0x331534bb6483e"},"l2_gas":{"max_amount":"0x0","max_price_per_unit":"0x0"}},"sender_address":"0x761a5d53b8133d70140845fbc522f63adf80f3b9ed979d2eb7f772f76c1b206","signature":["0x1","0x9c670e18bb2c37ff4c35cf1483956426fd431f14284ffffeddf162e665da80","0x4ca7d08cd33b90c5d8122803c5372f96229f222f037d66d7dcfc829f97a2ac5"],"tip":"0x0","transaction_hash":"0x1d7bd9ff4e5e083490e3f3e1f87abba275ec423232712d1f140adf218988a40","type":"INVOKE","version":"0x3"}},{"receipt":{"actual_fee":{"amount":"0x2afe9bc3489d","unit":"WEI"},"events":[{"data":["0x0"],"from_address":"0x3a23b12779066d2a3d5ef250e64f1cea567e59ca6c3d4eadf958f175843440c","keys":["0x1d9ca8a89626bead91b5cb4275a622219e9443975b34f3fdbc683e8621231a9","0x2e4cba8b90943fce9c0e31989207ad325e5bb9e4bdcd9f3e4d5ddf93b9c7f89"]},{"data":[],"from_address":"0x3a23b12779066d2a3d5ef250e64f1cea567e59ca6c3d4eadf958f175843440c","keys":["0x38f6a5b87c23cee6e7294bcc3302e95019f70f81586ff3cac38581f5ca96381","0x2e4cba8b90943fce9c0e31989207ad325e5bb9e4bdcd9f3e4d5ddf93b9c7f89"]},{"data":["0x3a23b12779066d2a3d5ef250e64f1cea567e59ca6c3d4eadf958f175843440c","0x36bc704e0ba7bd77bcc30c00f13d810b2c6dba3d8f7676263d82d9e69306f87","0x0","0x1a736d6ed154502257f02b1ccdf4d9d1089f80811cd6acad48e6b6a9d1f2003","0x2","0x2e4cba8b90943fce9c0e31989207ad325e5bb9e4bdcd9f3e4d5ddf93b9c7f89","0x0","0x2e4cba8b90943fce9c0e31989207ad325e5bb9e4bdcd9f3e4d5ddf93b9c7f89"],"from_address":"0x41a78e741e5af2fec34b695679bc6891742439f7afb8484ecd7766661ad02bf","keys":["0x26b160f10156dea0639bec90696772c640b9706a47f5b8c52ea1abe5858b34d"]},{"data":["0x5fec5b60ef80000","0x0"],"from_address":"0x269b6a4501f60b6012dd659cdaec077cca07b073aa0f6ef5cf710ae804b68f7","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9","0x23f63ecaeb91d32523cf8c5d1535edcd8e2bca123708da8f51e0b360c1fc2aa","0x17f07cc08162c68d4902655ab06058fec2a72a081b4ad8cf8a73c86c079e31e"]},{"data":["0x214e8348c4f00000","0x0"],"from_address":"0x269b6a4501f60b6012dd659cdaec077cca07b073aa0f6ef5cf710ae804b68f7","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9","0x23f63ecaeb91d32523cf8c5d1535edcd8e2bca123708da8f51e0b360c1fc2aa","0x3a23b12779066d2a3d5ef250e64f1cea567e59ca6c3d4eadf958f175843440c"]},{"data":["0x3a23b12779066d2a3d5ef250e64f1cea567e59ca6c3d4eadf958f175843440c","0x214e8348c4f00000","0x0","0x363738643130636362343530383762393462643333303835","0x1","0x17f07cc08162c68d4902655ab06058fec2a72a081b4ad8cf8a73c86c079e31e","0x5fec5b60ef80000","0x0"],"from_address":"0x23f63ecaeb91d32523cf8c5d1535edcd8e2bca123708da8f51e0b360c1fc2aa","keys":["0x12401fe39f465ce67b760f03b5e26d762b45d2cff1972e6e92f2cab2ccd0032"]},{"data":["0x1","0x0"],"from_address":"0x3a23b12779066d2a3d5ef250e64f1cea567e59ca6c3d4eadf958f175843440c","keys":["0x1dcde06aabdbca2f80aa51392b345d7549d7757aa855f7e37f5d335ac8243b1","0x7c45d37e78f5fc5491012aa22c442f303ca82e940b07d2dadcadd3780988a2c"]},{"data":["0x2","0x1","0x3a23b12779066d2a3d5ef250e64f1cea567e59ca6c3d4eadf958f175843440c","0x1","0x1"],"from_address":"0x36bc704e0ba7bd77bcc30c00f13d810b2c6dba3d8f7676263d82d9e69306f87","keys":["0x1dcde06aabdbca2f80aa51392b345d7549d7757aa855f7e37f5d335ac8243b1","0x721b007780f135c66db1c9e44f71810bd3ffd8a07507d9f93c4454c2275602f"]},{"data":["0x36bc704e0ba7bd77bcc30c00f13d810b2c6dba3d8f7676263d82d9e69306f87","0x1176a1bd84444c89232ec27754698e5d2e7e1a7f1539f12027f28b23ec9f3d8","0x2afe9bc3489d","0x0"],"from_address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]}],"execution_resources":{"bitwise_builtin_applications":30,"data_availability":{"l1_data_gas":736,"l1_gas":0},"ec_op_builtin_applications":6,"keccak_builtin_applications":1,"pedersen_builtin_applications":115,"poseidon_builtin_applications":15,"range_check_builtin_applications":15551,"steps":188182},"execution_status":"SUCCEEDED","finality_status":"ACCEPTED_ON_L2","messages_sent":[],"transaction_hash":"0x721b007780f135c66db1c9e44f71810bd3ffd8a07507d9f93c4454c2275602f","type":"INVOKE"},"transaction":{"calldata":["0x2","0x41a78e741e5af2fec34b695679bc6891742439f7afb8484ecd7766661ad02bf","0x1987cbd17808b9a23693d4de7e246a443cfe37e6e7fbaeabd7d7e6532b07c3d","0x6","0x1a736d6ed154502257f02b1ccdf4d9d1089f80811cd6acad48e6b6a9d1f2003","0x2e4cba8b90943fce9c0e31989207ad325e5bb9e4bdcd9f3e4d5ddf93b9c7f89","0x0","0x2","0x2e4cba8b90943fce9c0e31989207ad325e5bb9e4bdcd9f3e4d5ddf93b9c7f89","0x0","0x127021a1b5a52d3174c2ab077c2b043c80369250d29428cee956d76ee51584f","0x2dd871e79e84c455513b00b5f8158b7e8e6b1e8b27b499a9c4ba3c9d10b32d9","0x1a","0x3a23b12779066d2a3d5ef250e64f1cea567e59ca6c3d4eadf958f175843440c","0x7ec457cd7ed1630225a8328f826a29a327b19486f6b2882b4176545ebdbe3d","0x17","0x127021a1b5a52d3174c2ab077c2b043c80369250d29428cee956d76ee51584f","0x18cc1bad5066fdfa18a2ea10508b7ce95c65670cf582260cc4577c5a563a9b4","0x1","0x1947f4094e1","0x1","0x23f63ecaeb91d32523cf8c5d1535edcd8e2bca123708da8f51e0b360c1fc2aa","0x2076ff343d09a3a73d72267791f1b44ef5dc8b245573948eaa85370a2ced899","0xc","0x214e8348c4f00000","0x0","0x1","0x17f07cc08162c68d4902655ab06058fec2a72a081b4ad8cf8a73c86c079e31e","0x5fec5b60ef80000","0x0","0x363738643130636362343530383762393462643333303835","0x1094ad27936d527fe0a25922e2da6163","0xfcc1d81dd645f2b5b8b5bdf9c7c6132","0x14b9f9286ca1d5705f69741bc2dcf96c","0x4e33a30eb0917aee9212e168f2baa9b0","0x1b","0x2","0x4b39c3e94c65df7df95dea7600d6f4e62ecd6b0f20027e359fecb9e76b3739a","0x34497c45ebdedefbc68bb14065a770d1bf256c31c4275d2f931cc359fdbf0ce"],"max_fee":"0x55fd3786913a","nonce":"0xb872","sender_address":"0x36bc704e0ba7bd77bcc30c00f13d810b2c6dba3d8f7676263d82d9e69306f87","signature":["0x2bea6500d6f19edb9895a01dad50608b2e04a339f244954b2ef1fae8ea93d07","0x152a4d201acf288fba3b20191d4d2d4aabf64136a72dff7842fe5b6c7238d28"],"transaction_hash":"0x721b007780f135c66db1c9e44f71810bd3ffd8a07507d9f93c4454c2275602f","type":"INVOKE","version":"0x1"}},{"receipt":{"actual_fee":{"amount":"0x5491380557a7","unit":"WEI"},"events":[{"data":["0x678d10cd","0x4249545354414d50","0x505241474d41","0x5fc97c0","0x574254432f425443","0x0"],"from_address":"0x2a85bd616f912537c50a49a4076db02c00b29b2cdc8a197ce92ed1837fa875b","keys":["0x280bb2099800026f90c334a3a23888ffe718a2920ffbbf4f44c6d3d5efb613c"]},{"data":["0x678d10cd","0x444546494c4c414d41","0x505241474d41","0x5ee52ad","0x574254432f425443","0x0"],"from_address":"0x2a85bd616f912537c50a49a4076db02c00b29b2cdc8a197ce92ed1837fa875b","keys":["0x280bb2099800026f90c334a3a23888ffe718a2920ffbbf4f44c6d3d5efb613c"]},{"data":["0x678d10ce","0x48554f4249","0x505241474d41","0x5f44c36","0x574254432f425443","0x0"],"from_address":"0x2a85bd616f912537c50a49a4076db02c00b29b2cdc8a197ce92ed1837fa875b","keys":["0x280bb2099800026f90c334a3a23888ffe718a2920ffbbf4f44c6d3d5efb613c"]},{"data":["0x678d10cd","0x4b55434f494e","0x505241474d41","0x5eff178","0x574254432f425443","0x0"],"from_address":"0x2a85bd616f912537c50a49a4076db02c00b29b2cdc8a197ce92ed1837fa875b","keys":["0x280bb2099800026f90c334a3a23888ffe718a2920ffbbf4f44c6d3d5efb613c"]},{"data":["0x678d10cd","0x444546494c4c414d41","0x505241474d41","0x5af4326500","0x5753544554482f555344","0x0"],"from_address":"0x2a85bd616f912537c50a49a4076db02c00b29b2cdc8a197ce92ed1837fa875b","keys":["0x280bb2099800026f90c334a3a23888ffe718a2920ffbbf4f44c6d3d5efb613c"]},{"data":["0x678d10cd","0x44455853435245454e4552","0x505241474d41","0x1a3ec0","0x5753544554482f555344","0x0"],"from_address":"0x2a85bd616f912537c50a49a4076db02c00b29b2cdc8a197ce92ed1837fa875b","keys":["0x280bb2099800026f90c334a3a23888ffe718a2920ffbbf4f44c6d3d5efb613c"]},{"data":["0x678d10cd","0x4249545354414d50","0x505241474d41","0xf4236","0x555344432f555344","0x0"],"from_address":"0x2a85bd616f912537c50a49a4076db02c00b29b2cdc8a197ce92ed1837fa875b","keys":["0x280bb2099800026f90c334a3a23888ffe718a2920ffbbf4f44c6d3d5efb613c"]},{"data":["0x678d10ce","0x4f4b58","0x505241474d41","0xf4d42","0x555344432f555344","0x27248c"],"from_address":"0x2a85bd616f912537c50a49a4076db02c00b29b2cdc8a197ce92ed1837fa875b","keys":["0x280bb2099800026f90c334a3a23888ffe718a2920ffbbf4f44c6d3d5efb613c"]},{"data":["0x678d10ce","0x48554f4249","0x505241474d41","0xf4be3","0x555344432f555344","0x5c378c"],"from_address":"0x2a85bd616f912537c50a49a4076db02c00b29b2cdc8a197ce92ed1837fa875b","keys":["0x280bb2099800026f90c334a3a23888ffe718a2920ffbbf4f44c6d3d5efb613c"]},{"data":["0x678d10cd","0x4b55434f494e","0x505241474d41","0xf468c","0x555344432f555344","0x0"],"from_address":"0x2a85bd616f912537c50a49a4076db02c00b29b2cdc8a197ce92ed1837fa875b","keys":["0x280bb2099800026f90c334a3a23888ffe718a2920ffbbf4f44c6d3d5efb613c"]},{"data":["0x678d10cd","0x44455853435245454e4552","0x505241474d41","0x33fe","0x555344432f555344","0x0"],"from_address":"0x2a85bd616f912537c50a49a4076db02c00b29b2cdc8a197ce92ed1837fa875b","keys":["0x280bb2099800026f90c334a3a23888ffe718a2920ffbbf4f44c6d3d5efb613c"]},{"data":["0x678d10cd","0x4249545354414d50","0x505241474d41","0x98bac8c9b00","0x4254432f555344","0x0"],"from_address":"0x2a85bd616f912537c50a49a4076db02c00b29b2cdc8a197ce92ed1837fa875b","keys":["0x280bb2099800026f90c334a3a23888ffe718a2920ffbbf4f44c6d3d5efb613c"]},{"data":["0x678d10cd","0x444546494c4c414d41","0x505241474d41","0x98846524900","0x4254432f555344","0x0"],"from_address":"0x2a85bd616f912537c50a49a4076db02c00b29b2cdc8a197ce92ed1837fa875b","keys":["0x280bb2099800026f90c334a3a23888ffe718a2920ffbbf4f44c6d3d5efb613c"]},{"data":["0x678d10ce","0x4f4b58","0x505241474d41","0x992a20895d9","0x4254432f555344","0x127f9"],"from_address":"0x2a85bd616f912537c50a49a4076db02c00b29b2cdc8a197ce92ed1837fa875b","keys":["0x280bb2099800026f90c334a3a23888ffe718a2920ffbbf4f44c6d3d5efb613c"]},{"data":["0x678d10cc","0x4745434b4f5445524d494e414c","0x505241474d41","0x984fde55c00","0x4254432f555344","0x17e0307d"],"from_address":"0x2a85bd616f912537c50a49a4076db02c00b29b2cdc8a197ce92ed1837fa875b","keys":["0x280bb2099800026f90c334a3a23888ffe718a2920ffbbf4f44c6d3d5efb613c"]},{"data":["0x678d10ce","0x48554f4249","0x505241474d41","0x99177705c01","0x4254432f555344","0x1d6b19b9"],"from_address":"0x2a85bd616f912537c50a49a4076db02c00b29b2cdc8a197ce92ed1837fa875b","keys":["0x280bb2099800026f90c334a3a23888ffe718a2920ffbbf4f44c6d3d5efb613c"]},{"data":["0x678d10cd","0x4b55434f494e","0x505241474d41","0x98ed0051500","0x4254432f555344","0x0"],"from_address":"0x2a85bd616f912537c50a49a4076db02c00b29b2cdc8a197ce92ed1837fa875b","keys":["0x280bb2099800026f90c334a3a23888ffe718a2920ffbbf4f44c6d3d5efb613c"]},{"data":["0x678d10cd","0x4249545354414d50","0x505241474d41","0xf3cc8","0x555344542f555344","0x0"],"from_address":"0x2a85bd616f912537c50a49a4076db02c00b29b2cdc8a197ce92ed1837fa875b","keys":["0x280bb2099800026f90c334a3a23888ffe718a2920ffbbf4f44c6d3d5efb613c"]},{"data":["0x678d10cd","0x44455853435245454e4552","0x505241474d41","0x1c18","0x555344542f555344","0x0"],"from_address":"0x2a85bd616f912537c50a49a4076db02c00b29b2cdc8a197ce92ed1837fa875b","keys":["0x280bb2099800026f90c334a3a23888ffe718a2920ffbbf4f44c6d3d5efb613c"]},{"data":["0x678d10cd","0x4249545354414d50","0x505241474d41","0x94d3a956100","0x4254432f455552","0x0"],"from_address":"0x2a85bd616f912537c50a49a4076db02c00b29b2cdc8a197ce92ed1837fa875b","keys":["0x280bb2099800026f90c334a3a23888ffe718a2920ffbbf4f44c6d3d5efb613c"]},{"data":["0x678d10cc","0x4b55434f494e","0x505241474d41","0x958260a9600","0x4254432f455552","0x0"],"from_address":"0x2a85bd616f912537c50a49a4076db02c00b29b2cdc8a197ce92ed1837fa875b","keys":["0x280bb2099800026f90c334a3a23888ffe718a2920ffbbf4f44c6d3d5efb613c"]},{"data":["0x678d10cc","0x444546494c4c414d41","0x505241474d41","0x97c30d72200","0x574254432f555344","0x0"],"from_address":"0x2a85bd616f912537c50a49a4076db02c00b29b2cdc8a197ce92ed1837fa875b","keys":["0x280bb2099800026f90c334a3a23888ffe718a2920ffbbf4f44c6d3d5efb613c"]},{"data":["0x678d10cc","0x4745434b4f5445524d494e414c","0x505241474d41","0x984fde55c00","0x574254432f555344","0x17e0307d"],"from_address":"0x2a85bd616f912537c50a49a4076db02c00b29b2cdc8a197ce92ed1837fa875b","keys":["0x280bb2099800026f90c334a3a23888ffe718a2920ffbbf4f44c6d3d5efb613c"]},{"data":["0x678d10cc","0x4b55434f494e","0x505241474d41","0x9875be577c0","0x574254432f555344","0x0"],"from_address":"0x2a85bd616f912537c50a49a4076db02c00b29b2cdc8a197ce92ed1837fa875b","keys":["0x280bb2099800026f90c334a3a23888ffe718a2920ffbbf4f44c6d3d5efb613c"]},{"data":["0x678d10cd","0x44455853435245454e4552","0x505241474d41","0x3d523e0","0x574254432f555344","0x0"],"from_address":"0x2a85bd616f912537c50a49a4076db02c00b29b2cdc8a197ce92ed1837fa875b","keys":["0x280bb2099800026f90c334a3a23888ffe718a2920ffbbf4f44c6d3d5efb613c"]},{"data":["0x678d10cd","0x4249545354414d50","0x505241474d41","0x5f423b0","0x4441492f555344","0x0"],"from_address":"0x2a85bd616f912537c50a49a4076db02c00b29b2cdc8a197ce92ed1837fa875b","keys":["0x280bb2099800026f90c334a3a23888ffe718a2920ffbbf4f44c6d3d5efb613c"]},{"data":["0x678d10cc","0x444546494c4c414d41","0x505241474d41","0x5f5e100","0x4441492f555344","0x0"],"from_address":"0x2a85bd616f912537c50a49a4076db02c00b29b2cdc8a197ce92ed1837fa875b","keys":["0x280bb2099800026f90c334a3a23888ffe718a2920ffbbf4f44c6d3d5efb613c"]},{"data":["0x678d10ce","0x48554f4249","0x505241474d41","0x5f99181","0x4441492f555344","0x289e07"],"from_address":"0x2a85bd616f912537c50a49a4076db02c00b29b2cdc8a197ce92ed1837fa875b","keys":["0x280bb2099800026f90c334a3a23888ffe718a2920ffbbf4f44c6d3d5efb613c"]},{"data":["0x678d10cd","0x44455853435245454e4552","0x505241474d41","0x5f82af0","0x4441492f555344","0x0"],"from_address":"0x2a85bd616f912537c50a49a4076db02c00b29b2cdc8a197ce92ed1837fa875b","keys":["0x280bb2099800026f90c334a3a23888ffe718a2920ffbbf4f44c6d3d5efb613c"]},{"data":["0x678d10cd","0x4249545354414d50","0x505241474d41","0x276cc60","0x5354524b2f555344","0x0"],"from_address":"0x2a85bd616f912537c50a49a4076db02c00b29b2cdc8a197ce92ed1837fa875b","keys":["0x280bb2099800026f90c334a3a23888ffe718a2920ffbbf4f44c6d3d5efb613c"]},{"data":["0x678d10cd","0x444546494c4c414d41","0x505241474d41","0x276cd28","0x5354524b2f555344","0x0"],"from_address":"0x2a85bd616f912537c50a49a4076db02c00b29b2cdc8a197ce92ed1837fa875b","keys":["0x280bb2099800026f90c334a3a23888ffe718a2920ffbbf4f44c6d3d5efb613c"]},{"data":["0x678d10ce","0x4f4b58","0x505241474d41","0x27d5eff","0x5354524b2f555344","0x2bdcb4f"],"from_address":"0x2a85bd616f912537c50a49a4076db02c00b29b2cdc8a197ce92ed1837fa875b","keys":["0x280bb2099800026f90c334a3a23888ffe718a2920ffbbf4f44c6d3d5efb613c"]},{"data":["0x678d10ce","0x48554f4249","0x505241474d41","0x27d4b71","0x5354524b2f555344","0x5f820b"],"from_address":"0x2a85bd616f912537c50a49a4076db02c00b29b2cdc8a197ce92ed1837fa875b","keys":["0x280bb2099800026f90c334a3a23888ffe718a2920ffbbf4f44c6d3d5efb613c"]},{"data":["0x678d10cd","0x4b55434f494e","0x505241474d41","0x27cf298","0x5354524b2f555344","0x0"],"from_address":"0x2a85bd616f912537c50a49a4076db02c00b29b2cdc8a197ce92ed1837fa875b","keys":["0x280bb2099800026f90c334a3a23888ffe718a2920ffbbf4f44c6d3d5efb613c"]},{"data":["0x678d10cd","0x44455853435245454e4552","0x505241474d41","0x2444ab0","0x5354524b2f555344","0x0"],"from_address":"0x2a85bd616f912537c50a49a4076db02c00b29b2cdc8a197ce92ed1837fa875b","keys":["0x280bb2099800026f90c334a3a23888ffe718a2920ffbbf4f44c6d3d5efb613c"]},{"data":["0x678d10cd","0x4249545354414d50","0x505241474d41","0x4e79beda80","0x4554482f555344","0x0"],"from_address":"0x2a85bd616f912537c50a49a4076db02c00b29b2cdc8a197ce92ed1837fa875b","keys":["0x280bb2099800026f90c334a3a23888ffe718a2920ffbbf4f44c6d3d5efb613c"]},{"data":["0x678d10cd","0x444546494c4c414d41","0x505241474d41","0x4dc950d680","0x4554482f555344","0x0"],"from_address":"0x2a85bd616f912537c50a49a4076db02c00b29b2cdc8a197ce92ed1837fa875b","keys":["0x280bb2099800026f90c334a3a23888ffe718a2920ffbbf4f44c6d3d5efb613c"]},{"data":["0x678d10ce","0x4f4b58","0x505241474d41","0x4ea7123e04","0x4554482f555344","0x2ae703"],"from_address":"0x2a85bd616f912537c50a49a4076db02c00b29b2cdc8a197ce92ed1837fa875b","keys":["0x280bb2099800026f90c334a3a23888ffe718a2920ffbbf4f44c6d3d5efb613c"]},{"data":["0x678d10ce","0x48554f4249","0x505241474d41","0x4eab93aea8","0x4554482f555344","0x924fb9d"],"from_address":"0x2a85bd616f912537c50a49a4076db02c00b29b2cdc8a197ce92ed1837fa875b","keys":["0x280bb2099800026f90c334a3a23888ffe718a2920ffbbf4f44c6d3d5efb613c"]},{"data":["0x678d10cd","0x4b55434f494e","0x505241474d41","0x4e8f4346c0","0x4554482f555344","0x0"],"from_address":"0x2a85bd616f912537c50a49a4076db02c00b29b2cdc8a197ce92ed1837fa875b","keys":["0x280bb2099800026f90c334a3a23888ffe718a2920ffbbf4f44c6d3d5efb613c"]},{"data":["0x1","0x0"],"from_address":"0x6707675cd7dd9256667eca8284e46f4546711ee0054bc2dd02f0ce572056cf4","keys":["0x1dcde06aabdbca2f80aa51392b345d7549d7757aa855f7e37f5d335ac8243b1","0x5449e47ae5e5cfbeb5e894bf453704a9dcf4034d66a9deade115038f61b2ecf"]},{"data":["0x6707675cd7dd9256667eca8284e46f4546711ee0054bc2dd02f0ce572056cf4","0x1176a1bd84444c89232ec27754698e5d2e7e1a7f1539f12027f28b23ec9f3d8","0x5491380557a7","0x0"],"from_address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]}],"execution_resources":{"bitwise_builtin_applications":120,"data_availability":{"l1_data_gas":2752,"l1_gas":0},"ec_op_builtin_applications":3,"pedersen_builtin_applications":2699,"poseidon_builtin_applications":44,"range_check_builtin_applications":14793,"steps":468525},"execution_status":"SUCCEEDED","finality_status":"ACCEPTED_ON_L2","messages_sent":[],"transaction_hash":"0x5449e47ae5e5cfbeb5e894bf453704a9dcf4034d66a9deade115038f61b2ecf","type":"INVOKE"},"transaction":{"calldata":["0x1","0x2a85bd616f912537c50a49a4076db02c00b29b2cdc8a197ce92ed1837fa875b","0x3d0bcca55c118f88a08e0fcc06f43906c0c174feb52ebc83f0fa28a1f59ed67","0x119","0x28","0x0","0x678d10cd","0x4249545354414d50","0x505241474d41","0x5fc97c0","0x574254432f425443","0x0","0x0","0x678d10cd","0x444546494c4c414d41","0x505241474d41","0x5ee52ad","0x574254432f425443","0x0","0x0","0x678d10ce","0x48554f4249","0x505241474d41","0x5f44c36","0x574254432f425443","0x0","0x0","0x678d10cd","0x4b55434f494e","0x505241474d41","0x5eff178","0x574254432f425443","0x0","0x0","0x678d10cd","0x444546494c4c414d41","0x505241474d41","0x5af4326500","0x5753544554482f555344","0x0","0x0","0x678d10cd","0x44455853435245454e4552","0x505241474d41","0x1a3ec0","0x5753544554482f555344","0x0","0x0","0x678d10cd","0x4249545354414d50","0x505241474d41","0xf4236","0x555344432f555344","0x0","0x0","0x678d10ce","0x4f4b58","0x505241474d41","0xf4d42","0x555344432f555344","0x27248c","0x0","0x678d10ce","0x48554f4249","0x505241474d41","0xf4be3","0x555344432f555344","0x5c378c","0x0","0x678d10cd","0x4b55434f494e","0x505241474d41","0xf468c","0x555344432f555344","0x0","0x0","0x678d10cd","0x44455853435245454e4552","0x505241474d41","0x33fe","0x555344432f555344","0x0","0x0","0x678d10cd","0x4249545354414d50","0x505241474d41","0x98bac8c9b00","0x4254432f555344","0x0","0x0","0x678d10cd","0x444546494c4c414d41","0x505241474d41","0x98846524900","0x4254432f555344","0x0","0x0","0x678d10ce","0x4f4b58","0x505241474d41","0x992a20895d9","0x4254432f555344","0x127f9","0x0","0x678d10cc","0x4745434b4f5445524d494e414c","0x505241474d41","0x984fde55c00","0x4254432f555344","0x17e0307d","0x0","0x678d10ce","0x48554f4249","0x505241474d41","0x99177705c01","0x4254432f555344","0x1d6b19b9","0x0","0x678d10cd","0x4b55434f494e","0x505241474d41","0x98ed0051500","0x4254432f555344","0x0","0x0","0x678d10cd","0x4249545354414d50","0x505241474d41","0xf3cc8","0x555344542f555344","0x0","0x0","0x678d10cd","0x44455853435245454e4552","0x505241474d41","0x1c18","0x555344542f555344","0x0","0x0","0x678d10cd","0x4249545354414d50","0x505241474d41","0x94d3a956100","0x4254432f455552","0x0","0x0","0x678d10cc","0x4b55434f494e","0x505241474d41","0x958260a9600","0x4254432f455552","0x0","0x0","0x678d10cc","0x444546494c4c414d41","0x505241474d41","0x97c30d72200","0x574254432f555344","0x0","0x0","0x678d10cc","0x4745434b4f5445524d494e414c","0x505241474d41","0x984fde55c00","0x574254432f555344","0x17e0307d","0x0","0x678d10cc","0x4b55434f494e","0x505241474d41","0x9875be577c0","0x574254432f555344","0x0","0x0","0x678d10cd","0x44455853435245454e4552","0x505241474d41","0x3d523e0","0x574254432f555344","0x0","0x0","0x678d10cd","0x4249545354414d50","0x505241474d41","0x5f423b0","0x4441492f555344","0x0","0x0","0x678d10cc","0x444546494c4c414d41","0x505241474d41","0x5f5e100","0x4441492f555344","0x0","0x0","0x678d10ce","0x48554f4249","0x505241474d41","0x5f99181","0x4441492f555344","0x289e07","0x0","0x678d10cd","0x44455853435245454e4552","0x505241474d41","0x5f82af0","0x4441492f555344","0x0","0x0","0x678d10cd","0x4249545354414d50","0x505241474d41","0x276cc60","0x5354524b2f555344","0x0","0x0","0x678d10cd","0x444546494c4c414d41","0x505241474d41","0x276cd28","0x5354524b2f555344","0x0","0x0","0x678d10ce","0x4f4b58","0x505241474d41","0x27d5eff","0x5354524b2f555344","0x2bdcb4f","0x0","0x678d10ce","0x48554f4249","0x505241474d41","0x27d4b71","0x5354524b2f555344","0x5f820b","0x0","0x678d10cd","0x4b55434f494e","0x505241474d41","0x27cf298","0x5354524b2f555344","0x0","0x0","0x678d10cd","0x44455853435245454e4552","0x505241474d41","0x2444ab0","0x5354524b2f555344","0x0","0x0","0x678d10cd","0x4249545354414d50","0x505241474d41","0x4e79beda80","0x4554482f555344","0x0","0x0","0x678d10cd","0x444546494c4c414d41","0x505241474d41","0x4dc950d680","0x4554482f555344","0x0","0x0","0x678d10ce","0x4f4b58","0x505241474d41","0x4ea7123e04","0x4554482f555344","0x2ae703","0x0","0x678d10ce","0x48554f4249","0x505241474d41","0x4eab93aea8","0x4554482f555344","0x924fb9d","0x0","0x678d10cd","0x4b55434f494e","0x505241474d41","0x4e8f4346c0","0x4554482f555344","0x0"],"max_fee":"0x16345785d8a0000","nonce":"0x7b75f","sender_address":"0x6707675cd7dd9256667eca8284e46f4546711ee0054bc2dd02f0ce572056cf4","signature":["0x2e5b3481040cdf4e2996238e810e0961dbac9db057b07883fe7fad43a933ac1","0x1b7d7e4d95057938c3a7401391bc1e9728ce368a74e84869f674a6952d5ef5a"],"transaction_hash":"0x5449e47ae5e5cfbeb5e894bf453704a9dcf4034d66a9deade115038f61b2ecf","type":"INVOKE","version":"0x1"}},{"receipt":{"actual_fee":{"amount":"0x34ad4312e1c6","unit":"WEI"},"events":[{"data":["0x1628f102c41077cfee92623e208df9563227b2d7ae38f4db398f432948d1c9a","0x2e4d0591d14134c1fc914cc9cdd43fe7a21826ec73efe7bc276a7692cc71b19","0x487ab00","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x1628f102c41077cfee92623e208df9563227b2d7ae38f4db398f432948d1c9a","0x2e4d0591d14134c1fc914cc9cdd43fe7a21826ec73efe7bc276a7692cc71b19","0x0","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x1628f102c41077cfee92623e208df9563227b2d7ae38f4db398f432948d1c9a","0x2e4d0591d14134c1fc914cc9cdd43fe7a21826ec73efe7bc276a7692cc71b19","0x487ab00","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x2e4d0591d14134c1fc914cc9cdd43fe7a21826ec73efe7bc276a7692cc71b19","0x38925b0bcf4dce081042ca26a96300d9e181b910328db54a6c89e5451503f5","0x487ab00","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x2e4d0591d14134c1fc914cc9cdd43fe7a21826ec73efe7bc276a7692cc71b19","0x38925b0bcf4dce081042ca26a96300d9e181b910328db54a6c89e5451503f5","0x0","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x2e4d0591d14134c1fc914cc9cdd43fe7a21826ec73efe7bc276a7692cc71b19","0x38925b0bcf4dce081042ca26a96300d9e181b910328db54a6c89e5451503f5","0x487ab00","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x38925b0bcf4dce081042ca26a96300d9e181b910328db54a6c89e5451503f5","0x2e4d0591d14134c1fc914cc9cdd43fe7a21826ec73efe7bc276a7692cc71b19","0x506d6c109689b9","0x0"],"from_address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x487ab00","0x0","0x506d6c109689b9","0x0","0x1db0","0x0","0x5adf66","0x7ab71285260ecf0f406e","0x0","0xaaf62e2b5bd7c"],"from_address":"0x38925b0bcf4dce081042ca26a96300d9e181b910328db54a6c89e5451503f5","keys":["0xe316f0d9d2a3affa97de1d99bb2aac0538e2666d0d8545545ead241ef0ccab","0x2e4d0591d14134c1fc914cc9cdd43fe7a21826ec73efe7bc276a7692cc71b19","0x4694e144244c6eadbbb5abd97e6aa47279a3897ae038edacdf9be483a09c8e1","0x1","0x1","0x34412"]},{"data":["0x2e4d0591d14134c1fc914cc9cdd43fe7a21826ec73efe7bc276a7692cc71b19","0x1628f102c41077cfee92623e208df9563227b2d7ae38f4db398f432948d1c9a","0x506d6c109689b9","0x0"],"from_address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x1628f102c41077cfee92623e208df9563227b2d7ae38f4db398f432948d1c9a","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0x487ab00","0x0","0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","0x506d6c109689b9","0x0","0x1628f102c41077cfee92623e208df9563227b2d7ae38f4db398f432948d1c9a"],"from_address":"0x2e4d0591d14134c1fc914cc9cdd43fe7a21826ec73efe7bc276a7692cc71b19","keys":["0xe316f0d9d2a3affa97de1d99bb2aac0538e2666d0d8545545ead241ef0ccab"]},{"data":["0x1628f102c41077cfee92623e208df9563227b2d7ae38f4db398f432948d1c9a","0x2e4d0591d14134c1fc914cc9cdd43fe7a21826ec73efe7bc276a7692cc71b19","0x506d6c10968588","0x0"],"from_address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x1628f102c41077cfee92623e208df9563227b2d7ae38f4db398f432948d1c9a","0x2e4d0591d14134c1fc914cc9cdd43fe7a21826ec73efe7bc276a7692cc71b19","0x41e2dac78c1","0x0"],"from_address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x1628f102c41077cfee92623e208df9563227b2d7ae38f4db398f432948d1c9a","0x2e4d0591d14134c1fc914cc9cdd43fe7a21826ec73efe7bc276a7692cc71b19","0x50694de2ea0cc7","0x0"],"from_address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x2e4d0591d14134c1fc914cc9cdd43fe7a21826ec73efe7bc276a7692cc71b19","0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0x20c49ba5e353f80000000000000000","0x3e8","0x0","0x50694de2ea0cc7","0x0","0x0","0x3cd31167bc50d7b98f9e33ad84cad","0x0","0x64","0x50694de2ea0cc7","0x0","0x4898951","0x1","0x3cd341bc999b9c7650342f4f36369","0x0","0x129b280","0x1","0x18081b7d6b26352"],"from_address":"0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","keys":["0x157717768aca88da4ac4279765f09f4d0151823d573537fbbeb950cdbd9a870"]},{"data":["0x2e4d0591d14134c1fc914cc9cdd43fe7a21826ec73efe7bc276a7692cc71b19","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0x50694de2ea0cc7","0x0"],"from_address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x2e4d0591d14134c1fc914cc9cdd43fe7a21826ec73efe7bc276a7692cc71b19","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0x0","0x0"],"from_address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x2e4d0591d14134c1fc914cc9cdd43fe7a21826ec73efe7bc276a7692cc71b19","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0x50694de2ea0cc7","0x0"],"from_address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0x2e4d0591d14134c1fc914cc9cdd43fe7a21826ec73efe7bc276a7692cc71b19","0x4898951","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x2e4d0591d14134c1fc914cc9cdd43fe7a21826ec73efe7bc276a7692cc71b19","0x1628f102c41077cfee92623e208df9563227b2d7ae38f4db398f432948d1c9a","0x4898951","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x1628f102c41077cfee92623e208df9563227b2d7ae38f4db398f432948d1c9a","0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","0x50694de2ea0cc7","0x0","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0x4898951","0x0","0x1628f102c41077cfee92623e208df9563227b2d7ae38f4db398f432948d1c9a"],"from_address":"0x2e4d0591d14134c1fc914cc9cdd43fe7a21826ec73efe7bc276a7692cc71b19","keys":["0xe316f0d9d2a3affa97de1d99bb2aac0538e2666d0d8545545ead241ef0ccab"]},{"data":["0x4","0x1","0x1","0x1","0x1","0x1","0x1","0x1","0x1"],"from_address":"0x1628f102c41077cfee92623e208df9563227b2d7ae38f4db398f432948d1c9a","keys":["0x1dcde06aabdbca2f80aa51392b345d7549d7757aa855f7e37f5d335ac8243b1","0x27714ad8f704f12ae887e1556ef174721f48e78bd41d429a054f3d4d14df402"]},{"data":["0x1628f102c41077cfee92623e208df9563227b2d7ae38f4db398f432948d1c9a","0x1176a1bd84444c89232ec27754698e5d2e7e1a7f1539f12027f28b23ec9f3d8","0x34ad4312e1c6","0x0"],"from_address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]}],"execution_resources":{"bitwise_builtin_applications":113,"data_availability":{"l1_data_gas":1216,"l1_gas":0},"ec_op_builtin_applications":3,"pedersen_builtin_applications":192,"poseidon_builtin_applications":20,"range_check_builtin_applications":18679,"steps":215052},"execution_status":"SUCCEEDED","finality_status":"ACCEPTED_ON_L2","messages_sent":[],"transaction_hash":"0x27714ad8f704f12ae887e1556ef174721f48e78bd41d429a054f3d4d14df402","type":"INVOKE"},"transaction":{"calldata":["0x4","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0x219209e083275171774dab1df80982e9df2096516f06319c5c6d71ae0a8480c","0x3","0x2e4d0591d14134c1fc914cc9cdd43fe7a21826ec73efe7bc276a7692cc71b19","0x487ab00","0x0","0x2e4d0591d14134c1fc914cc9cdd43fe7a21826ec73efe7bc276a7692cc71b19","0x1171593aa5bdadda4d6b0efde6cc94ee7649c3163d5efeb19da6c16d63a2a63","0x13","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0x487ab00","0x0","0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","0x50694de2ea0cc7","0x0","0x506111f383c2d6","0x0","0x1628f102c41077cfee92623e208df9563227b2d7ae38f4db398f432948d1c9a","0x0","0x0","0x1","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","0x38925b0bcf4dce081042ca26a96300d9e181b910328db54a6c89e5451503f5","0xe8d4a51000","0x2","0x4694e144244c6eadbbb5abd97e6aa47279a3897ae038edacdf9be483a09c8e1","0x1b64f80645c3e05920","0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","0x219209e083275171774dab1df80982e9df2096516f06319c5c6d71ae0a8480c","0x3","0x2e4d0591d14134c1fc914cc9cdd43fe7a21826ec73efe7bc276a7692cc71b19","0x506d6c10968588","0x0","0x2e4d0591d14134c1fc914cc9cdd43fe7a21826ec73efe7bc276a7692cc71b19","0x1171593aa5bdadda4d6b0efde6cc94ee7649c3163d5efeb19da6c16d63a2a63","0x17","0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","0x50694de2ea0cc7","0x0","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0x4894dd9","0x0","0x489319a","0x0","0x1628f102c41077cfee92623e208df9563227b2d7ae38f4db398f432948d1c9a","0x0","0x0","0x1","0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0xe8d4a51000","0x6","0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0x20c49ba5e353f80000000000000000","0x3e8","0x0","0x60aa423636f3ac79dca06f583"],"max_fee":"0x4e81e4c963f4","nonce":"0x72db","sender_address":"0x1628f102c41077cfee92623e208df9563227b2d7ae38f4db398f432948d1c9a","signature":["0x662ee2660e7921bdea1f9b91531a907d156053124a34a2326132e1b79243ba6","0x2d43ea01023920b5fe858463429ab9fa666044ac446d8ebfa0d60daa2d28c76"],"transaction_hash":"0x27714ad8f704f12ae887e1556ef174721f48e78bd41d429a054f3d4d14df402","type":"INVOKE","version":"0x1"}},{"receipt":{"actual_fee":{"amount":"0x10cd338e103abfa33","unit":"FRI"},"events":[{"data":["0x56bdbb6dee0474cb49c88558d1c7964d4b40527a511c5161aeba948992eb132","0x51ba9be967d17aaafac92f9bc7ca4b035dfd3c4a97b32be1773f63e27b0526a","0x10ec88c9ef96c0000","0x0"],"from_address":"0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x56bdbb6dee0474cb49c88558d1c7964d4b40527a511c5161aeba948992eb132","0x0","0x22040f4e40","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x22040f4e40","0x0"],"from_address":"0x5cd48fccbfd8aa2773fe22c217e808319ffcc1c5a6a463f7d8fa2da48218196","keys":["0x282f521c69b2bc696552b9e141009d3c84f2df75e2e7b7716644d31e60f23b1","0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48","0xa33c2c76572b6dee9b82b98a7463205372854a81","0x56bdbb6dee0474cb49c88558d1c7964d4b40527a511c5161aeba948992eb132"]},{"data":["0x2","0x1","0x1","0x0"],"from_address":"0x56bdbb6dee0474cb49c88558d1c7964d4b40527a511c5161aeba948992eb132","keys":["0x1dcde06aabdbca2f80aa51392b345d7549d7757aa855f7e37f5d335ac8243b1","0x44ee1bea5e9e858a87da4e2229f90af4a7cc3b356cf57410b80b395f1d8af13"]},{"data":["0x56bdbb6dee0474cb49c88558d1c7964d4b40527a511c5161aeba948992eb132","0x1176a1bd84444c89232ec27754698e5d2e7e1a7f1539f12027f28b23ec9f3d8","0x10cd338e103abfa33","0x0"],"from_address":"0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]}],"execution_resources":{"data_availability":{"l1_data_gas":384,"l1_gas":0},"ec_op_builtin_applications":3,"pedersen_builtin_applications":37,"poseidon_builtin_applications":7,"range_check_builtin_applications":527,"steps":17348},"execution_status":"SUCCEEDED","finality_status":"ACCEPTED_ON_L2","messages_sent":[{"from_address":"0x5cd48fccbfd8aa2773fe22c217e808319ffcc1c5a6a463f7d8fa2da48218196","payload":["0x0","0xa33c2c76572b6dee9b82b98a7463205372854a81","0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48","0x22040f4e40","0x0"],"to_address":"0xf6080d9fbeebcd44d89affbfd42f098cbff92816"}],"transaction_hash":"0x44ee1bea5e9e858a87da4e2229f90af4a7cc3b356cf57410b80b395f1d8af13","type":"INVOKE"},"transaction":{"account_deployment_data":[],"calldata":["0x2","0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","0x83afd3f4caedc6eebf44246fe54e38c95e3179a5ec9ea81740eca5b482d12e","0x3","0x51ba9be967d17aaafac92f9bc7ca4b035dfd3c4a97b32be1773f63e27b0526a","0x10ec88c9ef96c0000","0x0","0x5cd48fccbfd8aa2773fe22c217e808319ffcc1c5a6a463f7d8fa2da48218196","0xe5b455a836c7a254df57ed39d023d46b641b331162c6c0b369647056655409","0x4","0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48","0xa33c2c76572b6dee9b82b98a7463205372854a81","0x22040f4e40","0x0"],"fee_data_availability_mode":"L1","nonce":"0x434","nonce_data_availability_mode":"L1","paymaster_data":[],"resource_bounds":{"l1_gas":{"max_amount":"0x17ada","max_price_per_unit":"0x331534bb5b390"},"l2_gas":{"max_amount":"0x0","max_price_per_unit":"0x0"}},"sender_address":"0x56bdbb6dee0474cb49c88558d1c7964d4b40527a511c5161aeba948992eb132","signature":["0x1","0x0","0x1ca412d9a75eab4ff03d12b6bc4451c307281edc1334e7cedeb559585da8180","0x1472dff2b6bfc6ac7ee33e9f4ec86ae5246adb30d486ff370534e6e38cd6247","0x71d40feebb8ec931fb5fd32c3ece8a4f8d7c0c937ee7b49a70276b195150fbe"],"tip":"0x0","transaction_hash":"0x44ee1bea5e9e858a87da4e2229f90af4a7cc3b356cf57410b80b395f1d8af13","type":"INVOKE","version":"0x3"}},{"receipt":{"actual_fee":{"amount":"0xe9ffaee8d68","unit":"WEI"},"events":[{"data":["0x2deb747fb7b448dad3ebdd2e7fb96f002b3e5e6613e57f012cbbbbe7d7f8d7d","0x453c4c996f1047d9370f824d68145bd5e7ce12d00437140ad02181e1d11dc83","0x4be8a198","0x0"],"from_address":"0x68f5c6a61780768455de69077e07e89787839bf8166decfbf92b645209c0fb8","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x678d10c5"],"from_address":"0x59a943ca214c10234b9a3b61c558ac20c005127d183b86a99a8f3c60a08b4ff","keys":["0x624ef3ac9a411845bcc671de3155f9c27c29a2539be56d17acff46d0747877","0x24e9b0d6bc79e111e6872bb1ada2a874c25712cf08dfc5bcf0de008a7cca55f"]},{"data":["0xeb8497ca2142dc4","0x0"],"from_address":"0x59a943ca214c10234b9a3b61c558ac20c005127d183b86a99a8f3c60a08b4ff","keys":["0x2d869564c7a090f9b3cd65b3da756ce0477042ddacf02cf39dd19ca4c223e5f","0x24e9b0d6bc79e111e6872bb1ada2a874c25712cf08dfc5bcf0de008a7cca55f"]},{"data":["0xf77c1e3db5d247c","0x0"],"from_address":"0x59a943ca214c10234b9a3b61c558ac20c005127d183b86a99a8f3c60a08b4ff","keys":["0x3fe378208b9b410fecd7e94ee45037c8715f0f12c7a8455bde0f35277b83d65","0x24e9b0d6bc79e111e6872bb1ada2a874c25712cf08dfc5bcf0de008a7cca55f"]},{"data":["0x32929","0x0"],"from_address":"0x360f9786a6595137f84f2d6931aaec09ceec476a94a98dcad2bb092c6c06701","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9","0x0","0x25a55e8860426582eefb8a54615ce79177f220a41362a202175cce6eaa30f49"]},{"data":["0x35a42","0x0"],"from_address":"0x360f9786a6595137f84f2d6931aaec09ceec476a94a98dcad2bb092c6c06701","keys":["0x34e55c1cd55f1338241b50d352f0e91c7e4ffad0e4271d64eb347589ebdfd16","0x25a55e8860426582eefb8a54615ce79177f220a41362a202175cce6eaa30f49"]},{"data":["0x2deb747fb7b448dad3ebdd2e7fb96f002b3e5e6613e57f012cbbbbe7d7f8d7d","0x453c4c996f1047d9370f824d68145bd5e7ce12d00437140ad02181e1d11dc83","0x0","0x0"],"from_address":"0x68f5c6a61780768455de69077e07e89787839bf8166decfbf92b645209c0fb8","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x2deb747fb7b448dad3ebdd2e7fb96f002b3e5e6613e57f012cbbbbe7d7f8d7d","0x59a943ca214c10234b9a3b61c558ac20c005127d183b86a99a8f3c60a08b4ff","0x4be8a198","0x0"],"from_address":"0x68f5c6a61780768455de69077e07e89787839bf8166decfbf92b645209c0fb8","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x4790f192","0x0"],"from_address":"0x453c4c996f1047d9370f824d68145bd5e7ce12d00437140ad02181e1d11dc83","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9","0x0","0x2deb747fb7b448dad3ebdd2e7fb96f002b3e5e6613e57f012cbbbbe7d7f8d7d"]},{"data":["0x4be8a198","0x0"],"from_address":"0x453c4c996f1047d9370f824d68145bd5e7ce12d00437140ad02181e1d11dc83","keys":["0x34e55c1cd55f1338241b50d352f0e91c7e4ffad0e4271d64eb347589ebdfd16","0x2deb747fb7b448dad3ebdd2e7fb96f002b3e5e6613e57f012cbbbbe7d7f8d7d"]},{"data":["0xf3e9aba969be53","0x0"],"from_address":"0x59a943ca214c10234b9a3b61c558ac20c005127d183b86a99a8f3c60a08b4ff","keys":["0x3f1547f5c752a56ed475433772ec93cab0ef5ff7786632287d7381d30d305ae","0x24e9b0d6bc79e111e6872bb1ada2a874c25712cf08dfc5bcf0de008a7cca55f"]},{"data":["0x1a55d2cd78fd138","0x0"],"from_address":"0x59a943ca214c10234b9a3b61c558ac20c005127d183b86a99a8f3c60a08b4ff","keys":["0x49d3e14a9a5551aa9aa4e7ce97f74cc7357d95f68db2d55fc291f2e07af82d","0x24e9b0d6bc79e111e6872bb1ada2a874c25712cf08dfc5bcf0de008a7cca55f"]},{"data":["0xf3e9aba969be53","0x0","0x1a55d2cd78fd138","0x0","0xeb8497ca2142dc4","0x0","0xf77c1e3db5d247c","0x0"],"from_address":"0x59a943ca214c10234b9a3b61c558ac20c005127d183b86a99a8f3c60a08b4ff","keys":["0x33db1d611576200c90997bde1f948502469d333e65e87045c250e6efd2e42c7","0x24e9b0d6bc79e111e6872bb1ada2a874c25712cf08dfc5bcf0de008a7cca55f"]},{"data":["0x2","0x1","0x1","0x0"],"from_address":"0x2deb747fb7b448dad3ebdd2e7fb96f002b3e5e6613e57f012cbbbbe7d7f8d7d","keys":["0x1dcde06aabdbca2f80aa51392b345d7549d7757aa855f7e37f5d335ac8243b1","0x105dd4b4333cdc45ab838314c1ea15791512b124e621db841393e45263fd19b"]},{"data":["0x2deb747fb7b448dad3ebdd2e7fb96f002b3e5e6613e57f012cbbbbe7d7f8d7d","0x1176a1bd84444c89232ec27754698e5d2e7e1a7f1539f12027f28b23ec9f3d8","0xe9ffaee8d68","0x0"],"from_address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]}],"execution_resources":{"data_availability":{"l1_data_gas":1088,"l1_gas":0},"ec_op_builtin_applications":3,"pedersen_builtin_applications":97,"poseidon_builtin_applications":18,"range_check_builtin_applications":3462,"steps":80599},"execution_status":"SUCCEEDED","finality_status":"ACCEPTED_ON_L2","messages_sent":[],"transaction_hash":"0x105dd4b4333cdc45ab838314c1ea15791512b124e621db841393e45263fd19b","type":"INVOKE"},"transaction":{"calldata":["0x2","0x68f5c6a61780768455de69077e07e89787839bf8166decfbf92b645209c0fb8","0x219209e083275171774dab1df80982e9df2096516f06319c5c6d71ae0a8480c","0x3","0x453c4c996f1047d9370f824d68145bd5e7ce12d00437140ad02181e1d11dc83","0x4be8a198","0x0","0x453c4c996f1047d9370f824d68145bd5e7ce12d00437140ad02181e1d11dc83","0x2f0b3c5710379609eb5495f1ecd348cb28167711b73609fe565a72734550354","0x3","0x2deb747fb7b448dad3ebdd2e7fb96f002b3e5e6613e57f012cbbbbe7d7f8d7d","0x4be8a198","0x0"],"max_fee":"0x2b0ff077afb4","nonce":"0x3a","sender_address":"0x2deb747fb7b448dad3ebdd2e7fb96f002b3e5e6613e57f012cbbbbe7d7f8d7d","signature":["0x1","0x0","0x142617fcfdcb59023d5951fdcfdda8d86de45b270f2ddfe6aed658bd2336991","0x365097f9eb56f9e8db13db2cbc6801390ee2dad10845ac33da4fb4ec96548c8","0x579a2532637b26a4855af3d3882da892cc0711045af6ee926506ee2a0fe4808"],"transaction_hash":"0x105dd4b4333cdc45ab838314c1ea15791512b124e621db841393e45263fd19b","type":"INVOKE","version":"0x1"}},{"receipt":{"actual_fee":{"amount":"0x89d1364635f1674","unit":"FRI"},"events":[{"data":["0x91e997822ba21d2cee6b7d6eea9a76c7d07c32dc2262adca1a22dafadaaa30","0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0xbeeca41","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x91e997822ba21d2cee6b7d6eea9a76c7d07c32dc2262adca1a22dafadaaa30","0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x7b37e","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x91e997822ba21d2cee6b7d6eea9a76c7d07c32dc2262adca1a22dafadaaa30","0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0xbe716c3","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0x68f5c6a61780768455de69077e07e89787839bf8166decfbf92b645209c0fb8","0x14f8b588e368f1000000000000000","0x14","0x0","0xbe716c3","0x0","0x0","0x20dc02b6d6671f2978405a52e25259","0x1","0x64","0xbe716c3","0x0","0xbea15c4","0x1","0x20dd6e3467186a8fd2eef4eb254f64","0x1","0x3ea","0x0","0x8641f2284ec41"],"from_address":"0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","keys":["0x157717768aca88da4ac4279765f09f4d0151823d573537fbbeb950cdbd9a870"]},{"data":["0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0xbe716c3","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0x0","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0xbe716c3","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0xbea15c4","0x0"],"from_address":"0x68f5c6a61780768455de69077e07e89787839bf8166decfbf92b645209c0fb8","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x1114c7103e12c2b2ecbd3a2472ba9c48ddcbf702b1c242dd570057e26212111","0xbea15c4","0x0"],"from_address":"0x68f5c6a61780768455de69077e07e89787839bf8166decfbf92b645209c0fb8","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x1114c7103e12c2b2ecbd3a2472ba9c48ddcbf702b1c242dd570057e26212111","0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0xd346ca91286856","0x0"],"from_address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x1114c7103e12c2b2ecbd3a2472ba9c48ddcbf702b1c242dd570057e26212111","0x0","0x0"],"from_address":"0x68f5c6a61780768455de69077e07e89787839bf8166decfbf92b645209c0fb8","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x1114c7103e12c2b2ecbd3a2472ba9c48ddcbf702b1c242dd570057e26212111","0xbea15c4","0x0"],"from_address":"0x68f5c6a61780768455de69077e07e89787839bf8166decfbf92b645209c0fb8","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","0x68f5c6a61780768455de69077e07e89787839bf8166decfbf92b645209c0fb8","0x1f4","0xa","0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0xd346ca91286856","0x0","0x1","0xbea15c4","0x0","0x0","0x3cda3a55d874cc6f63e85","0x0","0x4ecf706e32e08","0xa8fcc"],"from_address":"0x1114c7103e12c2b2ecbd3a2472ba9c48ddcbf702b1c242dd570057e26212111","keys":["0xe316f0d9d2a3affa97de1d99bb2aac0538e2666d0d8545545ead241ef0ccab"]},{"data":["0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","0x20c49ba5e353f80000000000000000","0x3e8","0x0","0xd346ca91286856","0x0","0x1","0x2d9bd24ee317fe8ff73e56a00324732","0x0","0x64","0x1a02b948b169080e8a","0x1","0xd346ca91286856","0x0","0x2d987d0c8b62a5b25c0f12ab6314a28","0x0","0x894448","0x1","0x3f78e7f76ea06541679"],"from_address":"0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","keys":["0x157717768aca88da4ac4279765f09f4d0151823d573537fbbeb950cdbd9a870"]},{"data":["0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0xd346ca91286856","0x0"],"from_address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0x0","0x0"],"from_address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0xd346ca91286856","0x0"],"from_address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x1a02b948b169080e8a","0x0"],"from_address":"0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x91e997822ba21d2cee6b7d6eea9a76c7d07c32dc2262adca1a22dafadaaa30","0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x4fe66","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x91e997822ba21d2cee6b7d6eea9a76c7d07c32dc2262adca1a22dafadaaa30","0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x2b518","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0x68f5c6a61780768455de69077e07e89787839bf8166decfbf92b645209c0fb8","0x14f8b588e368f1000000000000000","0x14","0x0","0x2b518","0x0","0x0","0x20da973946186a8f87c1fa0bff6080","0x1","0x64","0x2b518","0x0","0x2b5c4","0x1","0x20dd6de1b937c4d3ca18b2f9809a9e","0x1","0x3ea","0x0","0x8641f2284ec41"],"from_address":"0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","keys":["0x157717768aca88da4ac4279765f09f4d0151823d573537fbbeb950cdbd9a870"]},{"data":["0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0x2b518","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0x0","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0x2b518","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x2b5c4","0x0"],"from_address":"0x68f5c6a61780768455de69077e07e89787839bf8166decfbf92b645209c0fb8","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x1114c7103e12c2b2ecbd3a2472ba9c48ddcbf702b1c242dd570057e26212111","0x2b5c4","0x0"],"from_address":"0x68f5c6a61780768455de69077e07e89787839bf8166decfbf92b645209c0fb8","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x1114c7103e12c2b2ecbd3a2472ba9c48ddcbf702b1c242dd570057e26212111","0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x2ff012662f21","0x0"],"from_address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x1114c7103e12c2b2ecbd3a2472ba9c48ddcbf702b1c242dd570057e26212111","0x0","0x0"],"from_address":"0x68f5c6a61780768455de69077e07e89787839bf8166decfbf92b645209c0fb8","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x1114c7103e12c2b2ecbd3a2472ba9c48ddcbf702b1c242dd570057e26212111","0x2b5c4","0x0"],"from_address":"0x68f5c6a61780768455de69077e07e89787839bf8166decfbf92b645209c0fb8","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","0x68f5c6a61780768455de69077e07e89787839bf8166decfbf92b645209c0fb8","0x1f4","0xa","0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x2ff012662f21","0x0","0x1","0x2b5c4","0x0","0x0","0x3cda4322477e9ae06115c","0x0","0x4ecf706e32e08","0xa8fcc"],"from_address":"0x1114c7103e12c2b2ecbd3a2472ba9c48ddcbf702b1c242dd570057e26212111","keys":["0xe316f0d9d2a3affa97de1d99bb2aac0538e2666d0d8545545ead241ef0ccab"]},{"data":["0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","0x20c49ba5e353f80000000000000000","0x3e8","0x0","0x2ff012662f21","0x0","0x1","0x2d9f2791356c39b28582dc8ebcf1e34","0x0","0x64","0x5e667d0e2082065","0x1","0x2ff012662f21","0x0","0x2d987dcdcb0bcbf8d51ea02b9b13ccd","0x0","0x894448","0x1","0x3f78e7f76ea06541679"],"from_address":"0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","keys":["0x157717768aca88da4ac4279765f09f4d0151823d573537fbbeb950cdbd9a870"]},{"data":["0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0x2ff012662f21","0x0"],"from_address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0x0","0x0"],"from_address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0x2ff012662f21","0x0"],"from_address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x5e667d0e2082065","0x0"],"from_address":"0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x91e997822ba21d2cee6b7d6eea9a76c7d07c32dc2262adca1a22dafadaaa30","0x1a054f3c3886769c21","0x0"],"from_address":"0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x360fb3a51bd291e5db0892b6249918a5689bc61760adcb350fe39cd725e1d22","0x3507449c49992ce","0x0"],"from_address":"0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x91e997822ba21d2cee6b7d6eea9a76c7d07c32dc2262adca1a22dafadaaa30","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0xbe9cbdb","0x0","0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","0x1a054f3c3886769c21","0x0","0x91e997822ba21d2cee6b7d6eea9a76c7d07c32dc2262adca1a22dafadaaa30"],"from_address":"0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","keys":["0xe316f0d9d2a3affa97de1d99bb2aac0538e2666d0d8545545ead241ef0ccab"]},{"data":["0x91e997822ba21d2cee6b7d6eea9a76c7d07c32dc2262adca1a22dafadaaa30","0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x0","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x91e997822ba21d2cee6b7d6eea9a76c7d07c32dc2262adca1a22dafadaaa30","0x1176a1bd84444c89232ec27754698e5d2e7e1a7f1539f12027f28b23ec9f3d8","0x89d1364635f1674","0x0"],"from_address":"0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]}],"execution_resources":{"bitwise_builtin_applications":341,"data_availability":{"l1_data_gas":2240,"l1_gas":0},"ec_op_builtin_applications":3,"pedersen_builtin_applications":430,"poseidon_builtin_applications":36,"range_check_builtin_applications":20619,"steps":394606},"execution_status":"SUCCEEDED","finality_status":"ACCEPTED_ON_L2","messages_sent":[],"transaction_hash":"0x2992830b92606f410f45aa29c09a806ce78fc90bd2b7426429c05f1cc8045c2","type":"INVOKE"},"transaction":{"account_deployment_data":[],"calldata":["0x3","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0x219209e083275171774dab1df80982e9df2096516f06319c5c6d71ae0a8480c","0x3","0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0xbeeca41","0x0","0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0xe9f3b52dc560050c4c679481500c1b1e2ba7496b6a0831638c1acaedcbc6ac","0x27","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0xbe716c3","0x0","0xbeeca41","0x0","0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","0x1a054f3c3886769c21","0x0","0x91e997822ba21d2cee6b7d6eea9a76c7d07c32dc2262adca1a22dafadaaa30","0x3","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0x68f5c6a61780768455de69077e07e89787839bf8166decfbf92b645209c0fb8","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0xe8d4a51000","0x6","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0x68f5c6a61780768455de69077e07e89787839bf8166decfbf92b645209c0fb8","0x14f8b588e368f1000000000000000","0x14","0x0","0x2d6fb210000004b2cfadf25eee4","0x68f5c6a61780768455de69077e07e89787839bf8166decfbf92b645209c0fb8","0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","0x1114c7103e12c2b2ecbd3a2472ba9c48ddcbf702b1c242dd570057e26212111","0xe8d4a51000","0x2","0x30baaaf1b243f6e74c656f98dcb24b98687dcbe783d25f35854148c4c602d41","0x4c78f29a4d8770bcee0","0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0xe8d4a51000","0x6","0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","0x20c49ba5e353f80000000000000000","0x3e8","0x0","0x6aa84aa0994002973c9e359dd40c","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0x219209e083275171774dab1df80982e9df2096516f06319c5c6d71ae0a8480c","0x3","0x4270219d365d6b017231b52e92b3fb5d7c8378b05e9abc97724537a80e93b0f","0x0","0x0"],"fee_data_availability_mode":"L1","nonce":"0x234f","nonce_data_availability_mode":"L1","paymaster_data":[],"resource_bounds":{"l1_gas":{"max_amount":"0x606","max_price_per_unit":"0x331534bb6483e"},"l2_gas":{"max_amount":"0x0","max_price_per_unit":"0x0"}},"sender_address":"0x91e997822ba21d2cee6b7d6eea9a76c7d07c32dc2262adca1a22dafadaaa30","signature":["0x29ec7e6b63583903944d6c9f83e071cdce7d2ef7374f1a33c811fcbedcfbc9","0x61dfcc4bda33cf44906949ed458a373f687dae02944263ace01dab298d33b36"],"tip":"0x0","transaction_hash":"0x2992830b92606f410f45aa29c09a806ce78fc90bd2b7426429c05f1cc8045c2","type":"INVOKE","version":"0x3"}},{"receipt":{"actual_fee":{"amount":"0x1073fa4c38a5","unit":"WEI"},"events":[{"data":["0x2df27296be2bee0c171c8633530bbbd91d880dcc0556bd966219098ab4096e4","0x4505a9f06f2bd639b6601f37a4dc0908bb70e8e0e0c34b1220827d64f4fc066","0xee6b280","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x4505a9f06f2bd639b6601f37a4dc0908bb70e8e0e0c34b1220827d64f4fc066","0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0x20c49ba5e353f80000000000000000","0x3e8","0x0","0xee6b280","0x0","0x1","0x6f3528fe26840249f4b191ef6dff7928","0xfffffc080ed7b455","0x0","0x20833e4acbaabc3f87","0x1","0xee6b280","0x0","0xad46679e73a2a94f1b5bfea7dff","0x0","0x1b2f981","0x1","0x37f5b4bbee17d9ba"],"from_address":"0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","keys":["0x157717768aca88da4ac4279765f09f4d0151823d573537fbbeb950cdbd9a870"]},{"data":["0x4505a9f06f2bd639b6601f37a4dc0908bb70e8e0e0c34b1220827d64f4fc066","0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","0x20c49ba5e353f80000000000000000","0x3e8","0x0","0x20833e4acbaabc3f87","0x0","0x0","0x1000003f7f1380b75","0x0","0x0","0x20833e4acbaabc3f87","0x0","0x107cecfecbc1197","0x1","0x2d9453ca4aa3f6a1f82e190cb4f3d09","0x0","0x894711","0x1","0x3f55f028ad1b2013f78"],"from_address":"0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","keys":["0x157717768aca88da4ac4279765f09f4d0151823d573537fbbeb950cdbd9a870"]},{"data":["0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0x4505a9f06f2bd639b6601f37a4dc0908bb70e8e0e0c34b1220827d64f4fc066","0x107cecfecbc1197","0x0"],"from_address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x4505a9f06f2bd639b6601f37a4dc0908bb70e8e0e0c34b1220827d64f4fc066","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0xee6b280","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x4505a9f06f2bd639b6601f37a4dc0908bb70e8e0e0c34b1220827d64f4fc066","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0x0","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x134692b230b9e1ffa39098904722134159652b09c5bc41d88d6698779d228ff"]},{"data":["0x4505a9f06f2bd639b6601f37a4dc0908bb70e8e0e0c34b1220827d64f4fc066","0x5dd3d2f4429af886cd1a3b08289dbcea99a294197e9eb43b0e0325b4b","0xee6b280","0x0"],"from_address":"0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x4505a9f06f2bd639b6601f37a4dc0908bb70e8e0e0c34b1220827d64f4fc066","0x2df27296be2bee0c171c8633530bbbd91d880dcc0556bd966219098ab4096e4","0x107cecfecbc1197","0x0"],"from_address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x2df27296be2bee0c171c8633530bbbd91d880dcc0556bd966219098ab4096e4","0x1176a1bd84444c89232ec27754698e5d2e7e1a7f1539f12027f28b23ec9f3d8","0x1073fa4c38a5","0x0"],"from_address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]}],"execution_resources":{"bitwise_builtin_applications":113,"data_availability":{"l1_data_gas":896,"l1_gas":0},"ec_op_builtin_applications":3,"pedersen_builtin_applications":149,"poseidon_builtin_applications":15,"range_check_builtin_applications":5695,"steps":84757},"execution_status":"SUCCEEDED","finality_status":"ACCEPTED_ON_L2","messages_sent":[],"transaction_hash":"0x709dc57aa8c1de005b5f15db1eee4e9e2fed95ed2f10a079e015e1668d807ec","type":"INVOKE"},"transaction":{"calldata":["0x4","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0x83afd3f4caedc6eebf44246fe54e38c95e3179a5ec9ea81740eca5b482d12e","0x3","0x4505a9f06f2bd639b6601f37a4dc0908bb70e8e0e0c34b1220827d64f4fc066","0xee6b280","0x0","0x4505a9f06f2bd639b6601f37a4dc0908bb70e8e0e0c34b1220827d64f4fc066","0x23170181ce94904a6579426f958734a9e1ae9bd25082143abf393288c83bbb1","0x14","0x2","0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0x20c49ba5e353f80000000000000000","0x3e8","0x0","0x0","0x0","0x0","0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","0x20c49ba5e353f80000000000000000","0x3e8","0x0","0x0","0x0","0x0","0x53c91253bc9682c04929ca02ed00b3e423f6710d2ee7e0d5ebb06f3ecf368a8","0xee6b280","0x0","0x4505a9f06f2bd639b6601f37a4dc0908bb70e8e0e0c34b1220827d64f4fc066","0x2e1d93dafae32660a4a76a0fd6f31550f3ddfd6a51c29ef2e055b80afbbd011","0x3","0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","0x10777847fb6a8c0","0x0","0x4505a9f06f2bd639b6601f37a4dc0908bb70e8e0e0c34b1220827d64f4fc066","0x292f3f4df7749c2ae1fdc3379303c2e6caa9bbc3033ee67709fde5b77f65836","0x1","0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7"],"max_fee":"0x2b800c2ce4578","nonce":"0xfeb0","sender_address":"0x2df27296be2bee0c171c8633530bbbd91d880dcc0556bd966219098ab4096e4","signature":["0x5c52e400f134ce42e2b42a66a954e6a3d7b296abc553f598ad851dd27e310da","0x1503b60c70fde4588c8d6aba95ad312a1044cc079b56d4676258e6cc2f49d71"],"transaction_hash":"0x709dc57aa8c1de005b5f15db1eee4e9e2fed95ed2f10a079e015e1668d807ec","type":"INVOKE","version":"0x1"}},{"receipt":{"actual_fee":{"amount":"0x2a5146a9ff17","unit":"WEI"},"events":[{"data":["0x7fe5cf2bea00000","0x0"],"from_address":"0x269b6a4501f60b6012dd659cdaec077cca07b073aa0f6ef5cf710ae804b68f7","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9","0x23f63ecaeb91d32523cf8c5d1535edcd8e2bca123708da8f51e0b360c1fc2aa","0x76b8fbb6f543a5c54c85f6a1866791833ebe5912b0939e48953b74af9589836"]},{"data":["0x429d069189e00000","0x0"],"from_address":"0x269b6a4501f60b6012dd659cdaec077cca07b073aa0f6ef5cf710ae804b68f7","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9","0x23f63ecaeb91d32523cf8c5d1535edcd8e2bca123708da8f51e0b360c1fc2aa","0x58bc45f98967a87ff8c788c3e0c058b1741d346271fe0eb8ede8ddce637fc11"]},{"data":["0x58bc45f98967a87ff8c788c3e0c058b1741d346271fe0eb8ede8ddce637fc11","0x429d069189e00000","0x0","0x363738643130646362343530383762393462643333313634","0x1","0x76b8fbb6f543a5c54c85f6a1866791833ebe5912b0939e48953b74af9589836","0x7fe5cf2bea00000","0x0"],"from_address":"0x23f63ecaeb91d32523cf8c5d1535edcd8e2bca123708da8f51e0b360c1fc2aa","keys":["0x12401fe39f465ce67b760f03b5e26d762b45d2cff1972e6e92f2cab2ccd0032"]},{"data":["0x1","0x0"],"from_address":"0x58bc45f98967a87ff8c788c3e0c058b1741d346271fe0eb8ede8ddce637fc11","keys":["0x1dcde06aabdbca2f80aa51392b345d7549d7757aa855f7e37f5d335ac8243b1","0x41dd6f18cf3d8c1d519d24bc4dc53d52d97d9735acb4054d78cbca23dee9726"]},{"data":["0x1","0x1","0x1"],"from_address":"0x762aab106f0100a73c71c1d0010464da1486fd13a5b13705fc7f8aab1fec16e","keys":["0x1dcde06aabdbca2f80aa51392b345d7549d7757aa855f7e37f5d335ac8243b1","0x146cfbc82237db8c5a96db87181e97415238825bc246efce7573a88a9d0f052"]},{"data":["0x762aab106f0100a73c71c1d0010464da1486fd13a5b13705fc7f8aab1fec16e","0x1176a1bd84444c89232ec27754698e5d2e7e1a7f1539f12027f28b23ec9f3d8","0x2a5146a9ff17","0x0"],"from_address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]}],"execution_resources":{"bitwise_builtin_applications":30,"data_availability":{"l1_data_gas":640,"l1_gas":0},"ec_op_builtin_applications":6,"keccak_builtin_applications":1,"pedersen_builtin_applications":99,"poseidon_builtin_applications":14,"range_check_builtin_applications":15425,"steps":183436},"execution_status":"SUCCEEDED","finality_status":"ACCEPTED_ON_L2","messages_sent":[],"transaction_hash":"0x146cfbc82237db8c5a96db87181e97415238825bc246efce7573a88a9d0f052","type":"INVOKE"},"transaction":{"calldata":["0x1","0x127021a1b5a52d3174c2ab077c2b043c80369250d29428cee956d76ee51584f","0x2dd871e79e84c455513b00b5f8158b7e8e6b1e8b27b499a9c4ba3c9d10b32d9","0x1a","0x58bc45f98967a87ff8c788c3e0c058b1741d346271fe0eb8ede8ddce637fc11","0x7ec457cd7ed1630225a8328f826a29a327b19486f6b2882b4176545ebdbe3d","0x17","0x127021a1b5a52d3174c2ab077c2b043c80369250d29428cee956d76ee51584f","0x982d6c0e12749c92f5ed93b0530a8ebf5f2080987a4daa670c032133e45d03","0x1","0x1947f40d78c","0x1","0x23f63ecaeb91d32523cf8c5d1535edcd8e2bca123708da8f51e0b360c1fc2aa","0x2076ff343d09a3a73d72267791f1b44ef5dc8b245573948eaa85370a2ced899","0xc","0x429d069189e00000","0x0","0x1","0x76b8fbb6f543a5c54c85f6a1866791833ebe5912b0939e48953b74af9589836","0x7fe5cf2bea00000","0x0","0x363738643130646362343530383762393462643333313634","0x228d0aa25b5ebeed0f2af2d37ff8c915","0xd28d912903024a5c9ac6bf4ed089e94a","0x21ed9fc579a67dc07f797b479b69019b","0x1e1e54b4fb287357d134c288d343dc5b","0x1c","0x2","0x56a5bda8ac61955ada682ce006e37bad72fa7f7d98fc93f07af8e4c6e000a18","0xf438db1ce4469d35c36ad9a5b273dbfeedf4d24669d29453bd04aeef3259ef"],"max_fee":"0x54a28d53fe2e","nonce":"0xb73b","sender_address":"0x762aab106f0100a73c71c1d0010464da1486fd13a5b13705fc7f8aab1fec16e","signature":["0x45f0b1cb8557079eb44b700c0c2adde744d065342de33c7a33b4c159379518b","0x2e284b1f7560628e78cf0d5441638748d3d84d3acb339f1f9e0c4e9c8c84ea3"],"transaction_hash":"0x146cfbc82237db8c5a96db87181e97415238825bc246efce7573a88a9d0f052","type":"INVOKE","version":"0x1"}},{"receipt":{"actual_fee":{"amount":"0x432a9365032","unit":"WEI"},"events":[{"data":["0x7838fe8cdd61eb445f7773d9648476b571f17242058859ed7fba9074ee915d1","0x79ad019eee628ba13861b96bc6efa11f6891bc50e7dac40c2135cd41d771495","0x8a4c18db3c36205","0x0"],"from_address":"0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]},{"data":["0x79ad019eee628ba13861b96bc6efa11f6891bc50e7dac40c2135cd41d771495","0x8ca4e3f3040749dc5"],"from_address":"0x7838fe8cdd61eb445f7773d9648476b571f17242058859ed7fba9074ee915d1","keys":["0x35cc0235f835cc84da50813dc84eb10a75e24a21d74d6d86278c0f037cb7429"]},{"data":["0x79ad019eee628ba13861b96bc6efa11f6891bc50e7dac40c2135cd41d771495","0x1176a1bd84444c89232ec27754698e5d2e7e1a7f1539f12027f28b23ec9f3d8","0x432a9365032","0x0"],"from_address":"0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","keys":["0x99cd8bde557814842a3121e8ddfd433a539b8c9f14bf31ebf108d12e6196e9"]}],"execution_resources":{"data_availability":{"l1_data_gas":448,"l1_gas":0},"ec_op_builtin_applications":3,"pedersen_builtin_applications":84,"poseidon_builtin_applications":9,"range_check_builtin_applications":908,"steps":23503},"execution_status":"SUCCEEDED","finality_status":"ACCEPTED_ON_L2","messages_sent":[],"transaction_hash":"0xc431b1172a416d3fee34407e121aa57eb3349461a5c09f62762f0f98c162f","type":"INVOKE"},"transaction":{"calldata":["0x1","0x7838fe8cdd61eb445f7773d9648476b571f17242058859ed7fba9074ee915d1","0xb758361d5e84380ef1e632f89d8e76a8677dbc3f4b93a4f9d75d2a6048f312","0xe","0x8ca4e3f3040749dc5","0xc","0x5a371668c6b57c6bc8b1f6c2d3d09d2970f4ce70eab29c1b96bb5a8c16cbf46","0x70dda50fbfa490db4e755715308749f846f216797d1a8e2502298923d6ca1b7","0x11d5c5d81b9fc56f9baab6119d650416f34734d5edecd4918da494225966667","0x75db16e70568e438bcd33bd01895c137c2916401811e8a836ec66c41a988d67","0x209f1016a104866419a0be2c71a6e66dc2964a011cb7d892b2e5ce071de2472","0x279b97050a2bf8e6be83047bde4476ca07a4a6084c7a4e922a1527a3a0f3ba1","0x7d8e3f970aa864d49e09bd63d5b8b4fc4d9ad9d904fd6205cb6250d026dd009","0x568f3f6bda25dbb0fed30670663985ae32af95abb69e7f20399b69cf3190038","0x5ad84550507c5de3125beb960fc8b3111691eea9e6adf8270f2003e816298ed","0x305710aaee00234174fb264e25b4c5e26ca078c7cdb618d922d77bec0bbdafa","0x22a83875c18c7aa38fc5114ac527df82539e241e1fdddb787207aeaaf5e8e99","0x5f13768e8e4157f6fd64d06d0b6a0da71bfae786cbaedfe84ed59942532b708"],"max_fee":"0x631fdda2d00","nonce":"0x73","sender_address":"0x79ad019eee628ba13861b96bc6efa11f6891bc50e7dac40c2135cd41d771495","signature":["0x1","0x4b0d7a7c0f37cca488b8b41eb528d0bbd8799cd2e1db0cc7535cd31d2d09564","0x64dd6c6691a487c8094c4abc3f52afa09bd0e5a9111105560c1869ce702e0e2"],"transaction_hash":"0xc431b1172a416d3fee34407e121aa57eb3349461a5c09f62762f0f98c162f","type":"INVOKE","version":"0x1"}}]}`),
 		},
+		{
+			name:  "PreConfirmed",
+			input: []byte(`{"block_number":1081036,"timestamp":1753360000,"sequencer_address":"0x1176a1bd84444c89232ec27754698e5d2e7e1a7f1539f12027f28b23ec9f3d8","l1_gas_price":{"price_in_fri":"0x220e23279857f","price_in_wei":"0x11554f5307"},"l1_data_gas_price":{"price_in_fri":"0x39c36","price_in_wei":"0x1e"},"l2_gas_price":{"price_in_fri":"0x1dcd65000","price_in_wei":"0xf4240"},"l1_da_mode":"BLOB","starknet_version":"0.14.0","transactions":[]}`),
+		},
 	}
 
 	for _, test := range tests {
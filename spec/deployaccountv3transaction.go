@@ -22,10 +22,10 @@ import (
 
 // DeployAccountV3Transaction is version 3 of the deploy account transaction.
 type DeployAccountV3Transaction struct {
-	TransactionHash           types.Hash           `json:"transaction_hash"`
+	TransactionHash           *types.Hash          `json:"transaction_hash,omitempty"`
 	Type                      TransactionType      `json:"type"`
 	Version                   TransactionVersion   `json:"version"`
-	Signature                 []types.FieldElement `json:"signature"`
+	Signature                 types.Signature      `json:"signature"`
 	Nonce                     types.Number         `json:"nonce"`
 	ContractAddressSalt       types.FieldElement   `json:"contract_address_salt"`
 	ConstructorCalldata       []types.FieldElement `json:"constructor_calldata"`
@@ -37,6 +37,43 @@ type DeployAccountV3Transaction struct {
 	FeeDataAvailabilityMode   TxDAMode             `json:"fee_data_availability_mode"`
 }
 
+// Copy provides a deep copy of the transaction.
+func (t *DeployAccountV3Transaction) Copy() *DeployAccountV3Transaction {
+	tx := &DeployAccountV3Transaction{
+		Type:                      t.Type,
+		Version:                   t.Version,
+		Nonce:                     t.Nonce,
+		ResourceBounds:            t.ResourceBounds.Copy(),
+		Tip:                       t.Tip,
+		NonceDataAvailabilityMode: t.NonceDataAvailabilityMode,
+		FeeDataAvailabilityMode:   t.FeeDataAvailabilityMode,
+	}
+	if t.TransactionHash != nil {
+		tx.TransactionHash = &types.Hash{}
+		copy(tx.TransactionHash[:], t.TransactionHash[:])
+	}
+
+	copy(tx.ContractAddressSalt[:], t.ContractAddressSalt[:])
+	copy(tx.ClassHash[:], t.ClassHash[:])
+
+	tx.Signature = make([]types.FieldElement, len(t.Signature))
+	for i := range t.Signature {
+		copy(tx.Signature[i][:], t.Signature[i][:])
+	}
+
+	tx.ConstructorCalldata = make([]types.FieldElement, len(t.ConstructorCalldata))
+	for i := range t.ConstructorCalldata {
+		copy(tx.ConstructorCalldata[i][:], t.ConstructorCalldata[i][:])
+	}
+
+	tx.PaymasterData = make([]types.FieldElement, len(t.PaymasterData))
+	for i := range t.PaymasterData {
+		copy(tx.PaymasterData[i][:], t.PaymasterData[i][:])
+	}
+
+	return tx
+}
+
 // String returns a string version of the structure.
 func (t *DeployAccountV3Transaction) String() string {
 	data, err := json.Marshal(t)
@@ -34,6 +34,8 @@ const (
 	FinalityStatusAcceptedOnL1
 	// FinalityStatusRejected means the transaction has been rejected.
 	FinalityStatusRejected
+	// FinalityStatusPreConfirmed means the transaction is in a pre-confirmed block.
+	FinalityStatusPreConfirmed
 )
 
 var finalityStatusStrings = [...]string{
@@ -42,6 +44,7 @@ var finalityStatusStrings = [...]string{
 	"ACCEPTED_ON_L2",
 	"ACCEPTED_ON_L1",
 	"REJECTED",
+	"PRE_CONFIRMED",
 }
 
 // MarshalJSON implements json.Marshaler.
@@ -62,6 +65,8 @@ func (f *FinalityStatus) UnmarshalJSON(input []byte) error {
 		*f = FinalityStatusAcceptedOnL1
 	case `"REJECTED"`:
 		*f = FinalityStatusRejected
+	case `"PRE_CONFIRMED"`:
+		*f = FinalityStatusPreConfirmed
 	default:
 		err = fmt.Errorf("unrecognised finality status %s", string(input))
 	}
@@ -50,6 +50,10 @@ func TestFinalityStatus(t *testing.T) {
 			input:    []byte(`"accepted_on_l1"`),
 			expected: []byte(`"ACCEPTED_ON_L1"`),
 		},
+		{
+			name:  "PRE_CONFIRMED",
+			input: []byte(`"PRE_CONFIRMED"`),
+		},
 		{
 			name:  "Unknown",
 			input: []byte(`"unknown"`),
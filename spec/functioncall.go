@@ -0,0 +1,62 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/attestantio/go-starknet-client/types"
+)
+
+// FunctionCall is a call to a function of a contract.
+type FunctionCall struct {
+	ContractAddress    types.Address        `json:"contract_address"`
+	EntryPointSelector types.FieldElement   `json:"entry_point_selector"`
+	Calldata           []types.FieldElement `json:"calldata"`
+}
+
+// Serialize returns the Cairo serialization of the call, being the contract
+// address, entry point selector, calldata length and calldata.
+func (f *FunctionCall) Serialize() []types.FieldElement {
+	res := make([]types.FieldElement, 0, 3+len(f.Calldata))
+	res = append(res,
+		types.FieldElement(f.ContractAddress),
+		f.EntryPointSelector,
+		types.FieldElementFromUint64(uint64(len(f.Calldata))),
+	)
+
+	return append(res, f.Calldata...)
+}
+
+// SerializeFunctionCalls returns the Cairo serialization of an array of calls,
+// as used for the calldata of an account's __execute__ entry point.
+func SerializeFunctionCalls(calls []*FunctionCall) []types.FieldElement {
+	res := []types.FieldElement{types.FieldElementFromUint64(uint64(len(calls)))}
+	for _, call := range calls {
+		res = append(res, call.Serialize()...)
+	}
+
+	return res
+}
+
+// String returns a string version of the structure.
+func (f *FunctionCall) String() string {
+	data, err := json.Marshal(f)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
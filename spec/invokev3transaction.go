@@ -40,19 +40,10 @@ type InvokeV3Transaction struct {
 // Copy provides a deep copy of the transaction.
 func (t InvokeV3Transaction) Copy() *InvokeV3Transaction {
 	tx := &InvokeV3Transaction{
-		Type:    t.Type,
-		Version: t.Version,
-		Nonce:   t.Nonce,
-		ResourceBounds: ResourceBounds{
-			L1Gas: ResourceBound{
-				MaxAmount:       t.ResourceBounds.L1Gas.MaxAmount,
-				MaxPricePerUnit: t.ResourceBounds.L1Gas.MaxPricePerUnit,
-			},
-			L2Gas: ResourceBound{
-				MaxAmount:       t.ResourceBounds.L2Gas.MaxAmount,
-				MaxPricePerUnit: t.ResourceBounds.L2Gas.MaxPricePerUnit,
-			},
-		},
+		Type:                      t.Type,
+		Version:                   t.Version,
+		Nonce:                     t.Nonce,
+		ResourceBounds:            t.ResourceBounds.Copy(),
 		Tip:                       t.Tip,
 		NonceDataAvailabilityMode: t.NonceDataAvailabilityMode,
 		FeeDataAvailabilityMode:   t.FeeDataAvailabilityMode,
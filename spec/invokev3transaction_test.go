@@ -41,6 +41,10 @@ func TestInvokeV3Transaction(t *testing.T) {
 			name:  "Good",
 			input: []byte(`{"type":"INVOKE","sender_address":"0x391d69afc1b49f01ad8d2e0e8a03756b694dd056fb6645781eb00f33dbd8caf","calldata":["0x1","0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","0x83afd3f4caedc6eebf44246fe54e38c95e3179a5ec9ea81740eca5b482d12e","0x3","0x714792a41f3651e171c46c93fc53adeb922a414a891cc36d73029d23e99a6ec","0x2386f26fc10000","0x0"],"version":"0x3","signature":["0x30793b441461b3627061238a8370037cfe4ed310d1df5c74b6302ab2f1ee1ce","0x2b08553d33515ba29e8235e163718731a2c4ae55a10b036a848485c9601d236"],"nonce":"0x2","resource_bounds":{"l1_gas":{"max_amount":"0x22","max_price_per_unit":"0x9819642cfe1"},"l2_gas":{"max_amount":"0x0","max_price_per_unit":"0x0"}},"tip":"0x0","paymaster_data":[],"account_deployment_data":[],"nonce_data_availability_mode":"L1","fee_data_availability_mode":"L1"}`),
 		},
+		{
+			name:  "L1DataGas",
+			input: []byte(`{"type":"INVOKE","sender_address":"0x391d69afc1b49f01ad8d2e0e8a03756b694dd056fb6645781eb00f33dbd8caf","calldata":["0x1","0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7","0x83afd3f4caedc6eebf44246fe54e38c95e3179a5ec9ea81740eca5b482d12e","0x3","0x714792a41f3651e171c46c93fc53adeb922a414a891cc36d73029d23e99a6ec","0x2386f26fc10000","0x0"],"version":"0x3","signature":["0x30793b441461b3627061238a8370037cfe4ed310d1df5c74b6302ab2f1ee1ce","0x2b08553d33515ba29e8235e163718731a2c4ae55a10b036a848485c9601d236"],"nonce":"0x2","resource_bounds":{"l1_gas":{"max_amount":"0x22","max_price_per_unit":"0x9819642cfe1"},"l1_data_gas":{"max_amount":"0x210","max_price_per_unit":"0x97c"},"l2_gas":{"max_amount":"0xe6fa0","max_price_per_unit":"0x1920d1317"}},"tip":"0x0","paymaster_data":[],"account_deployment_data":[],"nonce_data_availability_mode":"L1","fee_data_availability_mode":"L1"}`),
+		},
 	}
 
 	for _, test := range tests {
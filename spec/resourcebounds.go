@@ -19,9 +19,26 @@ import (
 )
 
 // ResourceBounds contains a set of resource bound information.
+// L1 data gas bounds were introduced in Starknet 0.13.4, and are nil for
+// transactions that predate them.
 type ResourceBounds struct {
-	L1Gas ResourceBound `json:"l1_gas"`
-	L2Gas ResourceBound `json:"l2_gas"`
+	L1Gas     ResourceBound  `json:"l1_gas"`
+	L1DataGas *ResourceBound `json:"l1_data_gas,omitempty"`
+	L2Gas     ResourceBound  `json:"l2_gas"`
+}
+
+// Copy provides a deep copy of the resource bounds.
+func (r *ResourceBounds) Copy() ResourceBounds {
+	res := ResourceBounds{
+		L1Gas: r.L1Gas,
+		L2Gas: r.L2Gas,
+	}
+	if r.L1DataGas != nil {
+		l1DataGas := *r.L1DataGas
+		res.L1DataGas = &l1DataGas
+	}
+
+	return res
 }
 
 // String returns a string version of the structure.
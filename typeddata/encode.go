@@ -0,0 +1,504 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typeddata
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"math/big"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/attestantio/go-starknet-client/crypto"
+	"github.com/attestantio/go-starknet-client/types"
+)
+
+var (
+	// u128Limit is the exclusive upper bound of a u128.
+	u128Limit = new(big.Int).Lsh(big.NewInt(1), 128)
+	// i128Limit is the exclusive upper bound of an i128.
+	i128Limit = new(big.Int).Lsh(big.NewInt(1), 127)
+	// i128Lower is the inclusive lower bound of an i128.
+	i128Lower = new(big.Int).Neg(i128Limit)
+	// hexSelector matches selectors that are already hashed.
+	hexSelector = regexp.MustCompile("^0[xX][0-9a-fA-F]*$")
+)
+
+// encoder encodes values according to a set of types and a revision.
+type encoder struct {
+	types      map[string][]*Parameter
+	revision   Revision
+	typeHashes map[string]types.Felt
+}
+
+// newEncoder creates a new encoder.
+func newEncoder(userTypes map[string][]*Parameter, revision Revision) *encoder {
+	allTypes := make(map[string][]*Parameter, len(userTypes)+len(presetTypes))
+	maps.Copy(allTypes, userTypes)
+	if revision == Revision1 {
+		maps.Copy(allTypes, presetTypes)
+	}
+
+	return &encoder{
+		types:      allTypes,
+		revision:   revision,
+		typeHashes: make(map[string]types.Felt),
+	}
+}
+
+// encodeType returns the encoding of a type, being the type followed by its
+// dependencies in alphabetical order.
+func (e *encoder) encodeType(typeName string) (string, error) {
+	dependencies := e.dependencies(typeName, "", nil)
+	if len(dependencies) == 0 {
+		return "", fmt.Errorf("unknown type %s", typeName)
+	}
+	slices.Sort(dependencies[1:])
+
+	var builder strings.Builder
+	for _, dependency := range dependencies {
+		builder.WriteString(e.escape(dependency))
+		builder.WriteString("(")
+		for i, param := range e.types[dependency] {
+			if i > 0 {
+				builder.WriteString(",")
+			}
+			builder.WriteString(e.escape(param.Name))
+			builder.WriteString(":")
+
+			target := param.Type
+			if e.revision == Revision1 && param.Type == "enum" {
+				target = param.Contains
+			}
+			if isVariant(target) {
+				subtypes := variantSubtypes(target)
+				for j := range subtypes {
+					if subtypes[j] != "" {
+						subtypes[j] = e.escape(subtypes[j])
+					}
+				}
+				builder.WriteString("(" + strings.Join(subtypes, ",") + ")")
+			} else {
+				builder.WriteString(e.escape(target))
+			}
+		}
+		builder.WriteString(")")
+	}
+
+	return builder.String(), nil
+}
+
+// dependencies returns the type and the types upon which it depends.
+func (e *encoder) dependencies(typeName string, contains string, seen []string) []string {
+	candidates := []string{typeName}
+	switch {
+	case strings.HasSuffix(typeName, "*"):
+		candidates = []string{strings.TrimSuffix(typeName, "*")}
+	case e.revision == Revision1 && typeName == "enum":
+		candidates = []string{contains}
+	case e.revision == Revision1 && isVariant(typeName):
+		candidates = variantSubtypes(typeName)
+		for i := range candidates {
+			candidates[i] = strings.TrimSuffix(candidates[i], "*")
+		}
+	}
+
+	res := make([]string, 0)
+	for _, candidate := range candidates {
+		if slices.Contains(seen, candidate) {
+			continue
+		}
+		params, exists := e.types[candidate]
+		if !exists {
+			continue
+		}
+
+		found := []string{candidate}
+		for _, param := range params {
+			for _, dependency := range e.dependencies(param.Type, param.Contains, append(slices.Clone(seen), candidate)) {
+				if !slices.Contains(found, dependency) {
+					found = append(found, dependency)
+				}
+			}
+		}
+		for _, dependency := range found {
+			if !slices.Contains(res, dependency) {
+				res = append(res, dependency)
+			}
+		}
+	}
+
+	return res
+}
+
+// escape escapes a name for the type encoding.
+func (e *encoder) escape(name string) string {
+	if e.revision == Revision1 {
+		return `"` + name + `"`
+	}
+
+	return name
+}
+
+// typeHash returns the hash of a type.
+func (e *encoder) typeHash(typeName string) (types.Felt, error) {
+	if hash, exists := e.typeHashes[typeName]; exists {
+		return hash, nil
+	}
+
+	encoding, err := e.encodeType(typeName)
+	if err != nil {
+		return types.Felt{}, err
+	}
+
+	hash, err := types.FeltFromFieldElement(crypto.StarknetKeccak([]byte(encoding)))
+	if err != nil {
+		return types.Felt{}, err
+	}
+	e.typeHashes[typeName] = hash
+
+	return hash, nil
+}
+
+// structHash returns the hash of data as an instance of a type.
+func (e *encoder) structHash(typeName string, value any) (types.Felt, error) {
+	params, exists := e.types[typeName]
+	if !exists {
+		return types.Felt{}, fmt.Errorf("unknown type %s", typeName)
+	}
+
+	data, isMap := value.(map[string]any)
+	if !isMap {
+		return types.Felt{}, fmt.Errorf("data for type %s is not an object", typeName)
+	}
+
+	typeHash, err := e.typeHash(typeName)
+	if err != nil {
+		return types.Felt{}, err
+	}
+
+	elements := []types.Felt{typeHash}
+	for _, param := range params {
+		item, exists := data[param.Name]
+		if !exists || (item == nil && param.Type != "enum") {
+			return types.Felt{}, fmt.Errorf("missing data for %s.%s", typeName, param.Name)
+		}
+
+		element, err := e.encodeValue(param.Type, item, param)
+		if err != nil {
+			return types.Felt{}, errors.Join(fmt.Errorf("failed to encode %s.%s", typeName, param.Name), err)
+		}
+		elements = append(elements, element)
+	}
+
+	return e.revision.hash(elements...), nil
+}
+
+// encodeValue encodes a value of the given type.
+// The parameter is the definition from which the type came, if any.
+func (e *encoder) encodeValue(typeName string, value any, param *Parameter) (types.Felt, error) {
+	if _, exists := e.types[typeName]; exists {
+		return e.structHash(typeName, value)
+	}
+
+	if strings.HasSuffix(typeName, "*") {
+		return e.encodeArray(strings.TrimSuffix(typeName, "*"), value)
+	}
+
+	switch typeName {
+	case "enum":
+		if e.revision == Revision1 {
+			return e.encodeEnum(value, param)
+		}
+	case "merkletree":
+		return e.encodeMerkleTree(value, param)
+	case "selector":
+		return encodeSelector(value)
+	case "string":
+		if e.revision == Revision1 {
+			return e.encodeString(value)
+		}
+	case "i128":
+		if e.revision == Revision1 {
+			return encodeI128(value)
+		}
+	case "u128", "timestamp":
+		if e.revision == Revision1 {
+			return encodeU128(value)
+		}
+	case "bool":
+		if _, isBool := value.(bool); e.revision == Revision1 && !isBool {
+			return types.Felt{}, fmt.Errorf("value %v is not a bool", value)
+		}
+	case "felt", "shortstring", "ContractAddress", "ClassHash":
+	default:
+		if e.revision == Revision1 {
+			return types.Felt{}, fmt.Errorf("unsupported type %s", typeName)
+		}
+	}
+
+	return encodeFelt(value)
+}
+
+// encodeArray encodes an array of values of the given type.
+func (e *encoder) encodeArray(typeName string, value any) (types.Felt, error) {
+	items, isArray := value.([]any)
+	if !isArray {
+		return types.Felt{}, fmt.Errorf("value %v is not an array", value)
+	}
+
+	elements := make([]types.Felt, 0, len(items))
+	for i, item := range items {
+		element, err := e.encodeValue(typeName, item, nil)
+		if err != nil {
+			return types.Felt{}, errors.Join(fmt.Errorf("failed to encode array item %d", i), err)
+		}
+		elements = append(elements, element)
+	}
+
+	return e.revision.hash(elements...), nil
+}
+
+// encodeEnum encodes an enum, being the hash of the variant index and its encoded values.
+func (e *encoder) encodeEnum(value any, param *Parameter) (types.Felt, error) {
+	if param == nil {
+		return types.Felt{}, errors.New("enum without definition")
+	}
+	variants, exists := e.types[param.Contains]
+	if !exists {
+		return types.Felt{}, fmt.Errorf("unknown enum type %s", param.Contains)
+	}
+
+	data, isMap := value.(map[string]any)
+	if !isMap || len(data) != 1 {
+		return types.Felt{}, errors.New("enum value must be an object with a single variant")
+	}
+
+	for index, variant := range variants {
+		variantValue, exists := data[variant.Name]
+		if !exists {
+			continue
+		}
+
+		items, isArray := variantValue.([]any)
+		if !isArray {
+			return types.Felt{}, fmt.Errorf("values for variant %s are not an array", variant.Name)
+		}
+
+		elements := []types.Felt{types.FeltFromUint64(uint64(index))}
+		for i, subtype := range variantSubtypes(variant.Type) {
+			if subtype == "" {
+				elements = append(elements, types.Felt{})
+
+				continue
+			}
+			if i >= len(items) {
+				return types.Felt{}, fmt.Errorf("missing value %d for variant %s", i, variant.Name)
+			}
+			element, err := e.encodeValue(subtype, items[i], nil)
+			if err != nil {
+				return types.Felt{}, errors.Join(fmt.Errorf("failed to encode value %d for variant %s", i, variant.Name), err)
+			}
+			elements = append(elements, element)
+		}
+
+		return e.revision.hash(elements...), nil
+	}
+
+	return types.Felt{}, fmt.Errorf("unknown variant for enum %s", param.Contains)
+}
+
+// encodeMerkleTree encodes a merkle tree as its root.
+func (e *encoder) encodeMerkleTree(value any, param *Parameter) (types.Felt, error) {
+	if param == nil || param.Contains == "" {
+		return types.Felt{}, errors.New("merkle tree without contained type")
+	}
+
+	items, isArray := value.([]any)
+	if !isArray {
+		return types.Felt{}, fmt.Errorf("value %v is not an array", value)
+	}
+	if len(items) == 0 {
+		return types.Felt{}, errors.New("merkle tree has no leaves")
+	}
+
+	leaves := make([]types.Felt, 0, len(items))
+	for i, item := range items {
+		leaf, err := e.encodeValue(param.Contains, item, nil)
+		if err != nil {
+			return types.Felt{}, errors.Join(fmt.Errorf("failed to encode merkle tree leaf %d", i), err)
+		}
+		leaves = append(leaves, leaf)
+	}
+
+	return merkleRoot(leaves, e.revision.merkleHash), nil
+}
+
+// encodeString encodes a string as the hash of its byte array serialization.
+func (*encoder) encodeString(value any) (types.Felt, error) {
+	str, isString := value.(string)
+	if !isString {
+		return types.Felt{}, fmt.Errorf("value %v is not a string", value)
+	}
+
+	fieldElements := types.ByteArrayFromString(str).FieldElements()
+	elements := make([]types.Felt, 0, len(fieldElements))
+	for i := range fieldElements {
+		element, err := fieldElements[i].Felt()
+		if err != nil {
+			return types.Felt{}, err
+		}
+		elements = append(elements, element)
+	}
+
+	return crypto.PoseidonArray(elements...), nil
+}
+
+// encodeSelector encodes a selector, hashing it if it is a name.
+func encodeSelector(value any) (types.Felt, error) {
+	str, isString := value.(string)
+	if !isString {
+		return types.Felt{}, fmt.Errorf("selector %v is not a string", value)
+	}
+
+	if hexSelector.MatchString(str) {
+		return encodeFelt(str)
+	}
+
+	selector := crypto.SelectorFromName(str)
+
+	return selector.Felt()
+}
+
+// encodeI128 encodes a signed 128-bit integer, with negative values wrapping around the field.
+func encodeI128(value any) (types.Felt, error) {
+	val, err := parseValue(value)
+	if err != nil {
+		return types.Felt{}, err
+	}
+	if val.Cmp(i128Lower) < 0 || val.Cmp(i128Limit) >= 0 {
+		return types.Felt{}, fmt.Errorf("value %v out of range for i128", value)
+	}
+	if val.Sign() < 0 {
+		val.Add(val, types.FieldPrime())
+	}
+
+	return types.FeltFromBigInt(val)
+}
+
+// encodeU128 encodes an unsigned 128-bit integer.
+func encodeU128(value any) (types.Felt, error) {
+	val, err := parseValue(value)
+	if err != nil {
+		return types.Felt{}, err
+	}
+	if val.Sign() < 0 || val.Cmp(u128Limit) >= 0 {
+		return types.Felt{}, fmt.Errorf("value %v out of range for u128", value)
+	}
+
+	return types.FeltFromBigInt(val)
+}
+
+// encodeFelt encodes a value as a felt.
+func encodeFelt(value any) (types.Felt, error) {
+	val, err := parseValue(value)
+	if err != nil {
+		return types.Felt{}, err
+	}
+
+	return types.FeltFromBigInt(val)
+}
+
+// parseValue parses a scalar value to an integer.
+// Strings that are neither hex nor decimal are treated as short strings.
+func parseValue(value any) (*big.Int, error) {
+	switch val := value.(type) {
+	case bool:
+		if val {
+			return big.NewInt(1), nil
+		}
+
+		return big.NewInt(0), nil
+	case int:
+		return big.NewInt(int64(val)), nil
+	case int64:
+		return big.NewInt(val), nil
+	case uint64:
+		return new(big.Int).SetUint64(val), nil
+	case float64:
+		if val != math.Trunc(val) {
+			return nil, fmt.Errorf("value %v is not an integer", val)
+		}
+
+		res, _ := big.NewFloat(val).Int(nil)
+
+		return res, nil
+	case json.Number:
+		res, success := new(big.Int).SetString(val.String(), 10)
+		if !success {
+			return nil, fmt.Errorf("value %v is not an integer", val)
+		}
+
+		return res, nil
+	case *big.Int:
+		if val == nil {
+			return nil, errors.New("value missing")
+		}
+
+		return new(big.Int).Set(val), nil
+	case types.FieldElement:
+		return val.BigInt(), nil
+	case string:
+		return parseString(val)
+	default:
+		return nil, fmt.Errorf("unsupported value %v", value)
+	}
+}
+
+// parseString parses a string as a hex or decimal integer, or failing that a short string.
+func parseString(input string) (*big.Int, error) {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return big.NewInt(0), nil
+	}
+
+	if strings.HasPrefix(input, "0x") || strings.HasPrefix(input, "0X") {
+		if res, success := new(big.Int).SetString(input[2:], 16); success {
+			return res, nil
+		}
+	} else if res, success := new(big.Int).SetString(input, 10); success {
+		return res, nil
+	}
+
+	res, err := types.FieldElementFromShortString(input)
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("invalid value %q", input), err)
+	}
+
+	return res.BigInt(), nil
+}
+
+// isVariant returns true if the type is an enum variant, for example "(u128,felt)".
+func isVariant(typeName string) bool {
+	return strings.HasPrefix(typeName, "(") && strings.HasSuffix(typeName, ")")
+}
+
+// variantSubtypes returns the subtypes of an enum variant.
+func variantSubtypes(typeName string) []string {
+	return strings.Split(strings.TrimSuffix(strings.TrimPrefix(typeName, "("), ")"), ",")
+}
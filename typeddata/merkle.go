@@ -0,0 +1,41 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typeddata
+
+import (
+	"github.com/attestantio/go-starknet-client/types"
+)
+
+// merkleRoot returns the root of the merkle tree with the given leaves.
+// Each pair of nodes is hashed with the smaller first, and a final unpaired
+// node is paired with zero.
+func merkleRoot(leaves []types.Felt, hashFunc func(types.Felt, types.Felt) types.Felt) types.Felt {
+	for len(leaves) > 1 {
+		nodes := make([]types.Felt, 0, (len(leaves)+1)/2)
+		for i := 0; i < len(leaves); i += 2 {
+			a := leaves[i]
+			b := types.Felt{}
+			if i+1 < len(leaves) {
+				b = leaves[i+1]
+			}
+			if a.Cmp(b) > 0 {
+				a, b = b, a
+			}
+			nodes = append(nodes, hashFunc(a, b))
+		}
+		leaves = nodes
+	}
+
+	return leaves[0]
+}
@@ -0,0 +1,63 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typeddata
+
+// Revision is a revision of the SNIP-12 specification.
+type Revision uint8
+
+const (
+	// Revision0 is the legacy revision, using the Pedersen hash.
+	Revision0 Revision = iota
+	// Revision1 is the active revision, using the Poseidon hash.
+	Revision1
+)
+
+var revisionStrings = [...]string{
+	"0",
+	"1",
+}
+
+// String returns the string representation of the revision.
+func (r Revision) String() string {
+	if int(r) >= len(revisionStrings) {
+		return "unknown"
+	}
+
+	return revisionStrings[r]
+}
+
+// domainType returns the name of the domain type for the revision.
+func (r Revision) domainType() string {
+	if r == Revision1 {
+		return "StarknetDomain"
+	}
+
+	return "StarkNetDomain"
+}
+
+// presetTypes are the types predefined by revision 1.
+var presetTypes = map[string][]*Parameter{
+	"u256": {
+		{Name: "low", Type: "u128"},
+		{Name: "high", Type: "u128"},
+	},
+	"TokenAmount": {
+		{Name: "token_address", Type: "ContractAddress"},
+		{Name: "amount", Type: "u256"},
+	},
+	"NftId": {
+		{Name: "collection_address", Type: "ContractAddress"},
+		{Name: "token_id", Type: "u256"},
+	},
+}
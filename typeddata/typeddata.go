@@ -0,0 +1,211 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package typeddata provides hashing of SNIP-12 typed data, as used for
+// off-chain signing of structured messages by Starknet accounts.
+package typeddata
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/attestantio/go-starknet-client/crypto"
+	"github.com/attestantio/go-starknet-client/types"
+)
+
+// messagePrefix is the prefix of all SNIP-12 message hashes.
+var messagePrefix = types.MustFieldElementFromShortString("StarkNet Message")
+
+// Parameter is a named and typed member of a typed data type.
+type Parameter struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Contains string `json:"contains,omitempty"`
+}
+
+// TypedData is a SNIP-12 typed data structure.
+//
+// Values in the domain and message are as decoded from JSON: objects are
+// map[string]any and arrays are []any.  Scalar values can be strings (hex,
+// decimal or short string text), json.Number, bool, integers, *big.Int or
+// types.FieldElement.
+type TypedData struct {
+	Types       map[string][]*Parameter `json:"types"`
+	PrimaryType string                  `json:"primaryType"`
+	Domain      map[string]any          `json:"domain"`
+	Message     map[string]any          `json:"message"`
+}
+
+// typedDataJSON is the JSON representation of typed data.
+type typedDataJSON struct {
+	Types       map[string][]*Parameter `json:"types"`
+	PrimaryType string                  `json:"primaryType"`
+	Domain      map[string]any          `json:"domain"`
+	Message     map[string]any          `json:"message"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (t *TypedData) UnmarshalJSON(input []byte) error {
+	var data typedDataJSON
+	// Decode numbers as json.Number to avoid loss of precision.
+	decoder := json.NewDecoder(bytes.NewReader(input))
+	decoder.UseNumber()
+	if err := decoder.Decode(&data); err != nil {
+		return errors.Join(errors.New("invalid JSON"), err)
+	}
+
+	if len(data.Types) == 0 {
+		return errors.New("types missing")
+	}
+	if data.PrimaryType == "" {
+		return errors.New("primary type missing")
+	}
+	if data.Domain == nil {
+		return errors.New("domain missing")
+	}
+	if data.Message == nil {
+		return errors.New("message missing")
+	}
+
+	t.Types = data.Types
+	t.PrimaryType = data.PrimaryType
+	t.Domain = data.Domain
+	t.Message = data.Message
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (t *TypedData) String() string {
+	data, err := json.Marshal(t)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
+
+// Revision returns the SNIP-12 revision of the typed data.
+func (t *TypedData) Revision() (Revision, error) {
+	revision, exists := t.Domain["revision"]
+
+	if _, isRevision1 := t.Types[Revision1.domainType()]; isRevision1 && exists && fmt.Sprint(revision) == "1" {
+		return Revision1, nil
+	}
+
+	if _, isRevision0 := t.Types[Revision0.domainType()]; isRevision0 && (!exists || fmt.Sprint(revision) == "0") {
+		return Revision0, nil
+	}
+
+	return 0, errors.New("unable to determine typed data revision")
+}
+
+// EncodeType returns the SNIP-12 encoding of the given type.
+func (t *TypedData) EncodeType(typeName string) (string, error) {
+	encoder, err := t.encoder()
+	if err != nil {
+		return "", err
+	}
+
+	return encoder.encodeType(typeName)
+}
+
+// TypeHash returns the SNIP-12 hash of the given type.
+func (t *TypedData) TypeHash(typeName string) (types.FieldElement, error) {
+	encoder, err := t.encoder()
+	if err != nil {
+		return types.FieldElement{}, err
+	}
+
+	hash, err := encoder.typeHash(typeName)
+	if err != nil {
+		return types.FieldElement{}, err
+	}
+
+	return hash.FieldElement(), nil
+}
+
+// StructHash returns the SNIP-12 hash of the given data as the given type.
+func (t *TypedData) StructHash(typeName string, data map[string]any) (types.FieldElement, error) {
+	encoder, err := t.encoder()
+	if err != nil {
+		return types.FieldElement{}, err
+	}
+
+	hash, err := encoder.structHash(typeName, data)
+	if err != nil {
+		return types.FieldElement{}, err
+	}
+
+	return hash.FieldElement(), nil
+}
+
+// MessageHash returns the SNIP-12 hash of the message to be signed by the given account.
+func (t *TypedData) MessageHash(account types.Address) (types.FieldElement, error) {
+	encoder, err := t.encoder()
+	if err != nil {
+		return types.FieldElement{}, err
+	}
+
+	domainHash, err := encoder.structHash(encoder.revision.domainType(), t.Domain)
+	if err != nil {
+		return types.FieldElement{}, errors.Join(errors.New("failed to hash domain"), err)
+	}
+
+	messageHash, err := encoder.structHash(t.PrimaryType, t.Message)
+	if err != nil {
+		return types.FieldElement{}, errors.Join(errors.New("failed to hash message"), err)
+	}
+
+	prefix, err := messagePrefix.Felt()
+	if err != nil {
+		return types.FieldElement{}, err
+	}
+
+	accountFelt, err := types.FeltFromFieldElement(types.FieldElement(account))
+	if err != nil {
+		return types.FieldElement{}, errors.Join(errors.New("invalid account"), err)
+	}
+
+	return encoder.revision.hash(prefix, domainHash, accountFelt, messageHash).FieldElement(), nil
+}
+
+// encoder returns an encoder for the typed data.
+func (t *TypedData) encoder() (*encoder, error) {
+	revision, err := t.Revision()
+	if err != nil {
+		return nil, err
+	}
+
+	return newEncoder(t.Types, revision), nil
+}
+
+// hash hashes the elements with the array hash of the revision.
+func (r Revision) hash(elements ...types.Felt) types.Felt {
+	if r == Revision1 {
+		return crypto.PoseidonArray(elements...)
+	}
+
+	return crypto.PedersenArray(elements...)
+}
+
+// merkleHash hashes a pair of merkle tree nodes with the hash of the revision.
+func (r Revision) merkleHash(a types.Felt, b types.Felt) types.Felt {
+	if r == Revision1 {
+		return crypto.Poseidon(a, b)
+	}
+
+	return crypto.Pedersen(a, b)
+}
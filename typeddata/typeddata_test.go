@@ -0,0 +1,528 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typeddata_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/attestantio/go-starknet-client/typeddata"
+	"github.com/attestantio/go-starknet-client/types"
+	"github.com/stretchr/testify/require"
+)
+
+// loadTypedData loads typed data from the test data directory.
+func loadTypedData(t *testing.T, name string) *typeddata.TypedData {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join("testdata", name+".json"))
+	require.NoError(t, err)
+
+	var res typeddata.TypedData
+	require.NoError(t, json.Unmarshal(data, &res))
+
+	return &res
+}
+
+func TestUnmarshal(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name:  "Empty",
+			input: []byte{},
+			err:   "unexpected end of JSON input",
+		},
+		{
+			name:  "TypesMissing",
+			input: []byte(`{"primaryType":"Mail","domain":{},"message":{}}`),
+			err:   "types missing",
+		},
+		{
+			name:  "PrimaryTypeMissing",
+			input: []byte(`{"types":{"Mail":[]},"domain":{},"message":{}}`),
+			err:   "primary type missing",
+		},
+		{
+			name:  "DomainMissing",
+			input: []byte(`{"types":{"Mail":[]},"primaryType":"Mail","message":{}}`),
+			err:   "domain missing",
+		},
+		{
+			name:  "MessageMissing",
+			input: []byte(`{"types":{"Mail":[]},"primaryType":"Mail","domain":{}}`),
+			err:   "message missing",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"types":{"Mail":[{"name":"contents","type":"felt"}]},"primaryType":"Mail","domain":{"name":"Test"},"message":{"contents":"Hello"}}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res typeddata.TypedData
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				require.Equal(t, string(test.input), string(rt))
+				require.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
+
+func TestRevision(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected typeddata.Revision
+		err      string
+	}{
+		{
+			name:     "Revision0",
+			input:    `{"types":{"StarkNetDomain":[]},"primaryType":"StarkNetDomain","domain":{},"message":{}}`,
+			expected: typeddata.Revision0,
+		},
+		{
+			name:     "Revision0Explicit",
+			input:    `{"types":{"StarkNetDomain":[]},"primaryType":"StarkNetDomain","domain":{"revision":0},"message":{}}`,
+			expected: typeddata.Revision0,
+		},
+		{
+			name:     "Revision1",
+			input:    `{"types":{"StarknetDomain":[]},"primaryType":"StarknetDomain","domain":{"revision":"1"},"message":{}}`,
+			expected: typeddata.Revision1,
+		},
+		{
+			name:     "Revision1Number",
+			input:    `{"types":{"StarknetDomain":[]},"primaryType":"StarknetDomain","domain":{"revision":1},"message":{}}`,
+			expected: typeddata.Revision1,
+		},
+		{
+			name:  "Revision1Missing",
+			input: `{"types":{"StarknetDomain":[]},"primaryType":"StarknetDomain","domain":{},"message":{}}`,
+			err:   "unable to determine typed data revision",
+		},
+		{
+			name:  "Unknown",
+			input: `{"types":{"StarkNetDomain":[]},"primaryType":"StarkNetDomain","domain":{"revision":"2"},"message":{}}`,
+			err:   "unable to determine typed data revision",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var typedData typeddata.TypedData
+			require.NoError(t, json.Unmarshal([]byte(test.input), &typedData))
+			res, err := typedData.Revision()
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res)
+			}
+		})
+	}
+}
+
+func TestEncodeType(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		typeName string
+		expected string
+		err      string
+	}{
+		{
+			name:     "Domain",
+			input:    "base",
+			typeName: "StarkNetDomain",
+			expected: "StarkNetDomain(name:felt,version:felt,chainId:felt)",
+		},
+		{
+			name:     "Dependencies",
+			input:    "base",
+			typeName: "Mail",
+			expected: "Mail(from:Person,to:Person,contents:felt)Person(name:felt,wallet:felt)",
+		},
+		{
+			name:     "Unknown",
+			input:    "base",
+			typeName: "Unknown",
+			err:      "unknown type Unknown",
+		},
+		{
+			name:     "Revision1Domain",
+			input:    "array",
+			typeName: "StarknetDomain",
+			expected: `"StarknetDomain"("name":"shortstring","version":"shortstring","chainId":"shortstring","revision":"shortstring")`,
+		},
+		{
+			name:     "BaseTypes",
+			input:    "basetypes",
+			typeName: "Example",
+			expected: `"Example"("n0":"felt","n1":"bool","n2":"string","n3":"selector","n4":"u128","n5":"i128","n6":"ContractAddress","n7":"ClassHash","n8":"timestamp","n9":"shortstring")`,
+		},
+		{
+			name:     "PresetTypes",
+			input:    "presettypes",
+			typeName: "Example",
+			expected: `"Example"("n0":"TokenAmount","n1":"NftId")"NftId"("collection_address":"ContractAddress","token_id":"u256")"TokenAmount"("token_address":"ContractAddress","amount":"u256")"u256"("low":"u128","high":"u128")`,
+		},
+		{
+			name:     "MerkleTree",
+			input:    "merkletree",
+			typeName: "Session",
+			expected: "Session(key:felt,expires:felt,root:merkletree)",
+		},
+		{
+			name:     "StructArray",
+			input:    "structarray",
+			typeName: "Mail",
+			expected: "Mail(from:Person,to:Person,posts_len:felt,posts:Post*)Person(name:felt,wallet:felt)Post(title:felt,content:felt)",
+		},
+		{
+			name:     "Enum",
+			input:    "enum",
+			typeName: "Example",
+			expected: `"Example"("someEnum1":"EnumA","someEnum2":"EnumB")"EnumA"("Variant 1":(),"Variant 2":("u128","u128*"),"Variant 3":("u128"))"EnumB"("Variant 1":(),"Variant 2":("u128"))`,
+		},
+		{
+			name:     "EnumNested",
+			input:    "enumnested",
+			typeName: "Example",
+			expected: `"Example"("someEnum":"EnumA")"EnumA"("Variant 1":(),"Variant 2":("u128","StructA"))"EnumB"("Variant A":(),"Variant B":("StructB*"))"StructA"("nestedEnum":"EnumB")"StructB"("flag":"bool")`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			typedData := loadTypedData(t, test.input)
+			res, err := typedData.EncodeType(test.typeName)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res)
+			}
+		})
+	}
+}
+
+func TestTypeHash(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		typeName string
+		expected string
+	}{
+		{
+			name:     "Domain",
+			input:    "base",
+			typeName: "StarkNetDomain",
+			expected: "0x1bfc207425a47a5dfa1a50a4f5241203f50624ca5fdf5e18755765416b8e288",
+		},
+		{
+			name:     "Mail",
+			input:    "base",
+			typeName: "Mail",
+			expected: "0x13d89452df9512bf750f539ba3001b945576243288137ddb6c788457d4b2f79",
+		},
+		{
+			name:     "BaseTypes",
+			input:    "basetypes",
+			typeName: "Example",
+			expected: "0x1f94cd0be8b4097a41486170fdf09a4cd23aefbc74bb2344718562994c2c111",
+		},
+		{
+			name:     "PresetTypes",
+			input:    "presettypes",
+			typeName: "Example",
+			expected: "0x1a25a8bb84b761090b1fadaebe762c4b679b0d8883d2bedda695ea340839a55",
+		},
+		{
+			name:     "MerkleTree",
+			input:    "merkletree",
+			typeName: "Session",
+			expected: "0x1aa0e1c56b45cf06a54534fa1707c54e520b842feb21d03b7deddb6f1e340c",
+		},
+		{
+			name:     "EnumNested",
+			input:    "enumnested",
+			typeName: "Example",
+			expected: "0x2143bb787fabace39d62e9acf8b6e97d9a369000516c3e6ffd963dc1370fc1a",
+		},
+		{
+			name:     "OutsideExecution",
+			input:    "outsideexecution",
+			typeName: "OutsideExecution",
+			expected: "0x312b56c05a7965066ddbda31c016d8d05afc305071c0ca3cdc2192c3c2f1f0f",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			typedData := loadTypedData(t, test.input)
+			res, err := typedData.TypeHash(test.typeName)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, res.String())
+		})
+	}
+}
+
+func TestStructHash(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		typeName string
+		domain   bool
+		expected string
+	}{
+		{
+			name:     "Domain",
+			input:    "base",
+			typeName: "StarkNetDomain",
+			domain:   true,
+			expected: "0x54833b121883a3e3aebff48ec08a962f5742e5f7b973469c1f8f4f55d470b07",
+		},
+		{
+			name:     "BaseTypes",
+			input:    "basetypes",
+			typeName: "Example",
+			expected: "0x75db031c1f5bf980cc48f46943b236cb85a95c8f3b3c8203572453075d3d39",
+		},
+		{
+			name:     "PresetTypes",
+			input:    "presettypes",
+			typeName: "Example",
+			expected: "0x74fba3f77f8a6111a9315bac313bf75ecfa46d1234e0fda60312fb6a6517667",
+		},
+		{
+			name:     "MerkleTree",
+			input:    "merkletree",
+			typeName: "Session",
+			expected: "0x73602062421caf6ad2e942253debfad4584bff58930981364dcd378021defe8",
+		},
+		{
+			name:     "Nested",
+			input:    "nested",
+			typeName: "TransferERC721",
+			expected: "0x11b5fb80dd88c3d8b6239b065def4ac9a79e6995b117ed5940a3a0734324b79",
+		},
+		{
+			name:     "Enum",
+			input:    "enum",
+			typeName: "Example",
+			expected: "0x1e1bb5d477e92cbf562b3b766c5c1e5f8590f2df868d4c8249c0db8416f8c37",
+		},
+		{
+			name:     "OutsideExecution",
+			input:    "outsideexecution",
+			typeName: "OutsideExecution",
+			expected: "0x117e7ef9a7d447eb4bb3d9ebbb60594e0630f463ec7875f6fcc0ffad8d71c96",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			typedData := loadTypedData(t, test.input)
+			data := typedData.Message
+			if test.domain {
+				data = typedData.Domain
+			}
+			res, err := typedData.StructHash(test.typeName, data)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, res.String())
+		})
+	}
+}
+
+func TestMessageHash(t *testing.T) {
+	account := *new(types.Address).MustParse(strings.ToLower("0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"))
+
+	tests := []struct {
+		name     string
+		input    string
+		account  types.Address
+		expected string
+	}{
+		{
+			name:     "Base",
+			input:    "base",
+			account:  account,
+			expected: "0x6fcff244f63e38b9d88b9e3378d44757710d1b244282b435cb472053c8d78d0",
+		},
+		{
+			name:     "Array",
+			input:    "array",
+			account:  account,
+			expected: "0x88edea26d6177a8bc545b2e73c960ab7ddd67b46237b386b514e50315ce0f4",
+		},
+		{
+			name:     "BaseTypes",
+			input:    "basetypes",
+			account:  account,
+			expected: "0xdb7829db8909c0c5496f5952bcfc4fc894341ce01842537fc4f448743480b6",
+		},
+		{
+			name:     "PresetTypes",
+			input:    "presettypes",
+			account:  account,
+			expected: "0x185b339d5c566a883561a88fb36da301051e2c0225deb325c91bb7aa2f3473a",
+		},
+		{
+			name:     "MerkleTree",
+			input:    "merkletree",
+			account:  account,
+			expected: "0x751fb7d98545f7649d0d0eadc80d770fcd88d8cfaa55590b284f4e1b701ef0a",
+		},
+		{
+			name:     "StructArray",
+			input:    "structarray",
+			account:  account,
+			expected: "0x5914ed2764eca2e6a41eb037feefd3d2e33d9af6225a9e7fe31ac943ff712c",
+		},
+		{
+			name:     "Nested",
+			input:    "nested",
+			account:  account,
+			expected: "0x69b57cf0cd7c151c51f9616cc58a1f0a877fec28c8c15ff7537cf777c54a30d",
+		},
+		{
+			name:     "Enum",
+			input:    "enum",
+			account:  account,
+			expected: "0x6e61abaf480b1370bbf231f54e298c5f4872f40a6d2dd409ff30accee5bbd1e",
+		},
+		{
+			name:     "AllInOne",
+			input:    "allinone",
+			account:  account,
+			expected: "0x8fa4e453de78c2762493760efd449a38eb46f85b2e02b116b77b3daa9075c8",
+		},
+		{
+			name:     "EnumNested",
+			input:    "enumnested",
+			account:  account,
+			expected: "0x691fc54567306a8ea5431130f1b98299e74a748ac391540a86736f20ef5f2b7",
+		},
+		{
+			name:     "OutsideExecution",
+			input:    "outsideexecution",
+			account:  *new(types.Address).MustParse("0x05c74db20fa8f151bfd3a7a462cf2e8d4578a88aa4bd7a1746955201c48d8e5e"),
+			expected: "0x523f153f2a3ca228a2ea222fb5114437a9191bdade099667ef0c113f5444fa0",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			typedData := loadTypedData(t, test.input)
+			res, err := typedData.MessageHash(test.account)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, res.String())
+		})
+	}
+}
+
+func TestMessageHashErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		types   string
+		message string
+		err     string
+	}{
+		{
+			name:    "MissingData",
+			types:   `"Example":[{"name":"n0","type":"felt"}]`,
+			message: `{}`,
+			err:     "failed to hash message\nmissing data for Example.n0",
+		},
+		{
+			name:    "UnsupportedType",
+			types:   `"Example":[{"name":"n0","type":"unknown"}]`,
+			message: `{"n0":"0x1"}`,
+			err:     "failed to hash message\nfailed to encode Example.n0\nunsupported type unknown",
+		},
+		{
+			name:    "U128OutOfRange",
+			types:   `"Example":[{"name":"n0","type":"u128"}]`,
+			message: `{"n0":"0x100000000000000000000000000000000"}`,
+			err:     "failed to hash message\nfailed to encode Example.n0\nvalue 0x100000000000000000000000000000000 out of range for u128",
+		},
+		{
+			name:    "I128OutOfRange",
+			types:   `"Example":[{"name":"n0","type":"i128"}]`,
+			message: `{"n0":"-170141183460469231731687303715884105729"}`,
+			err:     "failed to hash message\nfailed to encode Example.n0\nvalue -170141183460469231731687303715884105729 out of range for i128",
+		},
+		{
+			name:    "FeltOutOfRange",
+			types:   `"Example":[{"name":"n0","type":"felt"}]`,
+			message: `{"n0":"0x800000000000011000000000000000000000000000000000000000000000001"}`,
+			err:     "failed to hash message\nfailed to encode Example.n0\nvalue outside of field range",
+		},
+		{
+			name:    "BoolNotBool",
+			types:   `"Example":[{"name":"n0","type":"bool"}]`,
+			message: `{"n0":"true"}`,
+			err:     "failed to hash message\nfailed to encode Example.n0\nvalue true is not a bool",
+		},
+		{
+			name:    "ShortStringTooLong",
+			types:   `"Example":[{"name":"n0","type":"shortstring"}]`,
+			message: `{"n0":"this string is longer than thirty one characters"}`,
+			err:     "failed to hash message\nfailed to encode Example.n0\ninvalid value \"this string is longer than thirty one characters\"\nshort string longer than 31 characters",
+		},
+		{
+			name:    "UnknownVariant",
+			types:   `"Example":[{"name":"n0","type":"enum","contains":"Enum"}],"Enum":[{"name":"A","type":"()"}]`,
+			message: `{"n0":{"B":[]}}`,
+			err:     "failed to hash message\nfailed to encode Example.n0\nunknown variant for enum Enum",
+		},
+		{
+			name:    "MissingVariantValue",
+			types:   `"Example":[{"name":"n0","type":"enum","contains":"Enum"}],"Enum":[{"name":"A","type":"(u128)"}]`,
+			message: `{"n0":{"A":[]}}`,
+			err:     "failed to hash message\nfailed to encode Example.n0\nmissing value 0 for variant A",
+		},
+		{
+			name:    "EmptyMerkleTree",
+			types:   `"Example":[{"name":"n0","type":"merkletree","contains":"felt"}]`,
+			message: `{"n0":[]}`,
+			err:     "failed to hash message\nfailed to encode Example.n0\nmerkle tree has no leaves",
+		},
+	}
+
+	domainType := `"StarknetDomain":[{"name":"name","type":"shortstring"},{"name":"version","type":"shortstring"},{"name":"chainId","type":"shortstring"},{"name":"revision","type":"shortstring"}]`
+	domain := `{"name":"Test","version":"1","chainId":"SN_MAIN","revision":"1"}`
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			input := `{"types":{` + domainType + `,` + test.types + `},"primaryType":"Example","domain":` + domain + `,"message":` + test.message + `}`
+			var typedData typeddata.TypedData
+			require.NoError(t, json.Unmarshal([]byte(input), &typedData))
+			_, err := typedData.MessageHash(types.Address{})
+			require.EqualError(t, err, test.err)
+		})
+	}
+}
@@ -19,6 +19,8 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+
+	"golang.org/x/crypto/sha3"
 )
 
 // AddressLength is the length of a startknet address.
@@ -31,6 +33,12 @@ type Address [AddressLength]byte
 
 var zeroAddress = Address{}
 
+// addressUpperBound is the exclusive upper bound for a Starknet address, 2^251 - 256.
+var addressUpperBound = Address{
+	0x07, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x00,
+}
+
 // IsZero returns true if the address is zero.
 func (a Address) IsZero() bool {
 	return bytes.Equal(a[:], zeroAddress[:])
@@ -49,6 +57,45 @@ func (a Address) String() string {
 	return "0x" + res
 }
 
+// PaddedString returns the canonical representation of the address,
+// being the full 64 hexadecimal characters in lower case.
+func (a Address) PaddedString() string {
+	return "0x" + hex.EncodeToString(a[:])
+}
+
+// ChecksumString returns the checksummed representation of the address,
+// being the full 64 hexadecimal characters in mixed case.
+// A character is upper case if the matching nibble of the Starknet keccak
+// hash of the address is 8 or higher.
+func (a Address) ChecksumString() string {
+	chars := []byte(hex.EncodeToString(a[:]))
+
+	// The hash is of the minimal big-endian representation of the address.
+	data := bytes.TrimLeft(a[:], "\x00")
+	if len(data) == 0 {
+		data = []byte{0x00}
+	}
+
+	hasher := sha3.NewLegacyKeccak256()
+	_, _ = hasher.Write(data)
+	hash := hasher.Sum(nil)
+	// Truncate to 250 bits, as per the Starknet keccak.
+	hash[0] &= 0x03
+
+	for i := range chars {
+		nibble := hash[i/2] & 0x0f
+		if i%2 == 0 {
+			nibble = hash[i/2] >> 4
+		}
+
+		if nibble >= 8 && chars[i] >= 'a' {
+			chars[i] -= 'a' - 'A'
+		}
+	}
+
+	return "0x" + string(chars)
+}
+
 // Format formats the address.
 func (a Address) Format(state fmt.State, v rune) {
 	format := string(v)
@@ -67,6 +114,7 @@ func (a Address) Format(state fmt.State, v rune) {
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
+// If the address is supplied in mixed case then its checksum is verified.
 func (a *Address) UnmarshalJSON(input []byte) error {
 	if len(input) == 0 {
 		return errors.New("address missing")
@@ -80,8 +128,13 @@ func (a *Address) UnmarshalJSON(input []byte) error {
 		return errors.New("invalid address suffix")
 	}
 
+	hexStr := string(input[3 : len(input)-1])
+	if len(hexStr) > AddressLength*2 {
+		return errors.New("address too long")
+	}
+
 	// Ensure that there are an even number of characters.
-	bytesStr := string(input[3 : len(input)-1])
+	bytesStr := hexStr
 	if len(bytesStr)%2 == 1 {
 		bytesStr = "0" + bytesStr
 	}
@@ -91,7 +144,21 @@ func (a *Address) UnmarshalJSON(input []byte) error {
 		return errors.New("invalid address")
 	}
 
-	copy(a[len(a)-len(val):], val)
+	var res Address
+	copy(res[len(res)-len(val):], val)
+
+	if !res.inRange() {
+		return errors.New("address outside of valid range")
+	}
+
+	if isMixedCase(hexStr) {
+		checksummed := strings.TrimPrefix(res.ChecksumString(), "0x")
+		if checksummed[len(checksummed)-len(hexStr):] != hexStr {
+			return errors.New("invalid address checksum")
+		}
+	}
+
+	*a = res
 
 	return nil
 }
@@ -110,6 +177,16 @@ func (a *Address) Parse(input string) (*Address, error) {
 	return a, nil
 }
 
+// inRange returns true if the address is below the Starknet address upper bound.
+func (a Address) inRange() bool {
+	return bytes.Compare(a[:], addressUpperBound[:]) < 0
+}
+
+// isMixedCase returns true if the hex string contains both upper and lower case characters.
+func isMixedCase(input string) bool {
+	return strings.ToLower(input) != input && strings.ToUpper(input) != input
+}
+
 // MustParse converts a string to an address , panicking on error.
 func (a *Address) MustParse(input string) *Address {
 	if _, err := a.Parse(input); err != nil {
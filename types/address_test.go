@@ -53,7 +53,42 @@ func TestAddressUnmarshalJSON(t *testing.T) {
 		},
 		{
 			name:  "Full",
+			input: []byte(`"0x7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffeff"`),
+		},
+		{
+			name:  "UpperBound",
+			input: []byte(`"0x7ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00"`),
+			err:   "address outside of valid range",
+		},
+		{
+			name:  "OutOfRange",
 			input: []byte(`"0xff102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"`),
+			err:   "address outside of valid range",
+		},
+		{
+			name:  "TooLong",
+			input: []byte(`"0x0000000000000000000000000000000000000000000000000000000000000000001"`),
+			err:   "address too long",
+		},
+		{
+			name:   "Checksummed",
+			input:  []byte(`"0x02Fd23d9182193775423497fc0c472E156C57C69E4089A1967fb288A2d84e914"`),
+			output: []byte(`"0x2fd23d9182193775423497fc0c472e156c57c69e4089a1967fb288a2d84e914"`),
+		},
+		{
+			name:   "ChecksummedUnpadded",
+			input:  []byte(`"0x2Fd23d9182193775423497fc0c472E156C57C69E4089A1967fb288A2d84e914"`),
+			output: []byte(`"0x2fd23d9182193775423497fc0c472e156c57c69e4089a1967fb288a2d84e914"`),
+		},
+		{
+			name:   "UpperCase",
+			input:  []byte(`"0x2FD23D9182193775423497FC0C472E156C57C69E4089A1967FB288A2D84E914"`),
+			output: []byte(`"0x2fd23d9182193775423497fc0c472e156c57c69e4089a1967fb288a2d84e914"`),
+		},
+		{
+			name:  "BadChecksum",
+			input: []byte(`"0x02fD23d9182193775423497fc0c472E156C57C69E4089A1967fb288A2d84e914"`),
+			err:   "invalid address checksum",
 		},
 	}
 
@@ -78,3 +113,37 @@ func TestAddressUnmarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestAddressChecksum(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		padded   string
+		checksum string
+	}{
+		{
+			name:     "Zero",
+			input:    "0x0",
+			padded:   "0x0000000000000000000000000000000000000000000000000000000000000000",
+			checksum: "0x0000000000000000000000000000000000000000000000000000000000000000",
+		},
+		{
+			name:     "Account",
+			input:    "0x2fd23d9182193775423497fc0c472e156c57c69e4089a1967fb288a2d84e914",
+			padded:   "0x02fd23d9182193775423497fc0c472e156c57c69e4089a1967fb288a2d84e914",
+			checksum: "0x02Fd23d9182193775423497fc0c472E156C57C69E4089A1967fb288A2d84e914",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			address := new(types.Address).MustParse(test.input)
+			require.Equal(t, test.padded, address.PaddedString())
+			require.Equal(t, test.checksum, address.ChecksumString())
+
+			rt, err := new(types.Address).Parse(test.checksum)
+			require.NoError(t, err)
+			require.Equal(t, address, rt)
+		})
+	}
+}
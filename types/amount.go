@@ -15,9 +15,11 @@ package types
 
 import (
 	"bytes"
+	"encoding/binary"
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"math/big"
 	"strings"
 )
 
@@ -31,11 +33,95 @@ type Amount [AmountLength]byte
 
 var zeroAmount = Amount{}
 
+// u256HalfOffset is the byte offset of the split between the high and low halves of a u256.
+const u256HalfOffset = AmountLength / 2
+
 // IsZero returns true if the amount is zero.
 func (a Amount) IsZero() bool {
 	return bytes.Equal(a[:], zeroAmount[:])
 }
 
+// AmountFromUint64 creates an amount from a uint64.
+func AmountFromUint64(input uint64) Amount {
+	var res Amount
+	binary.BigEndian.PutUint64(res[AmountLength-8:], input)
+
+	return res
+}
+
+// AmountFromBigInt creates an amount from a big integer.
+// It returns an error if the value is negative or does not fit in 256 bits.
+func AmountFromBigInt(input *big.Int) (Amount, error) {
+	var res Amount
+	if input == nil {
+		return res, errors.New("value missing")
+	}
+
+	if input.Sign() < 0 {
+		return res, errors.New("value is negative")
+	}
+
+	if input.BitLen() > AmountLength*8 {
+		return res, errors.New("value overflows amount")
+	}
+
+	input.FillBytes(res[:])
+
+	return res, nil
+}
+
+// AmountFromU256 creates an amount from the low and high 128-bit halves
+// of a Cairo u256, as used in calldata and call results.
+func AmountFromU256(low FieldElement, high FieldElement) (Amount, error) {
+	var res Amount
+	for i := range u256HalfOffset {
+		if low[i] != 0 {
+			return res, errors.New("u256 low value overflows 128 bits")
+		}
+
+		if high[i] != 0 {
+			return res, errors.New("u256 high value overflows 128 bits")
+		}
+	}
+
+	copy(res[:u256HalfOffset], high[u256HalfOffset:])
+	copy(res[u256HalfOffset:], low[u256HalfOffset:])
+
+	return res, nil
+}
+
+// U256 splits the amount in to the low and high 128-bit halves of a Cairo u256,
+// as used in calldata and call results.
+func (a Amount) U256() (FieldElement, FieldElement) {
+	var low, high FieldElement
+	copy(low[u256HalfOffset:], a[u256HalfOffset:])
+	copy(high[u256HalfOffset:], a[:u256HalfOffset])
+
+	return low, high
+}
+
+// BigInt returns the value of the amount as a big integer.
+func (a Amount) BigInt() *big.Int {
+	return new(big.Int).SetBytes(a[:])
+}
+
+// Uint64 returns the value of the amount as a uint64.
+// It returns an error if the value does not fit in a uint64.
+func (a Amount) Uint64() (uint64, error) {
+	for i := range AmountLength - 8 {
+		if a[i] != 0 {
+			return 0, errors.New("amount overflows uint64")
+		}
+	}
+
+	return binary.BigEndian.Uint64(a[AmountLength-8:]), nil
+}
+
+// Cmp compares two amounts, returning -1, 0 or 1.
+func (a Amount) Cmp(other Amount) int {
+	return bytes.Compare(a[:], other[:])
+}
+
 // String returns the string representation of the amount.
 func (a Amount) String() string {
 	res := hex.EncodeToString(a[:])
@@ -91,6 +177,11 @@ func (a *Amount) UnmarshalJSON(input []byte) error {
 		return errors.New("invalid amount")
 	}
 
+	if len(val) > AmountLength {
+		return errors.New("amount too long")
+	}
+
+	*a = Amount{}
 	copy(a[len(a)-len(val):], val)
 
 	return nil
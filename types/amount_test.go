@@ -69,3 +69,80 @@ func TestAmountUnmarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestAmountU256(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		low   string
+		high  string
+	}{
+		{
+			name:  "Zero",
+			input: "0x0",
+			low:   "0x0",
+			high:  "0x0",
+		},
+		{
+			name:  "LowOnly",
+			input: "0xde0b6b3a7640000",
+			low:   "0xde0b6b3a7640000",
+			high:  "0x0",
+		},
+		{
+			name:  "HighAndLow",
+			input: "0x100000000000000000000000000000002",
+			low:   "0x2",
+			high:  "0x1",
+		},
+		{
+			name:  "Max",
+			input: "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+			low:   "0xffffffffffffffffffffffffffffffff",
+			high:  "0xffffffffffffffffffffffffffffffff",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			amount := new(types.Amount).MustParse(test.input)
+			low, high := amount.U256()
+			require.Equal(t, test.low, low.String())
+			require.Equal(t, test.high, high.String())
+
+			rt, err := types.AmountFromU256(low, high)
+			require.NoError(t, err)
+			require.Equal(t, *amount, rt)
+
+			fromBigInt, err := types.AmountFromBigInt(amount.BigInt())
+			require.NoError(t, err)
+			require.Equal(t, *amount, fromBigInt)
+		})
+	}
+}
+
+func TestAmountFromU256Overflow(t *testing.T) {
+	overflow := *new(types.FieldElement).MustParse("0x100000000000000000000000000000000")
+
+	_, err := types.AmountFromU256(overflow, types.FieldElement{})
+	require.EqualError(t, err, "u256 low value overflows 128 bits")
+
+	_, err = types.AmountFromU256(types.FieldElement{}, overflow)
+	require.EqualError(t, err, "u256 high value overflows 128 bits")
+}
+
+func TestAmountCmp(t *testing.T) {
+	small := types.AmountFromUint64(1)
+	large := new(types.Amount).MustParse("0x100000000000000000000000000000000")
+
+	require.Equal(t, -1, small.Cmp(*large))
+	require.Equal(t, 1, large.Cmp(small))
+	require.Equal(t, 0, small.Cmp(small))
+
+	val, err := small.Uint64()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), val)
+
+	_, err = large.Uint64()
+	require.EqualError(t, err, "amount overflows uint64")
+}
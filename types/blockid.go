@@ -14,16 +14,30 @@
 package types
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 )
 
 // BlockID is a block identifier.
-// It can be a block number, block hash, or one of the special values "latest" or "pending".
+// It can be a block number, block hash, or one of the block tags.
 type BlockID string
 
+const (
+	// BlockIDLatest is the latest accepted block.
+	BlockIDLatest BlockID = "latest"
+	// BlockIDPending is the pending block, prior to RPC 0.9.
+	BlockIDPending BlockID = "pending"
+	// BlockIDPreConfirmed is the pre-confirmed block, from RPC 0.9.
+	BlockIDPreConfirmed BlockID = "pre_confirmed"
+	// BlockIDL1Accepted is the latest block accepted on L1, from RPC 0.9.
+	BlockIDL1Accepted BlockID = "l1_accepted"
+)
+
 // String returns the string representation of the block ID.
 func (b BlockID) String() string {
 	return string(b)
@@ -48,19 +62,152 @@ func (b BlockID) Format(state fmt.State, v rune) {
 	}
 }
 
+// blockIDTags are the valid block tags.
+var blockIDTags = []BlockID{BlockIDLatest, BlockIDPending, BlockIDPreConfirmed, BlockIDL1Accepted}
+
+// BlockIDFromNumber returns a block ID for the given block number.
+func BlockIDFromNumber(number uint64) BlockID {
+	return BlockID(strconv.FormatUint(number, 10))
+}
+
+// BlockIDFromHash returns a block ID for the given block hash.
+func BlockIDFromHash(hash Hash) BlockID {
+	return BlockID(hash.String())
+}
+
+// BlockIDTag returns a block ID for the given block tag.
+func BlockIDTag(tag string) (BlockID, error) {
+	res := BlockID(tag)
+	if !res.IsTag() {
+		return "", fmt.Errorf("unrecognised block tag %q", tag)
+	}
+
+	return res, nil
+}
+
+// ParseBlockID parses a block number, block hash or block tag.
+func ParseBlockID(input string) (BlockID, error) {
+	res := BlockID(input)
+	if err := res.Validate(); err != nil {
+		return "", err
+	}
+
+	return res, nil
+}
+
+// Validate returns an error if the block ID is not a valid block number,
+// block hash or block tag.
+func (b BlockID) Validate() error {
+	if b == "" {
+		return errors.New("no block ID")
+	}
+
+	if !b.IsTag() && !b.IsHash() && !b.IsNumber() {
+		return fmt.Errorf("invalid block ID %q", string(b))
+	}
+
+	return nil
+}
+
+// IsTag returns true if the block ID is a block tag.
+func (b BlockID) IsTag() bool {
+	return slices.Contains(blockIDTags, b)
+}
+
+// IsHash returns true if the block ID is a block hash.
+func (b BlockID) IsHash() bool {
+	_, isHash := b.Hash()
+
+	return isHash
+}
+
+// IsNumber returns true if the block ID is a block number.
+func (b BlockID) IsNumber() bool {
+	_, isNumber := b.Number()
+
+	return isNumber
+}
+
+// Hash returns the block hash, and true if the block ID is a block hash.
+func (b BlockID) Hash() (Hash, bool) {
+	hexStr, hasPrefix := strings.CutPrefix(string(b), "0x")
+	if !hasPrefix || hexStr == "" || len(hexStr) > HashLength*2 {
+		return Hash{}, false
+	}
+
+	var res Hash
+	if _, err := res.Parse(string(b)); err != nil {
+		return Hash{}, false
+	}
+
+	return res, true
+}
+
+// Number returns the block number, and true if the block ID is a block number.
+func (b BlockID) Number() (uint64, bool) {
+	res, err := strconv.ParseUint(string(b), 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return res, true
+}
+
 // MarshalJSON implements json.Marshaler.
 func (b BlockID) MarshalJSON() ([]byte, error) {
-	switch {
-	case b == "latest" || b == "pending":
-		return []byte(fmt.Sprintf("%q", b)), nil
-	case strings.HasPrefix(string(b), "0x"):
-		return []byte(fmt.Sprintf(`{"block_hash":"%s"}`, b)), nil
-	default:
-		block, err := strconv.ParseUint(string(b), 10, 64)
+	if b.IsTag() {
+		return []byte(fmt.Sprintf("%q", string(b))), nil
+	}
+
+	if hash, isHash := b.Hash(); isHash {
+		return []byte(fmt.Sprintf(`{"block_hash":"%s"}`, hash.String())), nil
+	}
+
+	if number, isNumber := b.Number(); isNumber {
+		return []byte(fmt.Sprintf(`{"block_number":%d}`, number)), nil
+	}
+
+	return nil, fmt.Errorf("invalid block ID %q", string(b))
+}
+
+// blockIDJSON is the JSON representation of a block hash or number.
+type blockIDJSON struct {
+	BlockHash   *Hash   `json:"block_hash"`
+	BlockNumber *uint64 `json:"block_number"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *BlockID) UnmarshalJSON(input []byte) error {
+	if bytes.HasPrefix(input, []byte{'"'}) {
+		var tag string
+		if err := json.Unmarshal(input, &tag); err != nil {
+			return errors.Join(errors.New("invalid block tag"), err)
+		}
+
+		res, err := BlockIDTag(tag)
 		if err != nil {
-			return nil, errors.New("invalid from block")
+			return err
 		}
+		*b = res
 
-		return []byte(fmt.Sprintf(`{"block_number":%d}`, block)), nil
+		return nil
 	}
+
+	var data blockIDJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Join(errors.New("invalid block ID"), err)
+	}
+
+	switch {
+	case data.BlockHash != nil && data.BlockNumber != nil:
+		return errors.New("block ID has both hash and number")
+	case data.BlockHash != nil:
+		*b = BlockIDFromHash(*data.BlockHash)
+	case data.BlockNumber != nil:
+		*b = BlockIDFromNumber(*data.BlockNumber)
+	default:
+		return errors.New("block ID has neither hash nor number")
+	}
+
+	return nil
 }
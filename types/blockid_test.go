@@ -14,6 +14,7 @@
 package types
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 )
@@ -72,3 +73,148 @@ func TestBlockIDFormat(t *testing.T) {
 		})
 	}
 }
+
+func TestBlockIDMarshalJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		block    BlockID
+		expected string
+		err      string
+	}{
+		{name: "Latest", block: BlockIDLatest, expected: `"latest"`},
+		{name: "Pending", block: BlockIDPending, expected: `"pending"`},
+		{name: "PreConfirmed", block: BlockIDPreConfirmed, expected: `"pre_confirmed"`},
+		{name: "L1Accepted", block: BlockIDL1Accepted, expected: `"l1_accepted"`},
+		{name: "Hash", block: "0xabc123", expected: `{"block_hash":"0xabc123"}`},
+		{name: "Number", block: "12345", expected: `{"block_number":12345}`},
+		{name: "HashLeadingZeros", block: "0x00abc123", expected: `{"block_hash":"0xabc123"}`},
+		{name: "Invalid", block: "safe", err: `invalid block ID "safe"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.block.MarshalJSON()
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Fatalf("unexpected error: got %v want %q", err, tt.err)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(got) != tt.expected {
+				t.Fatalf("unexpected output: got %s want %s", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestBlockIDConstructors(t *testing.T) {
+	if got := BlockIDFromNumber(12345); got != "12345" {
+		t.Fatalf("unexpected number block ID: %s", got)
+	}
+
+	hash := Hash{}
+	hash[31] = 0x23
+	hash[30] = 0xc1
+	if got := BlockIDFromHash(hash); got != "0xc123" {
+		t.Fatalf("unexpected hash block ID: %s", got)
+	}
+
+	if got, err := BlockIDTag("l1_accepted"); err != nil || got != BlockIDL1Accepted {
+		t.Fatalf("unexpected tag block ID: %s (%v)", got, err)
+	}
+
+	if _, err := BlockIDTag("safe"); err == nil || err.Error() != `unrecognised block tag "safe"` {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestBlockIDAccessors(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		isTag    bool
+		isHash   bool
+		isNumber bool
+		err      string
+	}{
+		{name: "Empty", input: "", err: "no block ID"},
+		{name: "Tag", input: "pre_confirmed", isTag: true},
+		{name: "TagUnknown", input: "safe", err: `invalid block ID "safe"`},
+		{name: "Hash", input: "0xabc123", isHash: true},
+		{name: "HashEmpty", input: "0x", err: `invalid block ID "0x"`},
+		{name: "HashInvalid", input: "0xabcxyz", err: `invalid block ID "0xabcxyz"`},
+		{name: "HashTooLong", input: "0x10000000000000000000000000000000000000000000000000000000000000000", err: `invalid block ID "0x10000000000000000000000000000000000000000000000000000000000000000"`},
+		{name: "Number", input: "12345", isNumber: true},
+		{name: "NumberNegative", input: "-1", err: `invalid block ID "-1"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			block, err := ParseBlockID(tt.input)
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Fatalf("unexpected error: got %v want %q", err, tt.err)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if block.IsTag() != tt.isTag || block.IsHash() != tt.isHash || block.IsNumber() != tt.isNumber {
+				t.Fatalf("unexpected kind: tag %t hash %t number %t", block.IsTag(), block.IsHash(), block.IsNumber())
+			}
+		})
+	}
+}
+
+func TestBlockIDUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected BlockID
+		err      string
+	}{
+		{name: "Tag", input: `"latest"`, expected: BlockIDLatest},
+		{name: "TagUnknown", input: `"safe"`, err: `unrecognised block tag "safe"`},
+		{name: "Hash", input: `{"block_hash":"0xabc123"}`, expected: "0xabc123"},
+		{name: "HashInvalid", input: `{"block_hash":"abc123"}`, err: "invalid block ID\ninvalid hash prefix"},
+		{name: "Number", input: `{"block_number":12345}`, expected: "12345"},
+		{name: "NumberInvalid", input: `{"block_number":-1}`, err: "invalid block ID\njson: cannot unmarshal number -1 into Go struct field blockIDJSON.block_number of type uint64"},
+		{name: "Both", input: `{"block_hash":"0xabc123","block_number":12345}`, err: "block ID has both hash and number"},
+		{name: "Neither", input: `{}`, err: "block ID has neither hash nor number"},
+		{name: "Array", input: `[]`, err: "invalid block ID\njson: cannot unmarshal array into Go value of type types.blockIDJSON"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var block BlockID
+			err := json.Unmarshal([]byte(tt.input), &block)
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Fatalf("unexpected error: got %v want %q", err, tt.err)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if block != tt.expected {
+				t.Fatalf("unexpected block ID: got %s want %s", block, tt.expected)
+			}
+
+			rt, err := json.Marshal(block)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(rt) != tt.input {
+				t.Fatalf("unexpected round trip: got %s want %s", rt, tt.input)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ByteArrayWordLength is the number of bytes held in each full data word of a Cairo byte array.
+const ByteArrayWordLength = 31
+
+// ByteArray is a Cairo ByteArray, which holds a string of arbitrary length.
+type ByteArray struct {
+	// Data contains the full words of the byte array, each holding ByteArrayWordLength bytes.
+	Data []FieldElement
+	// PendingWord contains the remaining bytes of the byte array.
+	PendingWord FieldElement
+	// PendingWordLen is the number of bytes in the pending word.
+	PendingWordLen uint32
+}
+
+// ByteArrayFromString creates a Cairo byte array from a string.
+func ByteArrayFromString(input string) *ByteArray {
+	res := &ByteArray{
+		Data: make([]FieldElement, 0, len(input)/ByteArrayWordLength),
+	}
+
+	for len(input) >= ByteArrayWordLength {
+		var word FieldElement
+		copy(word[FieldElementLength-ByteArrayWordLength:], input[:ByteArrayWordLength])
+		res.Data = append(res.Data, word)
+		input = input[ByteArrayWordLength:]
+	}
+
+	copy(res.PendingWord[FieldElementLength-len(input):], input)
+	res.PendingWordLen = uint32(len(input))
+
+	return res
+}
+
+// ByteArrayFromFieldElements decodes a Cairo byte array from its serialized form,
+// as returned by contract calls and found in event data.
+// It returns the byte array and the number of field elements consumed.
+func ByteArrayFromFieldElements(input []FieldElement) (*ByteArray, int, error) {
+	if len(input) == 0 {
+		return nil, 0, errors.New("byte array missing")
+	}
+
+	dataLen, err := input[0].Uint64()
+	if err != nil {
+		return nil, 0, errors.Join(errors.New("invalid byte array data length"), err)
+	}
+
+	// Compare against the available length so that a large data length cannot overflow.
+	if len(input) < 3 || dataLen > uint64(len(input))-3 {
+		return nil, 0, errors.New("byte array too short")
+	}
+
+	pendingWordLen, err := input[dataLen+2].Uint64()
+	if err != nil {
+		return nil, 0, errors.Join(errors.New("invalid byte array pending word length"), err)
+	}
+
+	res := &ByteArray{
+		Data:           make([]FieldElement, dataLen),
+		PendingWord:    input[dataLen+1],
+		PendingWordLen: uint32(pendingWordLen),
+	}
+	copy(res.Data, input[1:dataLen+1])
+
+	if err := res.validate(); err != nil {
+		return nil, 0, err
+	}
+
+	return res, int(dataLen) + 3, nil
+}
+
+// FieldElements returns the serialized form of the byte array.
+func (b *ByteArray) FieldElements() []FieldElement {
+	res := make([]FieldElement, 0, len(b.Data)+3)
+	res = append(res, FieldElementFromUint64(uint64(len(b.Data))))
+	res = append(res, b.Data...)
+	res = append(res, b.PendingWord)
+	res = append(res, FieldElementFromUint64(uint64(b.PendingWordLen)))
+
+	return res
+}
+
+// String returns the string held in the byte array.
+func (b *ByteArray) String() string {
+	res := make([]byte, 0, len(b.Data)*ByteArrayWordLength+int(b.PendingWordLen))
+	for i := range b.Data {
+		res = append(res, b.Data[i][FieldElementLength-ByteArrayWordLength:]...)
+	}
+
+	if b.PendingWordLen > 0 && b.PendingWordLen < ByteArrayWordLength {
+		res = append(res, b.PendingWord[FieldElementLength-int(b.PendingWordLen):]...)
+	}
+
+	return string(res)
+}
+
+// validate ensures that the byte array is well-formed.
+func (b *ByteArray) validate() error {
+	if b.PendingWordLen >= ByteArrayWordLength {
+		return fmt.Errorf("byte array pending word length %d too large", b.PendingWordLen)
+	}
+
+	for i := range b.Data {
+		if b.Data[i][0] != 0 {
+			return fmt.Errorf("byte array data word %d too large", i)
+		}
+	}
+
+	for i := range FieldElementLength - int(b.PendingWordLen) {
+		if b.PendingWord[i] != 0 {
+			return errors.New("byte array pending word larger than its length")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-starknet-client/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestByteArray(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{
+			name:     "Empty",
+			input:    "",
+			expected: []string{"0x0", "0x0", "0x0"},
+		},
+		{
+			name:     "Short",
+			input:    "hello",
+			expected: []string{"0x0", "0x68656c6c6f", "0x5"},
+		},
+		{
+			name:     "SingleWord",
+			input:    "ABCDEFGHIJKLMNOPQRSTUVWXYZ12345",
+			expected: []string{"0x1", "0x4142434445464748494a4b4c4d4e4f505152535455565758595a3132333435", "0x0", "0x0"},
+		},
+		{
+			name:  "MultipleWords",
+			input: "ABCDEFGHIJKLMNOPQRSTUVWXYZ12345AAADEFGHIJKLMNOPQRSTUVWXYZ12345A",
+			expected: []string{
+				"0x2",
+				"0x4142434445464748494a4b4c4d4e4f505152535455565758595a3132333435",
+				"0x4141414445464748494a4b4c4d4e4f505152535455565758595a3132333435",
+				"0x41",
+				"0x1",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			byteArray := types.ByteArrayFromString(test.input)
+			elements := byteArray.FieldElements()
+			res := make([]string, 0, len(elements))
+			for i := range elements {
+				res = append(res, elements[i].String())
+			}
+			require.Equal(t, test.expected, res)
+
+			decoded, consumed, err := types.ByteArrayFromFieldElements(elements)
+			require.NoError(t, err)
+			require.Equal(t, len(elements), consumed)
+			require.Equal(t, test.input, decoded.String())
+		})
+	}
+}
+
+func TestByteArrayFromFieldElements(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []string
+		expected string
+		consumed int
+		err      string
+	}{
+		{
+			name: "Empty",
+			err:  "byte array missing",
+		},
+		{
+			name:  "TooShort",
+			input: []string{"0x2", "0x41", "0x41"},
+			err:   "byte array too short",
+		},
+		{
+			name:  "Truncated",
+			input: []string{"0x0", "0x41"},
+			err:   "byte array too short",
+		},
+		{
+			name:  "LengthOverflow",
+			input: []string{"0xfffffffffffffffe"},
+			err:   "byte array too short",
+		},
+		{
+			name:  "LengthMax",
+			input: []string{"0xffffffffffffffff", "0x41", "0x1"},
+			err:   "byte array too short",
+		},
+		{
+			name:  "PendingWordLenTooLarge",
+			input: []string{"0x0", "0x41", "0x1f"},
+			err:   "byte array pending word length 31 too large",
+		},
+		{
+			name:  "PendingWordTooLarge",
+			input: []string{"0x0", "0x4141", "0x1"},
+			err:   "byte array pending word larger than its length",
+		},
+		{
+			name:  "DataWordTooLarge",
+			input: []string{"0x1", "0x10102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f", "0x0", "0x0"},
+			err:   "byte array data word 0 too large",
+		},
+		{
+			name:     "TrailingElements",
+			input:    []string{"0x0", "0x68656c6c6f", "0x5", "0x1234"},
+			expected: "hello",
+			consumed: 3,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			input := make([]types.FieldElement, 0, len(test.input))
+			for _, element := range test.input {
+				input = append(input, *new(types.FieldElement).MustParse(element))
+			}
+
+			res, consumed, err := types.ByteArrayFromFieldElements(input)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res.String())
+				require.Equal(t, test.consumed, consumed)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"bytes"
+	"errors"
+)
+
+const (
+	// ChainIDMainnet is the name of the Starknet mainnet chain.
+	ChainIDMainnet = "SN_MAIN"
+	// ChainIDSepolia is the name of the Starknet Sepolia testnet chain.
+	ChainIDSepolia = "SN_SEPOLIA"
+)
+
+// ChainIDName returns the name of a chain ID as returned by the node, for example "SN_MAIN".
+func ChainIDName(chainID Data) (string, error) {
+	res := bytes.TrimLeft(chainID, "\x00")
+	if len(res) == 0 {
+		return "", errors.New("chain ID missing")
+	}
+
+	if len(res) > ShortStringMaxLength {
+		return "", errors.New("chain ID too long")
+	}
+
+	if !isASCII(res) {
+		return "", errors.New("chain ID contains non-ASCII characters")
+	}
+
+	return string(res), nil
+}
+
+// ChainIDFromName returns the chain ID for a name, for example "SN_MAIN".
+func ChainIDFromName(name string) (Data, error) {
+	if name == "" {
+		return nil, errors.New("chain ID name missing")
+	}
+
+	if _, err := FieldElementFromShortString(name); err != nil {
+		return nil, errors.Join(errors.New("invalid chain ID name"), err)
+	}
+
+	return Data(name), nil
+}
@@ -0,0 +1,67 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-starknet-client/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChainIDName(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+		err      string
+	}{
+		{
+			name:  "Empty",
+			input: "0x",
+			err:   "chain ID missing",
+		},
+		{
+			name:     "Mainnet",
+			input:    "0x534e5f4d41494e",
+			expected: types.ChainIDMainnet,
+		},
+		{
+			name:     "Sepolia",
+			input:    "0x534e5f5345504f4c4941",
+			expected: types.ChainIDSepolia,
+		},
+		{
+			name:  "NonASCII",
+			input: "0x534eff",
+			err:   "chain ID contains non-ASCII characters",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := types.ChainIDName(*new(types.Data).MustParse(test.input))
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res)
+
+				chainID, err := types.ChainIDFromName(res)
+				require.NoError(t, err)
+				require.Equal(t, test.input, chainID.String())
+			}
+		})
+	}
+}
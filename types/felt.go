@@ -0,0 +1,365 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"math/bits"
+)
+
+// Felt is an element of the STARK field, the prime field of order
+// 2^251 + 17*2^192 + 1 over which Starknet operates.
+// Felt values are immutable; arithmetic operations return new values.
+//
+// Internally a felt is held as little-endian 64-bit limbs in canonical form.
+//
+//nolint:recvcheck
+type Felt [4]uint64
+
+// fieldPrime is the STARK field prime as little-endian 64-bit limbs.
+var fieldPrime = Felt{1, 0, 0, 0x0800000000000011}
+
+// fieldPrimeInv is -p^-1 mod 2^64, used for Montgomery reduction.
+const fieldPrimeInv = 0xffffffffffffffff
+
+var (
+	// fieldPrimeBig is the STARK field prime as a big integer.
+	fieldPrimeBig = fieldPrime.BigInt()
+	// montgomeryR2 is R^2 mod p, where R is 2^256.
+	montgomeryR2 = mustFeltFromBigInt(new(big.Int).Mod(new(big.Int).Lsh(big.NewInt(1), 512), fieldPrimeBig))
+	// montgomeryOne is R mod p, being 1 in Montgomery form.
+	montgomeryOne = mustFeltFromBigInt(new(big.Int).Mod(new(big.Int).Lsh(big.NewInt(1), 256), fieldPrimeBig))
+)
+
+// FieldPrime returns the STARK field prime.
+func FieldPrime() *big.Int {
+	return new(big.Int).Set(fieldPrimeBig)
+}
+
+// FeltFromUint64 creates a felt from a uint64.
+func FeltFromUint64(input uint64) Felt {
+	return Felt{input, 0, 0, 0}
+}
+
+// FeltFromBigInt creates a felt from a big integer.
+// It returns an error if the value is negative or not below the field prime.
+func FeltFromBigInt(input *big.Int) (Felt, error) {
+	if input == nil {
+		return Felt{}, errors.New("value missing")
+	}
+
+	if input.Sign() < 0 {
+		return Felt{}, errors.New("value is negative")
+	}
+
+	if input.Cmp(fieldPrimeBig) >= 0 {
+		return Felt{}, errors.New("value outside of field range")
+	}
+
+	var fe FieldElement
+	input.FillBytes(fe[:])
+
+	return FeltFromFieldElement(fe)
+}
+
+// FeltFromFieldElement creates a felt from a field element.
+// It returns an error if the value is not below the field prime.
+func FeltFromFieldElement(input FieldElement) (Felt, error) {
+	res := Felt{
+		binary.BigEndian.Uint64(input[24:32]),
+		binary.BigEndian.Uint64(input[16:24]),
+		binary.BigEndian.Uint64(input[8:16]),
+		binary.BigEndian.Uint64(input[0:8]),
+	}
+	if !res.isCanonical() {
+		return Felt{}, errors.New("value outside of field range")
+	}
+
+	return res, nil
+}
+
+// mustFeltFromBigInt creates a felt from a big integer, panicking on error.
+func mustFeltFromBigInt(input *big.Int) Felt {
+	res, err := FeltFromBigInt(input)
+	if err != nil {
+		panic(err)
+	}
+
+	return res
+}
+
+// IsZero returns true if the felt is zero.
+func (f Felt) IsZero() bool {
+	return f[0]|f[1]|f[2]|f[3] == 0
+}
+
+// Equal returns true if the felts are equal.
+func (f Felt) Equal(g Felt) bool {
+	return f == g
+}
+
+// Cmp compares two felts by their canonical values, returning -1, 0 or 1.
+func (f Felt) Cmp(g Felt) int {
+	for i := 3; i >= 0; i-- {
+		switch {
+		case f[i] < g[i]:
+			return -1
+		case f[i] > g[i]:
+			return 1
+		}
+	}
+
+	return 0
+}
+
+// Add returns f + g.
+func (f Felt) Add(g Felt) Felt {
+	var (
+		res   Felt
+		carry uint64
+	)
+
+	// Both values are below 2^252, so the sum cannot overflow 256 bits.
+	res[0], carry = bits.Add64(f[0], g[0], 0)
+	res[1], carry = bits.Add64(f[1], g[1], carry)
+	res[2], carry = bits.Add64(f[2], g[2], carry)
+	res[3], _ = bits.Add64(f[3], g[3], carry)
+
+	return res.reduce()
+}
+
+// Sub returns f - g.
+func (f Felt) Sub(g Felt) Felt {
+	var (
+		res    Felt
+		borrow uint64
+	)
+
+	res[0], borrow = bits.Sub64(f[0], g[0], 0)
+	res[1], borrow = bits.Sub64(f[1], g[1], borrow)
+	res[2], borrow = bits.Sub64(f[2], g[2], borrow)
+	res[3], borrow = bits.Sub64(f[3], g[3], borrow)
+
+	if borrow != 0 {
+		var carry uint64
+		res[0], carry = bits.Add64(res[0], fieldPrime[0], 0)
+		res[1], carry = bits.Add64(res[1], fieldPrime[1], carry)
+		res[2], carry = bits.Add64(res[2], fieldPrime[2], carry)
+		res[3], _ = bits.Add64(res[3], fieldPrime[3], carry)
+	}
+
+	return res
+}
+
+// Neg returns -f.
+func (f Felt) Neg() Felt {
+	return Felt{}.Sub(f)
+}
+
+// Mul returns f * g.
+func (f Felt) Mul(g Felt) Felt {
+	// montMul(f, g) gives f*g*R^-1; multiplying by R^2 in the same way gives f*g.
+	return montMul(montMul(f, g), montgomeryR2)
+}
+
+// Square returns f * f.
+func (f Felt) Square() Felt {
+	return f.Mul(f)
+}
+
+// Pow returns f raised to the given exponent.
+// A negative exponent raises the inverse of f, where the inverse of zero is taken to be zero.
+func (f Felt) Pow(exponent *big.Int) Felt {
+	base := f
+	if exponent.Sign() < 0 {
+		base = f.inverse()
+	}
+
+	// Work in Montgomery form to avoid conversions on each multiplication.
+	base = montMul(base, montgomeryR2)
+	res := montgomeryOne
+	e := new(big.Int).Abs(exponent)
+	for i := e.BitLen() - 1; i >= 0; i-- {
+		res = montMul(res, res)
+		if e.Bit(i) == 1 {
+			res = montMul(res, base)
+		}
+	}
+
+	return montMul(res, Felt{1, 0, 0, 0})
+}
+
+// Inverse returns the multiplicative inverse of f.
+func (f Felt) Inverse() (Felt, error) {
+	if f.IsZero() {
+		return Felt{}, errors.New("zero has no inverse")
+	}
+
+	return f.inverse(), nil
+}
+
+// Div returns f / g.
+func (f Felt) Div(g Felt) (Felt, error) {
+	inv, err := g.Inverse()
+	if err != nil {
+		return Felt{}, err
+	}
+
+	return f.Mul(inv), nil
+}
+
+// BigInt returns the value of the felt as a big integer.
+func (f Felt) BigInt() *big.Int {
+	fe := f.FieldElement()
+
+	return new(big.Int).SetBytes(fe[:])
+}
+
+// Uint64 returns the value of the felt as a uint64.
+// It returns an error if the value does not fit in a uint64.
+func (f Felt) Uint64() (uint64, error) {
+	if f[1]|f[2]|f[3] != 0 {
+		return 0, errors.New("felt overflows uint64")
+	}
+
+	return f[0], nil
+}
+
+// FieldElement returns the felt as a field element.
+func (f Felt) FieldElement() FieldElement {
+	var res FieldElement
+	binary.BigEndian.PutUint64(res[0:8], f[3])
+	binary.BigEndian.PutUint64(res[8:16], f[2])
+	binary.BigEndian.PutUint64(res[16:24], f[1])
+	binary.BigEndian.PutUint64(res[24:32], f[0])
+
+	return res
+}
+
+// String returns the string representation of the felt.
+func (f Felt) String() string {
+	fe := f.FieldElement()
+
+	return fe.String()
+}
+
+// MarshalJSON implements json.Marshaler.
+func (f Felt) MarshalJSON() ([]byte, error) {
+	return json.Marshal(f.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (f *Felt) UnmarshalJSON(input []byte) error {
+	var fe FieldElement
+	if err := fe.UnmarshalJSON(input); err != nil {
+		return err
+	}
+
+	res, err := FeltFromFieldElement(fe)
+	if err != nil {
+		return err
+	}
+
+	*f = res
+
+	return nil
+}
+
+// Format formats the felt.
+func (f Felt) Format(state fmt.State, v rune) {
+	fe := f.FieldElement()
+	fe.Format(state, v)
+}
+
+// inverse returns the multiplicative inverse of f, or zero if f is zero.
+func (f Felt) inverse() Felt {
+	// Fermat's little theorem: f^(p-2) = f^-1.
+	return f.Pow(new(big.Int).Sub(fieldPrimeBig, big.NewInt(2)))
+}
+
+// isCanonical returns true if the value is below the field prime.
+func (f Felt) isCanonical() bool {
+	return f.Cmp(fieldPrime) < 0
+}
+
+// reduce subtracts the field prime from a value in the range [0, 2p).
+func (f Felt) reduce() Felt {
+	if f.isCanonical() {
+		return f
+	}
+
+	var (
+		res    Felt
+		borrow uint64
+	)
+	res[0], borrow = bits.Sub64(f[0], fieldPrime[0], 0)
+	res[1], borrow = bits.Sub64(f[1], fieldPrime[1], borrow)
+	res[2], borrow = bits.Sub64(f[2], fieldPrime[2], borrow)
+	res[3], _ = bits.Sub64(f[3], fieldPrime[3], borrow)
+
+	return res
+}
+
+// montMul returns a*b*R^-1 mod p using coarsely integrated operand scanning.
+func montMul(a, b Felt) Felt {
+	var t [6]uint64
+
+	for i := range 4 {
+		var c, carry uint64
+		for j := range 4 {
+			hi, lo := bits.Mul64(a[j], b[i])
+			lo, carry = bits.Add64(lo, t[j], 0)
+			hi += carry
+			lo, carry = bits.Add64(lo, c, 0)
+			hi += carry
+			t[j] = lo
+			c = hi
+		}
+		t[4], carry = bits.Add64(t[4], c, 0)
+		t[5] = carry
+
+		m := t[0] * fieldPrimeInv
+		hi, lo := bits.Mul64(m, fieldPrime[0])
+		_, carry = bits.Add64(lo, t[0], 0)
+		c = hi + carry
+		for j := 1; j < 4; j++ {
+			hi, lo = bits.Mul64(m, fieldPrime[j])
+			lo, carry = bits.Add64(lo, t[j], 0)
+			hi += carry
+			lo, carry = bits.Add64(lo, c, 0)
+			hi += carry
+			t[j-1] = lo
+			c = hi
+		}
+		t[3], carry = bits.Add64(t[4], c, 0)
+		t[4] = t[5] + carry
+	}
+
+	res := Felt{t[0], t[1], t[2], t[3]}
+	if t[4] != 0 {
+		var borrow uint64
+		res[0], borrow = bits.Sub64(res[0], fieldPrime[0], 0)
+		res[1], borrow = bits.Sub64(res[1], fieldPrime[1], borrow)
+		res[2], borrow = bits.Sub64(res[2], fieldPrime[2], borrow)
+		res[3], _ = bits.Sub64(res[3], fieldPrime[3], borrow)
+
+		return res
+	}
+
+	return res.reduce()
+}
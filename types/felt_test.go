@@ -0,0 +1,230 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/attestantio/go-starknet-client/types"
+	"github.com/stretchr/testify/require"
+)
+
+// randomFelt returns a pseudo-random felt along with its value as a big integer.
+func randomFelt(rng *rand.Rand) (types.Felt, *big.Int) {
+	val := new(big.Int).Rand(rng, types.FieldPrime())
+	res, err := types.FeltFromBigInt(val)
+	if err != nil {
+		panic(err)
+	}
+
+	return res, val
+}
+
+// requireBigIntEqual requires that two big integers have the same value.
+func requireBigIntEqual(t *testing.T, expected *big.Int, actual *big.Int, msgAndArgs ...any) {
+	t.Helper()
+	require.Zero(t, expected.Cmp(actual), msgAndArgs...)
+}
+
+func TestFeltArithmetic(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	prime := types.FieldPrime()
+
+	// Include the edge values alongside random ones.
+	edges := []*big.Int{
+		big.NewInt(0),
+		big.NewInt(1),
+		big.NewInt(2),
+		new(big.Int).Sub(prime, big.NewInt(1)),
+		new(big.Int).Sub(prime, big.NewInt(2)),
+		new(big.Int).Lsh(big.NewInt(1), 251),
+	}
+
+	for i := range 1000 {
+		var (
+			a, b       types.Felt
+			aVal, bVal *big.Int
+		)
+		if i < len(edges)*len(edges) {
+			aVal = edges[i/len(edges)]
+			bVal = edges[i%len(edges)]
+			a, _ = types.FeltFromBigInt(aVal)
+			b, _ = types.FeltFromBigInt(bVal)
+		} else {
+			a, aVal = randomFelt(rng)
+			b, bVal = randomFelt(rng)
+		}
+
+		expected := new(big.Int).Mod(new(big.Int).Add(aVal, bVal), prime)
+		requireBigIntEqual(t, expected, a.Add(b).BigInt(), "add")
+
+		expected = new(big.Int).Mod(new(big.Int).Sub(aVal, bVal), prime)
+		requireBigIntEqual(t, expected, a.Sub(b).BigInt(), "sub")
+
+		expected = new(big.Int).Mod(new(big.Int).Mul(aVal, bVal), prime)
+		requireBigIntEqual(t, expected, a.Mul(b).BigInt(), "mul")
+
+		expected = new(big.Int).Mod(new(big.Int).Neg(aVal), prime)
+		requireBigIntEqual(t, expected, a.Neg().BigInt(), "neg")
+
+		expected = new(big.Int).Exp(aVal, bVal, prime)
+		requireBigIntEqual(t, expected, a.Pow(bVal).BigInt(), "pow")
+
+		if aVal.Sign() == 0 {
+			_, err := a.Inverse()
+			require.EqualError(t, err, "zero has no inverse")
+		} else {
+			inv, err := a.Inverse()
+			require.NoError(t, err)
+			requireBigIntEqual(t, new(big.Int).ModInverse(aVal, prime), inv.BigInt(), "inverse")
+			require.True(t, a.Mul(inv).Equal(types.FeltFromUint64(1)))
+		}
+
+		require.Equal(t, aVal.Cmp(bVal), a.Cmp(b), "cmp")
+	}
+}
+
+func TestFeltFromBigInt(t *testing.T) {
+	tests := []struct {
+		name  string
+		input *big.Int
+		err   string
+	}{
+		{
+			name: "Nil",
+			err:  "value missing",
+		},
+		{
+			name:  "Negative",
+			input: big.NewInt(-1),
+			err:   "value is negative",
+		},
+		{
+			name:  "Zero",
+			input: big.NewInt(0),
+		},
+		{
+			name:  "Max",
+			input: new(big.Int).Sub(types.FieldPrime(), big.NewInt(1)),
+		},
+		{
+			name:  "FieldPrime",
+			input: types.FieldPrime(),
+			err:   "value outside of field range",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := types.FeltFromBigInt(test.input)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				requireBigIntEqual(t, test.input, res.BigInt())
+
+				fe, err := types.FieldElementFromBigInt(test.input)
+				require.NoError(t, err)
+				requireBigIntEqual(t, test.input, fe.BigInt())
+			}
+		})
+	}
+}
+
+func TestFeltUint64(t *testing.T) {
+	res, err := types.FeltFromUint64(12345).Uint64()
+	require.NoError(t, err)
+	require.Equal(t, uint64(12345), res)
+
+	_, err = types.FeltFromUint64(1).Neg().Uint64()
+	require.EqualError(t, err, "felt overflows uint64")
+}
+
+func TestFeltJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name:  "Zero",
+			input: []byte(`"0x0"`),
+		},
+		{
+			name:  "Max",
+			input: []byte(`"0x800000000000011000000000000000000000000000000000000000000000000"`),
+		},
+		{
+			name:  "FieldPrime",
+			input: []byte(`"0x800000000000011000000000000000000000000000000000000000000000001"`),
+			err:   "field element outside of field range",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res types.Felt
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(res)
+				require.NoError(t, err)
+				require.Equal(t, string(test.input), string(rt))
+			}
+		})
+	}
+}
+
+func BenchmarkFeltAdd(b *testing.B) {
+	rng := rand.New(rand.NewSource(1))
+	x, _ := randomFelt(rng)
+	y, _ := randomFelt(rng)
+
+	for b.Loop() {
+		x = x.Add(y)
+	}
+}
+
+func BenchmarkFeltMul(b *testing.B) {
+	rng := rand.New(rand.NewSource(1))
+	x, _ := randomFelt(rng)
+	y, _ := randomFelt(rng)
+
+	for b.Loop() {
+		x = x.Mul(y)
+	}
+}
+
+func BenchmarkFeltInverse(b *testing.B) {
+	rng := rand.New(rand.NewSource(1))
+	x, _ := randomFelt(rng)
+
+	for b.Loop() {
+		x, _ = x.Inverse()
+	}
+}
+
+func BenchmarkFeltBigIntRoundTrip(b *testing.B) {
+	rng := rand.New(rand.NewSource(1))
+	x, _ := randomFelt(rng)
+
+	for b.Loop() {
+		x, _ = types.FeltFromBigInt(x.BigInt())
+	}
+}
@@ -15,9 +15,11 @@ package types
 
 import (
 	"bytes"
+	"encoding/binary"
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"math/big"
 	"strings"
 )
 
@@ -27,6 +29,59 @@ const FieldElementLength = 32
 // FieldElement is a 32-byte (actually max 252-bit) starknet field element.
 type FieldElement [FieldElementLength]byte
 
+var zeroFieldElement = FieldElement{}
+
+// IsZero returns true if the field element is zero.
+func (f *FieldElement) IsZero() bool {
+	return bytes.Equal(f[:], zeroFieldElement[:])
+}
+
+// FieldElementFromUint64 creates a field element from a uint64.
+func FieldElementFromUint64(input uint64) FieldElement {
+	var res FieldElement
+	binary.BigEndian.PutUint64(res[FieldElementLength-8:], input)
+
+	return res
+}
+
+// FieldElementFromBigInt creates a field element from a big integer.
+// It returns an error if the value is negative or not below the field prime.
+func FieldElementFromBigInt(input *big.Int) (FieldElement, error) {
+	felt, err := FeltFromBigInt(input)
+	if err != nil {
+		return FieldElement{}, err
+	}
+
+	return felt.FieldElement(), nil
+}
+
+// BigInt returns the value of the field element as a big integer.
+func (f *FieldElement) BigInt() *big.Int {
+	return new(big.Int).SetBytes(f[:])
+}
+
+// Felt returns the field element as a felt for arithmetic.
+func (f *FieldElement) Felt() (Felt, error) {
+	return FeltFromFieldElement(*f)
+}
+
+// Cmp compares two field elements by value, returning -1, 0 or 1.
+func (f *FieldElement) Cmp(other *FieldElement) int {
+	return bytes.Compare(f[:], other[:])
+}
+
+// Uint64 returns the value of the field element as a uint64.
+// It returns an error if the value does not fit in a uint64.
+func (f *FieldElement) Uint64() (uint64, error) {
+	for i := range FieldElementLength - 8 {
+		if f[i] != 0 {
+			return 0, errors.New("field element overflows uint64")
+		}
+	}
+
+	return binary.BigEndian.Uint64(f[FieldElementLength-8:]), nil
+}
+
 // String returns the string representation of the field element.
 func (f *FieldElement) String() string {
 	res := hex.EncodeToString(f[:])
@@ -82,7 +137,18 @@ func (f *FieldElement) UnmarshalJSON(input []byte) error {
 		return errors.New("invalid field element")
 	}
 
-	copy(f[len(f)-len(val):], val)
+	if len(val) > FieldElementLength {
+		return errors.New("field element too long")
+	}
+
+	var res FieldElement
+	copy(res[len(res)-len(val):], val)
+
+	if _, err := FeltFromFieldElement(res); err != nil {
+		return errors.New("field element outside of field range")
+	}
+
+	*f = res
 
 	return nil
 }
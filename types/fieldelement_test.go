@@ -53,7 +53,22 @@ func TestFieldElementUnmarshalJSON(t *testing.T) {
 		},
 		{
 			name:  "Full",
+			input: []byte(`"0x800000000000011000000000000000000000000000000000000000000000000"`),
+		},
+		{
+			name:  "FieldPrime",
+			input: []byte(`"0x800000000000011000000000000000000000000000000000000000000000001"`),
+			err:   "field element outside of field range",
+		},
+		{
+			name:  "OutOfRange",
 			input: []byte(`"0xff102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"`),
+			err:   "field element outside of field range",
+		},
+		{
+			name:  "TooLong",
+			input: []byte(`"0x0100000000000000000000000000000000000000000000000000000000000000000"`),
+			err:   "field element too long",
 		},
 	}
 
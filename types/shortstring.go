@@ -0,0 +1,75 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+)
+
+// ShortStringMaxLength is the maximum length of a Cairo short string.
+const ShortStringMaxLength = 31
+
+// FieldElementFromShortString encodes a Cairo short string as a field element.
+// The string must be ASCII and no longer than ShortStringMaxLength bytes.
+func FieldElementFromShortString(input string) (FieldElement, error) {
+	var res FieldElement
+	if len(input) > ShortStringMaxLength {
+		return res, fmt.Errorf("short string longer than %d characters", ShortStringMaxLength)
+	}
+
+	if !isASCII([]byte(input)) {
+		return res, errors.New("short string contains non-ASCII characters")
+	}
+
+	copy(res[len(res)-len(input):], input)
+
+	return res, nil
+}
+
+// MustFieldElementFromShortString encodes a Cairo short string as a field element, panicking on error.
+func MustFieldElementFromShortString(input string) FieldElement {
+	res, err := FieldElementFromShortString(input)
+	if err != nil {
+		panic(err)
+	}
+
+	return res
+}
+
+// ShortString decodes the field element as a Cairo short string.
+func (f *FieldElement) ShortString() (string, error) {
+	if f[0] != 0 {
+		return "", fmt.Errorf("short string longer than %d characters", ShortStringMaxLength)
+	}
+
+	res := bytes.TrimLeft(f[:], "\x00")
+	if !isASCII(res) {
+		return "", errors.New("short string contains non-ASCII characters")
+	}
+
+	return string(res), nil
+}
+
+// isASCII returns true if the input contains only ASCII characters.
+func isASCII(input []byte) bool {
+	for _, b := range input {
+		if b > 0x7f {
+			return false
+		}
+	}
+
+	return true
+}
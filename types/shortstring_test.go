@@ -0,0 +1,113 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-starknet-client/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShortString(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+		err      string
+	}{
+		{
+			name:     "Empty",
+			input:    "",
+			expected: "0x0",
+		},
+		{
+			name:     "Simple",
+			input:    "hello",
+			expected: "0x68656c6c6f",
+		},
+		{
+			name:     "ChainID",
+			input:    "SN_MAIN",
+			expected: "0x534e5f4d41494e",
+		},
+		{
+			name:     "MaxLength",
+			input:    "abcdefghijklmnopqrstuvwxyz01234",
+			expected: "0x6162636465666768696a6b6c6d6e6f707172737475767778797a3031323334",
+		},
+		{
+			name:  "TooLong",
+			input: "abcdefghijklmnopqrstuvwxyz012345",
+			err:   "short string longer than 31 characters",
+		},
+		{
+			name:  "NonASCII",
+			input: "héllo",
+			err:   "short string contains non-ASCII characters",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := types.FieldElementFromShortString(test.input)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res.String())
+				str, err := res.ShortString()
+				require.NoError(t, err)
+				require.Equal(t, test.input, str)
+			}
+		})
+	}
+}
+
+func TestShortStringDecode(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+		err      string
+	}{
+		{
+			name:     "Simple",
+			input:    "0x534e5f5345504f4c4941",
+			expected: "SN_SEPOLIA",
+		},
+		{
+			name:  "TooLong",
+			input: "0x10102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f",
+			err:   "short string longer than 31 characters",
+		},
+		{
+			name:  "NonASCII",
+			input: "0xff",
+			err:   "short string contains non-ASCII characters",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := new(types.FieldElement).MustParse(test.input).ShortString()
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res)
+			}
+		})
+	}
+}
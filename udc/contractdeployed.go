@@ -0,0 +1,109 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package udc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/attestantio/go-starknet-client/crypto"
+	"github.com/attestantio/go-starknet-client/spec"
+	"github.com/attestantio/go-starknet-client/types"
+)
+
+// contractDeployedSelector is the key of the event emitted by the universal deployer contract.
+var contractDeployedSelector = crypto.SelectorFromName("ContractDeployed")
+
+// ContractDeployed is the event emitted by the universal deployer contract
+// when it deploys a contract.
+type ContractDeployed struct {
+	Address             types.Address        `json:"address"`
+	Deployer            types.Address        `json:"deployer"`
+	Unique              bool                 `json:"unique"`
+	ClassHash           types.Hash           `json:"class_hash"`
+	ConstructorCalldata []types.FieldElement `json:"constructor_calldata"`
+	Salt                types.FieldElement   `json:"salt"`
+}
+
+// ParseContractDeployed parses a ContractDeployed event.
+func ParseContractDeployed(event *spec.TransactionEvent) (*ContractDeployed, error) {
+	if event == nil {
+		return nil, errors.New("no event supplied")
+	}
+
+	if event.FromAddress != Address {
+		return nil, errors.New("event not emitted by universal deployer contract")
+	}
+
+	if len(event.Keys) != 1 || event.Keys[0] != contractDeployedSelector {
+		return nil, errors.New("event is not ContractDeployed")
+	}
+
+	// Data is address, deployer, unique, class hash, calldata length, calldata, salt.
+	if len(event.Data) < 6 {
+		return nil, errors.New("event data too short")
+	}
+
+	calldataLen, err := event.Data[4].Uint64()
+	if err != nil || calldataLen != uint64(len(event.Data)-6) {
+		return nil, errors.New("event calldata length incorrect")
+	}
+
+	unique, err := event.Data[2].Uint64()
+	if err != nil || unique > 1 {
+		return nil, errors.New("event unique flag invalid")
+	}
+
+	return &ContractDeployed{
+		Address:             types.Address(event.Data[0]),
+		Deployer:            types.Address(event.Data[1]),
+		Unique:              unique == 1,
+		ClassHash:           types.Hash(event.Data[3]),
+		ConstructorCalldata: event.Data[5 : len(event.Data)-1],
+		Salt:                event.Data[len(event.Data)-1],
+	}, nil
+}
+
+// ContractDeployedEvents returns all ContractDeployed events in the receipt.
+func ContractDeployedEvents(receipt *spec.TransactionReceipt) ([]*ContractDeployed, error) {
+	if receipt == nil {
+		return nil, errors.New("no receipt supplied")
+	}
+
+	res := make([]*ContractDeployed, 0)
+	for i, event := range receipt.Events {
+		if event.FromAddress != Address || len(event.Keys) == 0 || event.Keys[0] != contractDeployedSelector {
+			continue
+		}
+
+		contractDeployed, err := ParseContractDeployed(event)
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("invalid event %d", i), err)
+		}
+		res = append(res, contractDeployed)
+	}
+
+	return res, nil
+}
+
+// String returns a string version of the structure.
+func (e *ContractDeployed) String() string {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
@@ -0,0 +1,151 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package udc provides helpers for deploying contract instances through the
+// universal deployer contract (UDC).
+package udc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/attestantio/go-starknet-client/crypto"
+	"github.com/attestantio/go-starknet-client/spec"
+	"github.com/attestantio/go-starknet-client/types"
+)
+
+// Address is the address of the universal deployer contract on mainnet and Sepolia.
+var Address = *new(types.Address).MustParse("0x041a78e741e5af2fec34b695679bc6891742439f7afb8484ecd7766661ad02bf")
+
+// entryPoint is the entry point of the universal deployer contract that deploys a contract.
+const entryPoint = "deployContract"
+
+// Deployment is a deployment of a contract instance through the universal deployer contract.
+type Deployment struct {
+	// ClassHash is the hash of the class to deploy.
+	ClassHash types.Hash `json:"class_hash"`
+	// Salt is the salt used to derive the address of the contract.
+	Salt types.FieldElement `json:"salt"`
+	// Unique is true if the address of the contract depends on the deployer,
+	// preventing others from deploying to the same address.
+	Unique bool `json:"unique"`
+	// ConstructorCalldata is the calldata passed to the constructor of the contract.
+	ConstructorCalldata []types.FieldElement `json:"constructor_calldata"`
+}
+
+// FunctionCall returns the call to the universal deployer contract for the deployment.
+func (d *Deployment) FunctionCall() *spec.FunctionCall {
+	calldata := make([]types.FieldElement, 0, 4+len(d.ConstructorCalldata))
+	calldata = append(calldata,
+		types.FieldElement(d.ClassHash),
+		d.Salt,
+		boolFieldElement(d.Unique),
+		types.FieldElementFromUint64(uint64(len(d.ConstructorCalldata))),
+	)
+	calldata = append(calldata, d.ConstructorCalldata...)
+
+	return &spec.FunctionCall{
+		ContractAddress:    Address,
+		EntryPointSelector: crypto.SelectorFromName(entryPoint),
+		Calldata:           calldata,
+	}
+}
+
+// ContractAddress calculates the address of the contract when deployed by
+// the given account.
+func (d *Deployment) ContractAddress(deployer types.Address) (types.Address, error) {
+	if !d.Unique {
+		return crypto.ContractAddress(types.Address{}, d.Salt, d.ClassHash, d.ConstructorCalldata)
+	}
+
+	elements, err := crypto.FeltsFromFieldElements([]types.FieldElement{types.FieldElement(deployer), d.Salt})
+	if err != nil {
+		return types.Address{}, err
+	}
+
+	salt := crypto.Pedersen(elements[0], elements[1]).FieldElement()
+
+	return crypto.ContractAddress(Address, salt, d.ClassHash, d.ConstructorCalldata)
+}
+
+// AddressFromReceipt obtains the address of the contract from the receipt of
+// the transaction that deployed it, verifying that it matches the address
+// calculated for the deployment.
+func (d *Deployment) AddressFromReceipt(receipt *spec.TransactionReceipt,
+	deployer types.Address,
+) (
+	types.Address,
+	error,
+) {
+	if receipt == nil {
+		return types.Address{}, errors.New("no receipt supplied")
+	}
+
+	if receipt.ExecutionStatus == spec.ExecutionStatusReverted {
+		return types.Address{}, fmt.Errorf("transaction reverted: %s", receipt.RevertReason)
+	}
+
+	expected, err := d.ContractAddress(deployer)
+	if err != nil {
+		return types.Address{}, errors.Join(errors.New("failed to calculate contract address"), err)
+	}
+
+	events, err := ContractDeployedEvents(receipt)
+	if err != nil {
+		return types.Address{}, err
+	}
+
+	for _, event := range events {
+		if !d.matches(event, deployer) {
+			continue
+		}
+
+		if event.Address != expected {
+			return types.Address{}, fmt.Errorf("deployed address %s does not match expected %s", event.Address, expected)
+		}
+
+		return event.Address, nil
+	}
+
+	return types.Address{}, errors.New("no matching ContractDeployed event in receipt")
+}
+
+// matches returns true if the event is for this deployment by the given deployer.
+func (d *Deployment) matches(event *ContractDeployed, deployer types.Address) bool {
+	return event.Deployer == deployer &&
+		event.ClassHash == d.ClassHash &&
+		event.Salt == d.Salt &&
+		event.Unique == d.Unique &&
+		slices.Equal(event.ConstructorCalldata, d.ConstructorCalldata)
+}
+
+// String returns a string version of the structure.
+func (d *Deployment) String() string {
+	data, err := json.Marshal(d)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
+
+// boolFieldElement returns 1 for true and 0 for false.
+func boolFieldElement(input bool) types.FieldElement {
+	if input {
+		return types.FieldElementFromUint64(1)
+	}
+
+	return types.FieldElementFromUint64(0)
+}
@@ -0,0 +1,203 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package udc_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-starknet-client/crypto"
+	"github.com/attestantio/go-starknet-client/spec"
+	"github.com/attestantio/go-starknet-client/types"
+	"github.com/attestantio/go-starknet-client/udc"
+	"github.com/stretchr/testify/require"
+)
+
+func fieldElement(input string) types.FieldElement {
+	return *new(types.FieldElement).MustParse(input)
+}
+
+func TestFunctionCall(t *testing.T) {
+	deployment := &udc.Deployment{
+		ClassHash:           *new(types.Hash).MustParse("0x387edd4804deba7af741953fdf64189468f37593a66b618d00d2476be3168f8"),
+		Salt:                fieldElement("0x18809706355007129cd38d1719447f454b7ff081c38817c53d9e2951d185243"),
+		Unique:              true,
+		ConstructorCalldata: []types.FieldElement{fieldElement("0x5"), fieldElement("0x6")},
+	}
+
+	call := deployment.FunctionCall()
+	require.Equal(t, udc.Address, call.ContractAddress)
+	require.Equal(t, crypto.SelectorFromName("deployContract"), call.EntryPointSelector)
+	require.Equal(t, []types.FieldElement{
+		fieldElement("0x387edd4804deba7af741953fdf64189468f37593a66b618d00d2476be3168f8"),
+		fieldElement("0x18809706355007129cd38d1719447f454b7ff081c38817c53d9e2951d185243"),
+		fieldElement("0x1"),
+		fieldElement("0x2"),
+		fieldElement("0x5"),
+		fieldElement("0x6"),
+	}, call.Calldata)
+}
+
+func TestContractAddress(t *testing.T) {
+	tests := []struct {
+		name       string
+		deployment *udc.Deployment
+		deployer   string
+		expected   string
+	}{
+		{
+			name: "NotUnique",
+			deployment: &udc.Deployment{
+				ClassHash: *new(types.Hash).MustParse("0x54328a1075b8820eb43caf0caa233923148c983742402dcfc38541dd843d01a"),
+				Salt:      fieldElement("0x52a06f2789d5229e87d5d0dc0d933d0e1e83366306ae069192ca9a3e4e6881f"),
+				ConstructorCalldata: []types.FieldElement{
+					fieldElement("0x546f6b656e"),
+					fieldElement("0x4552433230"),
+					fieldElement("0xf9e998b2853e6d01f3ae3c598c754c1b9a7bd398fec7657de022f3b778679"),
+				},
+			},
+			deployer: "0xf9e998b2853e6d01f3ae3c598c754c1b9a7bd398fec7657de022f3b778679",
+			expected: "0x48634f9843983eeb06b47bf7f5d156a55a1d297e958da1c86427f9ce077425b",
+		},
+		{
+			name: "Unique",
+			deployment: &udc.Deployment{
+				ClassHash: *new(types.Hash).MustParse("0x54328a1075b8820eb43caf0caa233923148c983742402dcfc38541dd843d01a"),
+				Salt:      fieldElement("0xb2334ec640982eb272cbfa72f4f9d32769e77166460c620ac950c8a4d94606"),
+				Unique:    true,
+				ConstructorCalldata: []types.FieldElement{
+					fieldElement("0x546f6b656e"),
+					fieldElement("0x4552433230"),
+					fieldElement("0x7d1f349d4d1c93d3e95bf584fd3f806fa61f4c72aa9b42ae624ef25470da0c6"),
+				},
+			},
+			deployer: "0xf9e998b2853e6d01f3ae3c598c754c1b9a7bd398fec7657de022f3b778679",
+			expected: "0x770f3b98c5a23250bc237dbeeb0a9385621f99c04a1fe3842d899264f4a1268",
+		},
+		{
+			name: "UniqueNoCalldata",
+			deployment: &udc.Deployment{
+				ClassHash: *new(types.Hash).MustParse("0x387edd4804deba7af741953fdf64189468f37593a66b618d00d2476be3168f8"),
+				Salt:      fieldElement("0x18809706355007129cd38d1719447f454b7ff081c38817c53d9e2951d185243"),
+				Unique:    true,
+			},
+			deployer: "0x2d54b7dc47eafa80f8e451cf39e7601f51fef6f1bfe5cea44ff12fa563e5457",
+			expected: "0x43267890ad2798db4a6a5374ee361cb6f3669facad58e9e58ea88c078c567bb",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := test.deployment.ContractAddress(*new(types.Address).MustParse(test.deployer))
+			require.NoError(t, err)
+			require.Equal(t, test.expected, res.String())
+		})
+	}
+}
+
+func TestAddressFromReceipt(t *testing.T) {
+	deployer := *new(types.Address).MustParse("0x2d54b7dc47eafa80f8e451cf39e7601f51fef6f1bfe5cea44ff12fa563e5457")
+	deployment := &udc.Deployment{
+		ClassHash:           *new(types.Hash).MustParse("0x387edd4804deba7af741953fdf64189468f37593a66b618d00d2476be3168f8"),
+		Salt:                fieldElement("0x18809706355007129cd38d1719447f454b7ff081c38817c53d9e2951d185243"),
+		Unique:              true,
+		ConstructorCalldata: []types.FieldElement{},
+	}
+	expected := *new(types.Address).MustParse("0x43267890ad2798db4a6a5374ee361cb6f3669facad58e9e58ea88c078c567bb")
+
+	event := func(address types.Address) *spec.TransactionEvent {
+		return &spec.TransactionEvent{
+			FromAddress: udc.Address,
+			Keys:        []types.FieldElement{crypto.SelectorFromName("ContractDeployed")},
+			Data: []types.FieldElement{
+				types.FieldElement(address),
+				types.FieldElement(deployer),
+				fieldElement("0x1"),
+				types.FieldElement(deployment.ClassHash),
+				fieldElement("0x0"),
+				deployment.Salt,
+			},
+		}
+	}
+	transfer := &spec.TransactionEvent{
+		FromAddress: *new(types.Address).MustParse("0x4718f5a0fc34cc1af16a1cdee98ffb20c31f5cd61d6ab07201858f4287c938d"),
+		Keys:        []types.FieldElement{crypto.SelectorFromName("Transfer")},
+	}
+
+	tests := []struct {
+		name    string
+		receipt *spec.TransactionReceipt
+		err     string
+	}{
+		{
+			name: "Nil",
+			err:  "no receipt supplied",
+		},
+		{
+			name: "Reverted",
+			receipt: &spec.TransactionReceipt{
+				ExecutionStatus: spec.ExecutionStatusReverted,
+				RevertReason:    "out of gas",
+			},
+			err: "transaction reverted: out of gas",
+		},
+		{
+			name: "NoEvent",
+			receipt: &spec.TransactionReceipt{
+				ExecutionStatus: spec.ExecutionStatusSucceeded,
+				Events:          []*spec.TransactionEvent{transfer},
+			},
+			err: "no matching ContractDeployed event in receipt",
+		},
+		{
+			name: "EventInvalid",
+			receipt: &spec.TransactionReceipt{
+				ExecutionStatus: spec.ExecutionStatusSucceeded,
+				Events: []*spec.TransactionEvent{
+					{
+						FromAddress: udc.Address,
+						Keys:        []types.FieldElement{crypto.SelectorFromName("ContractDeployed")},
+					},
+				},
+			},
+			err: "invalid event 0\nevent data too short",
+		},
+		{
+			name: "AddressMismatch",
+			receipt: &spec.TransactionReceipt{
+				ExecutionStatus: spec.ExecutionStatusSucceeded,
+				Events:          []*spec.TransactionEvent{event(deployer)},
+			},
+			err: "deployed address 0x2d54b7dc47eafa80f8e451cf39e7601f51fef6f1bfe5cea44ff12fa563e5457 does not match expected 0x43267890ad2798db4a6a5374ee361cb6f3669facad58e9e58ea88c078c567bb",
+		},
+		{
+			name: "Good",
+			receipt: &spec.TransactionReceipt{
+				ExecutionStatus: spec.ExecutionStatusSucceeded,
+				Events:          []*spec.TransactionEvent{transfer, event(expected)},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := deployment.AddressFromReceipt(test.receipt, deployer)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, expected, res)
+			}
+		})
+	}
+}